@@ -0,0 +1,60 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade defines a software upgrade of the app: the handler run at the upgrade height
+// and the stores added, renamed or deleted by the new binary
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
+	// StoreUpgrades are the store changes applied when loading the store at the upgrade height
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Upgrades lists the upgrades known by the binary
+var Upgrades = []Upgrade{
+	{
+		// v2 migrates x/blog to consensus version 2, see x/blog/migrations/v2
+		Name:          "v2",
+		StoreUpgrades: storetypes.StoreUpgrades{},
+	},
+}
+
+// setupUpgradeHandlers registers the handlers of the known upgrades, each one runs the module migrations
+func (app *App) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.Name,
+			func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			},
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders sets the store loader applying the store upgrades of the scheduled upgrade,
+// it must be called before the stores are loaded
+func (app *App) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	"planet/x/blog/keeper"
@@ -11,29 +12,98 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmdb "github.com/tendermint/tm-db"
 )
 
+const (
+	// ChannelID is the only channel known by the channel keeper stub
+	ChannelID = "channel-0"
+	// CounterpartyChannelID is the counterparty of ChannelID
+	CounterpartyChannelID = "channel-1"
+	// CounterpartyChainID is the chain ID tracked by the client of ChannelID
+	CounterpartyChainID = "mars"
+)
+
 // blogChannelKeeper is a stub of cosmosibckeeper.ChannelKeeper.
 type blogChannelKeeper struct{}
 
 func (blogChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool) {
-	return channeltypes.Channel{}, false
+	if srcPort != types.PortID || srcChan != ChannelID {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(types.PortID, CounterpartyChannelID),
+		[]string{"connection-0"},
+		types.Version,
+	), true
+}
+func (blogChannelKeeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+	if portID != types.PortID || channelID != ChannelID {
+		return "", nil, channeltypes.ErrChannelNotFound
+	}
+	return "07-tendermint-0", &ibctmtypes.ClientState{ChainId: CounterpartyChainID}, nil
+}
+func (blogChannelKeeper) GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel {
+	return []channeltypes.IdentifiedChannel{
+		channeltypes.NewIdentifiedChannel(types.PortID, ChannelID, channeltypes.NewChannel(
+			channeltypes.OPEN,
+			channeltypes.UNORDERED,
+			channeltypes.NewCounterparty(types.PortID, CounterpartyChannelID),
+			[]string{"connection-0"},
+			types.Version,
+		)),
+		// Channels that can't be broadcasted to
+		channeltypes.NewIdentifiedChannel(types.PortID, "channel-5", channeltypes.NewChannel(
+			channeltypes.CLOSED,
+			channeltypes.UNORDERED,
+			channeltypes.NewCounterparty(types.PortID, "channel-6"),
+			[]string{"connection-0"},
+			types.Version,
+		)),
+		channeltypes.NewIdentifiedChannel("transfer", "channel-7", channeltypes.NewChannel(
+			channeltypes.OPEN,
+			channeltypes.UNORDERED,
+			channeltypes.NewCounterparty("transfer", "channel-8"),
+			[]string{"connection-0"},
+			"ics20-1",
+		)),
+	}
 }
 func (blogChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
-	return 0, false
+	if portID != types.PortID || channelID != ChannelID {
+		return 0, false
+	}
+	return 1, true
 }
 func (blogChannelKeeper) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
 	return nil
 }
+func (blogChannelKeeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	if packet.GetDestPort() != types.PortID || packet.GetDestChannel() != ChannelID {
+		return channeltypes.ErrChannelNotFound
+	}
+	// The acknowledgement is emitted as the channel keeper does, so that tests can check it
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		channeltypes.EventTypeWriteAck,
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(channeltypes.AttributeKeyAck, string(acknowledgement.Acknowledgement())),
+	))
+	return nil
+}
 func (blogChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
 	return nil
 }
@@ -61,6 +131,8 @@ func BlogKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	appCodec := codec.NewProtoCodec(registry)
 	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
 
+	scopedKeeper := capabilityKeeper.ScopeToModule("BlogScopedKeeper")
+
 	paramsSubspace := typesparams.NewSubspace(appCodec,
 		types.Amino,
 		storeKey,
@@ -74,11 +146,19 @@ func BlogKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		paramsSubspace,
 		blogChannelKeeper{},
 		blogPortKeeper{},
-		capabilityKeeper.ScopeToModule("BlogScopedKeeper"),
+		scopedKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
 
+	// Own the capability of the stub channel so packets can be sent over it
+	_, err := scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(types.PortID, ChannelID))
+	require.NoError(t, err)
+
+	// Bind the module port
+	k.SetPort(ctx, types.PortID)
+
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
@@ -27,10 +27,37 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListPost())
 	cmd.AddCommand(CmdShowPost())
+	cmd.AddCommand(CmdPostsByCreator())
+	cmd.AddCommand(CmdPostsBySourceChannel())
+	cmd.AddCommand(CmdPostsByRemoteAuthor())
+	cmd.AddCommand(CmdPostRevisions())
+	cmd.AddCommand(CmdPostAtRevision())
+	cmd.AddCommand(CmdSearchPosts())
+	cmd.AddCommand(CmdPostsByTag())
+	cmd.AddCommand(CmdTopTags())
+	cmd.AddCommand(CmdNotifications())
+	cmd.AddCommand(CmdShowNotification())
 	cmd.AddCommand(CmdListSentPost())
 	cmd.AddCommand(CmdShowSentPost())
+	cmd.AddCommand(CmdSentPostsByCreator())
 	cmd.AddCommand(CmdListTimedoutPost())
 	cmd.AddCommand(CmdShowTimedoutPost())
+	cmd.AddCommand(CmdTimedoutPostsByCreator())
+	cmd.AddCommand(CmdListPendingPost())
+	cmd.AddCommand(CmdShowPendingPost())
+	cmd.AddCommand(CmdPendingPostsByCreator())
+	cmd.AddCommand(CmdListFailedPost())
+	cmd.AddCommand(CmdShowFailedPost())
+	cmd.AddCommand(CmdListInboundPost())
+	cmd.AddCommand(CmdShowInboundPost())
+	cmd.AddCommand(CmdListModerators())
+	cmd.AddCommand(CmdModerationLog())
+	cmd.AddCommand(CmdListBannedAuthors())
+	cmd.AddCommand(CmdListBannedChannels())
+	cmd.AddCommand(CmdListReportedPosts())
+	cmd.AddCommand(CmdListComment())
+	cmd.AddCommand(CmdShowComment())
+	cmd.AddCommand(CmdCommentThread())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
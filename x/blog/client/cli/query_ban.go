@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagChannel = "channel"
+
+func CmdListBannedAuthors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-banned-authors",
+		Short: "list the banned authors of other chains",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			channelID, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryBannedAuthorsRequest{
+				ChannelID:  channelID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BannedAuthors(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChannel, "", "List the authors banned on a channel only")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListBannedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-banned-channels",
+		Short: "list the banned channels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBannedChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BannedChannels(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagUnread = "unread"
+
+func CmdNotifications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notifications [address]",
+		Short: "list the notifications of an address, from the oldest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			unreadOnly, err := cmd.Flags().GetBool(flagUnread)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNotificationsRequest{
+				Address:    args[0],
+				UnreadOnly: unreadOnly,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Notifications(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagUnread, false, "List the unread notifications only")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowNotification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-notification [address] [id]",
+		Short: "shows a notification of an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetNotificationRequest{
+				Address: args[0],
+				Id:      id,
+			}
+
+			res, err := queryClient.Notification(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
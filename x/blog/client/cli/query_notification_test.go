@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/testutil/sample"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func TestNotifications(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	address := sample.AccAddress()
+	for i := 0; i < 3; i++ {
+		state.NotificationList = append(state.NotificationList, types.Notification{
+			Address: address,
+			Id:      uint64(i),
+			PostID:  uint64(i),
+			Author:  "A",
+			Read:    i == 1,
+		})
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+	objs := state.NotificationList
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	t.Run("All", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdNotifications(), append([]string{address}, common...))
+		require.NoError(t, err)
+		var resp types.QueryNotificationsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.Notification),
+		)
+	})
+	t.Run("Unread", func(t *testing.T) {
+		args := append([]string{address, "--unread"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdNotifications(), args)
+		require.NoError(t, err)
+		var resp types.QueryNotificationsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill([]types.Notification{objs[0], objs[2]}),
+			nullify.Fill(resp.Notification),
+		)
+	})
+	t.Run("Show", func(t *testing.T) {
+		args := append([]string{address, strconv.FormatUint(objs[1].Id, 10)}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowNotification(), args)
+		require.NoError(t, err)
+		var resp types.QueryGetNotificationResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill(&objs[1]),
+			nullify.Fill(&resp.Notification),
+		)
+	})
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdListPendingPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-post",
+		Short: "list all pendingPost",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPendingPostRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingPostAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPendingPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pending-post [port] [channel-id] [sequence]",
+		Short: "shows a pendingPost",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPort := args[0]
+			argChannelID := args[1]
+			argSequence, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPendingPostRequest{
+				Port:      argPort,
+				ChannelID: argChannelID,
+				Sequence:  argSequence,
+			}
+
+			res, err := queryClient.PendingPost(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingPostsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-posts-by-creator [creator]",
+		Short: "list the pendingPost sent by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingPostsByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingPostsByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
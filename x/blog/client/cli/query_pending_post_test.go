@@ -0,0 +1,171 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func networkWithPendingPostObjects(t *testing.T, n int) (*network.Network, []types.PendingPost) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	for i := 0; i < n; i++ {
+		pendingPost := types.PendingPost{
+			Port:      strconv.Itoa(i),
+			ChannelID: strconv.Itoa(i),
+			Sequence:  uint64(i),
+		}
+		nullify.Fill(&pendingPost)
+		state.PendingPostList = append(state.PendingPostList, pendingPost)
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PendingPostList
+}
+
+func TestShowPendingPost(t *testing.T) {
+	net, objs := networkWithPendingPostObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc        string
+		idPort      string
+		idChannelID string
+		idSequence  uint64
+
+		args []string
+		err  error
+		obj  types.PendingPost
+	}{
+		{
+			desc:        "found",
+			idPort:      objs[0].Port,
+			idChannelID: objs[0].ChannelID,
+			idSequence:  objs[0].Sequence,
+
+			args: common,
+			obj:  objs[0],
+		},
+		{
+			desc:        "not found",
+			idPort:      strconv.Itoa(100000),
+			idChannelID: strconv.Itoa(100000),
+			idSequence:  100000,
+
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				tc.idPort,
+				tc.idChannelID,
+				strconv.FormatUint(tc.idSequence, 10),
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPendingPost(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetPendingPostResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.NotNil(t, resp.PendingPost)
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.PendingPost),
+				)
+			}
+		})
+	}
+}
+
+func TestListPendingPost(t *testing.T) {
+	net, objs := networkWithPendingPostObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPendingPost(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllPendingPostResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.PendingPost), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.PendingPost),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(objs); i += step {
+			args := request(next, 0, uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPendingPost(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllPendingPostResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.PendingPost), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.PendingPost),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPendingPost(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllPendingPostResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.NoError(t, err)
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.PendingPost),
+		)
+	})
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +11,11 @@ import (
 	"planet/x/blog/types"
 )
 
+const (
+	flagOrigin        = "origin"
+	flagIncludeHidden = "include-hidden"
+)
+
 func CmdListPost() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-post",
@@ -24,8 +30,20 @@ func CmdListPost() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			origin, err := cmd.Flags().GetString(flagOrigin)
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryAllPostRequest{
-				Pagination: pageReq,
+				Pagination:    pageReq,
+				Origin:        origin,
+				IncludeHidden: includeHidden,
 			}
 
 			res, err := queryClient.PostAll(context.Background(), params)
@@ -37,6 +55,8 @@ func CmdListPost() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagOrigin, "", fmt.Sprintf("Filter the posts on their origin: %s or %s", types.PostOriginLocal, types.PostOriginRemote))
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -58,8 +78,14 @@ func CmdShowPost() *cobra.Command {
 				return err
 			}
 
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
 			params := &types.QueryGetPostRequest{
-				Id: id,
+				Id:            id,
+				IncludeHidden: includeHidden,
 			}
 
 			res, err := queryClient.Post(context.Background(), params)
@@ -71,6 +97,176 @@ func CmdShowPost() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagIncludeHidden, false, "Show the post even if it was hidden by a moderator")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPostsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts-by-creator [creator]",
+		Short: "list the post created by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostsByCreatorRequest{
+				Creator:       args[0],
+				Pagination:    pageReq,
+				IncludeHidden: includeHidden,
+			}
+
+			res, err := queryClient.PostsByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPostsBySourceChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts-by-source-channel [channel-id]",
+		Short: "list the post received through a source channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostsBySourceChannelRequest{
+				ChannelID:     args[0],
+				Pagination:    pageReq,
+				IncludeHidden: includeHidden,
+			}
+
+			res, err := queryClient.PostsBySourceChannel(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPostsByRemoteAuthor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts-by-remote-author [chain-id] [address]",
+		Short: "list the post received from an author on another chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostsByRemoteAuthorRequest{
+				ChainID:       args[0],
+				Address:       args[1],
+				Pagination:    pageReq,
+				IncludeHidden: includeHidden,
+			}
+
+			res, err := queryClient.PostsByRemoteAuthor(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPostsByTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts-by-tag [tag]",
+		Short: "list the posts tagged with a tag, hashtags of the content included",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostsByTagRequest{
+				Tag:           args[0],
+				Pagination:    pageReq,
+				IncludeHidden: includeHidden,
+			}
+
+			res, err := queryClient.PostsByTag(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
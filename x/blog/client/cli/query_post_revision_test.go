@@ -0,0 +1,163 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func networkWithPostRevisionObjects(t *testing.T, n int) (*network.Network, []types.PostRevision) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	// The revisions belong to a post of the genesis
+	post := types.Post{Id: 0}
+	nullify.Fill(&post)
+	state.PostList = append(state.PostList, post)
+	state.PostCount = 1
+	for i := 0; i < n; i++ {
+		postRevision := types.PostRevision{
+			PostID:   0,
+			Revision: uint64(i),
+		}
+		nullify.Fill(&postRevision)
+		state.PostRevisionList = append(state.PostRevisionList, postRevision)
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PostRevisionList
+}
+
+func TestPostAtRevision(t *testing.T) {
+	net, objs := networkWithPostRevisionObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc     string
+		postID   string
+		revision string
+		args     []string
+		err      error
+		obj      types.PostRevision
+	}{
+		{
+			desc:     "found",
+			postID:   fmt.Sprintf("%d", objs[1].PostID),
+			revision: fmt.Sprintf("%d", objs[1].Revision),
+			args:     common,
+			obj:      objs[1],
+		},
+		{
+			desc:     "not found",
+			postID:   fmt.Sprintf("%d", objs[1].PostID),
+			revision: "100000",
+			args:     common,
+			err:      status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{tc.postID, tc.revision}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostAtRevision(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryPostAtRevisionResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.NotNil(t, resp.PostRevision)
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.PostRevision),
+				)
+			}
+		})
+	}
+}
+
+func TestPostRevisions(t *testing.T) {
+	net, objs := networkWithPostRevisionObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			"0",
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostRevisions(), args)
+			require.NoError(t, err)
+			var resp types.QueryPostRevisionsResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.PostRevision),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(objs); i += step {
+			args := request(next, 0, uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostRevisions(), args)
+			require.NoError(t, err)
+			var resp types.QueryPostRevisionsResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.PostRevision),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostRevisions(), args)
+		require.NoError(t, err)
+		var resp types.QueryPostRevisionsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.NoError(t, err)
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.PostRevision),
+		)
+	})
+}
@@ -25,11 +25,18 @@ func networkWithPostObjects(t *testing.T, n int) (*network.Network, []types.Post
 
 	for i := 0; i < n; i++ {
 		post := types.Post{
-			Id: uint64(i),
+			Id:      uint64(i),
+			Creator: fmt.Sprintf("creator-%d", i%2),
 		}
 		nullify.Fill(&post)
 		state.PostList = append(state.PostList, post)
 	}
+	state.ReactionList = append(state.ReactionList, types.Reaction{
+		PostKind: types.CommentPostKindPost,
+		PostID:   0,
+		Reaction: types.ReactionLike,
+		Creator:  "creator-1",
+	})
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -81,6 +88,7 @@ func TestShowPost(t *testing.T) {
 					nullify.Fill(&tc.obj),
 					nullify.Fill(&resp.Post),
 				)
+				require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}}, resp.Reactions)
 			}
 		})
 	}
@@ -151,3 +159,23 @@ func TestListPost(t *testing.T) {
 		)
 	})
 }
+
+func TestPostsByCreator(t *testing.T) {
+	net, objs := networkWithPostObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		"creator-0",
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		fmt.Sprintf("--%s", flags.FlagCountTotal),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostsByCreator(), args)
+	require.NoError(t, err)
+	var resp types.QueryPostsByCreatorResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.Post{objs[0], objs[2], objs[4]}),
+		nullify.Fill(resp.Post),
+	)
+}
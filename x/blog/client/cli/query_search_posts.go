@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagOperator = "operator"
+
+func CmdSearchPosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-posts [query]",
+		Short: "list the posts matching the words of a query",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			operator, err := cmd.Flags().GetString(flagOperator)
+			if err != nil {
+				return err
+			}
+
+			includeHidden, err := cmd.Flags().GetBool(flagIncludeHidden)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySearchPostsRequest{
+				Query:         args[0],
+				Operator:      operator,
+				Pagination:    pageReq,
+				IncludeHidden: includeHidden,
+			}
+
+			res, err := queryClient.SearchPosts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOperator, types.SearchOperatorAnd, fmt.Sprintf("Match the posts with all the words (%s) or any of them (%s)", types.SearchOperatorAnd, types.SearchOperatorOr))
+	cmd.Flags().Bool(flagIncludeHidden, false, "Include the posts hidden by a moderator")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func TestSearchPosts(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	for i, title := range []string{"Hello Mars", "Hello Venus", "Mars and Venus"} {
+		post := types.Post{Id: uint64(i), Creator: "A", Title: title}
+		nullify.Fill(&post)
+		state.PostList = append(state.PostList, post)
+	}
+	state.PostCount = uint64(len(state.PostList))
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+	objs := state.PostList
+
+	ctx := net.Validators[0].ClientCtx
+	for _, tc := range []struct {
+		desc string
+		args []string
+		objs []types.Post
+	}{
+		{
+			desc: "and",
+			args: []string{"mars venus"},
+			objs: objs[2:],
+		},
+		{
+			desc: "or",
+			args: []string{"mars venus", fmt.Sprintf("--%s=%s", "operator", types.SearchOperatorOr)},
+			objs: objs,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdSearchPosts(), args)
+			require.NoError(t, err)
+			var resp types.QuerySearchPostsResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.Equal(t,
+				nullify.Fill(tc.objs),
+				nullify.Fill(resp.Post),
+			)
+		})
+	}
+}
@@ -75,3 +75,38 @@ func CmdShowSentPost() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSentPostsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sent-posts-by-creator [creator]",
+		Short: "list the sentPost sent by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySentPostsByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SentPostsByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func TestPostsByTagAndTopTags(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	for i, post := range []types.Post{
+		{Title: "title", Content: "#mars", Tags: []string{"space"}},
+		{Title: "title", Content: "#venus", Tags: []string{"space"}},
+	} {
+		post.Id = uint64(i)
+		post.Creator = "A"
+		state.PostList = append(state.PostList, post)
+	}
+	state.PostCount = uint64(len(state.PostList))
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+	objs := state.PostList
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	t.Run("PostsByTag", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPostsByTag(), append([]string{"venus"}, common...))
+		require.NoError(t, err)
+		var resp types.QueryPostsByTagResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill(objs[1:]),
+			nullify.Fill(resp.Post),
+		)
+	})
+	t.Run("TopTags", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTopTags(), common)
+		require.NoError(t, err)
+		var resp types.QueryTopTagsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, []types.TagCount{
+			{Tag: "space", Count: 2},
+			{Tag: "mars", Count: 1},
+			{Tag: "venus", Count: 1},
+		}, resp.TagCount)
+	})
+}
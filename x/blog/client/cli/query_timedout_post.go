@@ -75,3 +75,38 @@ func CmdShowTimedoutPost() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTimedoutPostsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timedout-posts-by-creator [creator]",
+		Short: "list the timedoutPost sent by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTimedoutPostsByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TimedoutPostsByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
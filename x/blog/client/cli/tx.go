@@ -13,10 +13,14 @@ import (
 
 var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+	// DefaultRelativePacketTimeoutHeight disables the timeout height
+	DefaultRelativePacketTimeoutHeight = "0-0"
 )
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
 	listSeparator              = ","
 )
 
@@ -31,6 +35,26 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSendIbcPost())
+	cmd.AddCommand(CmdCreatePost())
+	cmd.AddCommand(CmdUpdatePost())
+	cmd.AddCommand(CmdDeletePost())
+	cmd.AddCommand(CmdRetryIbcPost())
+	cmd.AddCommand(CmdBroadcastIbcPost())
+	cmd.AddCommand(CmdSendIbcComment())
+	cmd.AddCommand(CmdSendIbcEditPost())
+	cmd.AddCommand(CmdSendIbcDeletePost())
+	cmd.AddCommand(CmdMarkNotificationsRead())
+	cmd.AddCommand(CmdReactToPost())
+	cmd.AddCommand(CmdSendIbcReaction())
+	cmd.AddCommand(CmdApproveInboundPost())
+	cmd.AddCommand(CmdRejectInboundPost())
+	cmd.AddCommand(CmdHidePost())
+	cmd.AddCommand(CmdUnhidePost())
+	cmd.AddCommand(CmdBanAuthor())
+	cmd.AddCommand(CmdUnbanAuthor())
+	cmd.AddCommand(CmdBanChannel())
+	cmd.AddCommand(CmdUnbanChannel())
+	cmd.AddCommand(CmdReportPost())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
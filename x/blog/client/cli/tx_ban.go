@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdBanAuthor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban-author [channel-id] [address] [reason]",
+		Short: "Refuse the posts of an author of another chain received on a channel",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelID := args[0]
+			argAddress := args[1]
+			argReason := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBanAuthor(clientCtx.GetFromAddress().String(), argChannelID, argAddress, argReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnbanAuthor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban-author [channel-id] [address] [reason]",
+		Short: "Accept the posts of a banned author again",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelID := args[0]
+			argAddress := args[1]
+			var argReason string
+			if len(args) > 2 {
+				argReason = args[2]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbanAuthor(clientCtx.GetFromAddress().String(), argChannelID, argAddress, argReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBanChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban-channel [channel-id] [reason]",
+		Short: "Refuse the posts received on a channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelID := args[0]
+			argReason := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBanChannel(clientCtx.GetFromAddress().String(), argChannelID, argReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnbanChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban-channel [channel-id] [reason]",
+		Short: "Accept the posts received on a banned channel again",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChannelID := args[0]
+			var argReason string
+			if len(args) > 1 {
+				argReason = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbanChannel(clientCtx.GetFromAddress().String(), argChannelID, argReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channelutils "github.com/cosmos/ibc-go/v5/modules/core/04-channel/client/utils"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagSrcPort = "src-port"
+
+func CmdBroadcastIbcPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-ibc-post [title] [content] [src-channel]...",
+		Short: "Send a ibcPost over IBC to several channels",
+		Long: `Send a ibcPost over IBC to every given channel of the source port.
+Without channels, the post is sent to all the open channels of the blog port allowed by the params.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argTitle := args[0]
+			argContent := args[1]
+			argTags, err := cmd.Flags().GetStringSlice(flagTags)
+			if err != nil {
+				return err
+			}
+
+			srcPort, err := cmd.Flags().GetString(flagSrcPort)
+			if err != nil {
+				return err
+			}
+			var destinations []types.IbcPostDestination
+			for _, srcChannel := range args[2:] {
+				destinations = append(destinations, types.IbcPostDestination{Port: srcPort, ChannelID: srcChannel})
+			}
+
+			// Without channels the keeper picks the destinations when the message is executed, the open channels
+			// are only listed to compute the timeout
+			allChannels := len(destinations) == 0
+			timeoutDestinations := destinations
+			if allChannels {
+				timeoutDestinations, err = queryOpenChannels(cmd, clientCtx, srcPort)
+				if err != nil {
+					return err
+				}
+				if len(timeoutDestinations) == 0 {
+					return errors.New("no open channel to broadcast the post to")
+				}
+			}
+
+			// Get the relative timeout timestamp, from the most recent consensus state so the timeout is in the
+			// future for every destination
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				var latestTimestamp uint64
+				for _, destination := range timeoutDestinations {
+					consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, destination.Port, destination.ChannelID)
+					if err != nil {
+						return err
+					}
+					if consensusState.GetTimestamp() > latestTimestamp {
+						latestTimestamp = consensusState.GetTimestamp()
+					}
+				}
+				timeoutTimestamp = latestTimestamp + timeoutTimestamp
+			}
+
+			msg := types.NewMsgBroadcastIbcPost(creator, destinations, allChannels, timeoutTimestamp, argTitle, argContent, argTags)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSrcPort, types.PortID, "Source port of the channels")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().StringSlice(flagTags, nil, "Comma separated tags of the post, hashtags of the content are tagged too")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryOpenChannels returns the open channels bound to the port from the IBC channel query
+func queryOpenChannels(cmd *cobra.Command, clientCtx client.Context, port string) ([]types.IbcPostDestination, error) {
+	queryClient := channeltypes.NewQueryClient(clientCtx)
+
+	var (
+		destinations []types.IbcPostDestination
+		pageKey      []byte
+	)
+	for {
+		res, err := queryClient.Channels(cmd.Context(), &channeltypes.QueryChannelsRequest{
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, channel := range res.Channels {
+			if channel.PortId == port && channel.State == channeltypes.OPEN {
+				destinations = append(destinations, types.IbcPostDestination{Port: channel.PortId, ChannelID: channel.ChannelId})
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return destinations, nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
+}
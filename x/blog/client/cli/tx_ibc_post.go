@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channelutils "github.com/cosmos/ibc-go/v5/modules/core/04-channel/client/utils"
 	"github.com/spf13/cobra"
 	"planet/x/blog/types"
@@ -13,11 +14,17 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagTags = "tags"
+
 func CmdSendIbcPost() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send-ibc-post [src-port] [src-channel] [title] [content]",
 		Short: "Send a ibcPost over IBC",
-		Args:  cobra.ExactArgs(4),
+		Long: `Send a ibcPost over IBC. Timeouts can be specified as absolute or relative using the "absolute-timeouts" flag.
+Timeout height can be set by passing in the height string in the form {revision}-{height} using the "packet-timeout-height"
+flag. Relative timeouts are added to the block height and timestamp of the latest consensus state corresponding to the
+counterparty channel. Any timeout set to 0 is disabled.`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -30,21 +37,46 @@ func CmdSendIbcPost() *cobra.Command {
 
 			argTitle := args[2]
 			argContent := args[3]
+			argTags, err := cmd.Flags().GetStringSlice(flagTags)
+			if err != nil {
+				return err
+			}
 
-			// Get the relative timeout timestamp
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
 			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
 			if err != nil {
 				return err
 			}
-			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
 			if err != nil {
 				return err
 			}
-			if timeoutTimestamp != 0 {
-				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+
+			// Relative timeouts are added to the latest consensus state of the counterparty
+			if !absoluteTimeouts {
+				consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+				if err != nil {
+					return err
+				}
+				if !timeoutHeight.IsZero() {
+					absoluteHeight := height
+					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+					timeoutHeight = absoluteHeight
+				}
+				if timeoutTimestamp != 0 {
+					timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+				}
 			}
 
-			msg := types.NewMsgSendIbcPost(creator, srcPort, srcChannel, timeoutTimestamp, argTitle, argContent)
+			msg := types.NewMsgSendIbcPost(creator, srcPort, srcChannel, timeoutHeight, timeoutTimestamp, argTitle, argContent, argTags)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -52,7 +84,10 @@ func CmdSendIbcPost() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().String(flagPacketTimeoutHeight, DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().StringSlice(flagTags, nil, "Comma separated tags of the post, hashtags of the content are tagged too")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdMarkNotificationsRead() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-notifications-read [id]...",
+		Short: "Mark notifications as read, all the unread ones if no id is given",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var ids []uint64
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMarkNotificationsRead(clientCtx.GetFromAddress().String(), ids)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,177 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"planet/testutil/network"
+	"planet/x/blog/client/cli"
+)
+
+func TestCreatePost(t *testing.T) {
+	net := network.New(t)
+	val := net.Validators[0]
+	ctx := val.ClientCtx
+
+	fields := []string{"xyz", "xyz"}
+	for _, tc := range []struct {
+		desc string
+		args []string
+		err  error
+		code uint32
+	}{
+		{
+			desc: "valid",
+			args: []string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10))).String()),
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{}
+			args = append(args, fields...)
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCreatePost(), args)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp sdk.TxResponse
+				require.NoError(t, ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, tc.code, resp.Code)
+			}
+		})
+	}
+}
+
+func TestUpdatePost(t *testing.T) {
+	net := network.New(t)
+
+	val := net.Validators[0]
+	ctx := val.ClientCtx
+
+	fields := []string{"xyz", "xyz"}
+	common := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10))).String()),
+	}
+	args := []string{}
+	args = append(args, fields...)
+	args = append(args, common...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCreatePost(), args)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		id   string
+		args []string
+		code uint32
+		err  error
+	}{
+		{
+			desc: "valid",
+			id:   "0",
+			args: common,
+		},
+		{
+			desc: "key not found",
+			id:   "1",
+			args: common,
+			code: sdkerrors.ErrKeyNotFound.ABCICode(),
+		},
+		{
+			desc: "invalid key",
+			id:   "invalid",
+			err:  strconv.ErrSyntax,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{tc.id}
+			args = append(args, fields...)
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdUpdatePost(), args)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp sdk.TxResponse
+				require.NoError(t, ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, tc.code, resp.Code)
+			}
+		})
+	}
+}
+
+func TestDeletePost(t *testing.T) {
+	net := network.New(t)
+
+	val := net.Validators[0]
+	ctx := val.ClientCtx
+
+	fields := []string{"xyz", "xyz"}
+	common := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10))).String()),
+	}
+	args := []string{}
+	args = append(args, fields...)
+	args = append(args, common...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdCreatePost(), args)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		id   string
+		args []string
+		code uint32
+		err  error
+	}{
+		{
+			desc: "valid",
+			id:   "0",
+			args: common,
+		},
+		{
+			desc: "key not found",
+			id:   "1",
+			args: common,
+			code: sdkerrors.ErrKeyNotFound.ABCICode(),
+		},
+		{
+			desc: "invalid key",
+			id:   "invalid",
+			err:  strconv.ErrSyntax,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{tc.id}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdDeletePost(), args)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp sdk.TxResponse
+				require.NoError(t, ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, tc.code, resp.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v5/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdRetryIbcPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-ibc-post [timedout|failed] [id] [src-port] [src-channel]",
+		Short: "Resend a timed out or failed ibcPost over IBC",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argKind := args[0]
+			argID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			srcPort := args[2]
+			srcChannel := args[3]
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgRetryIbcPost(creator, argKind, argID, srcPort, srcChannel, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
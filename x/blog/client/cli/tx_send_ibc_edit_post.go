@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v5/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdSendIbcEditPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-ibc-edit-post [sent-post-id] [title] [content]",
+		Short: "Edit a post delivered to another chain, the edit is sent over IBC through the channel the post was sent through",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argTitle := args[1]
+			argContent := args[2]
+
+			timeoutTimestamp, err := sentPostTimeoutTimestamp(cmd, clientCtx, argID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendIbcEditPost(creator, argID, argTitle, argContent, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sentPostTimeoutTimestamp returns the absolute timeout timestamp of a packet changing a sent post, from the
+// relative timeout flag and the channel the post was delivered through
+func sentPostTimeoutTimestamp(cmd *cobra.Command, clientCtx client.Context, id uint64) (uint64, error) {
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	if timeoutTimestamp == 0 {
+		return 0, nil
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SentPost(cmd.Context(), &types.QueryGetSentPostRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+	if res.SentPost.SourceChannel == "" {
+		return 0, fmt.Errorf("the channel sent post %d was delivered through is unknown", id)
+	}
+	consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, res.SentPost.SourcePort, res.SentPost.SourceChannel)
+	if err != nil {
+		return 0, err
+	}
+	return consensusState.GetTimestamp() + timeoutTimestamp, nil
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v5/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdSendIbcReaction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-ibc-reaction [post-id] [reaction]",
+		Short: "React to a post received from another chain, the reaction is sent over IBC to the chain of the post",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argPostID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argReaction := args[1]
+
+			// Get the relative timeout timestamp, from the channel the post was received on
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.Post(cmd.Context(), &types.QueryGetPostRequest{Id: argPostID})
+				if err != nil {
+					return err
+				}
+				remoteAuthor := res.Post.RemoteAuthor
+				if remoteAuthor == nil {
+					return fmt.Errorf("post %d wasn't received from another chain", argPostID)
+				}
+				consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, remoteAuthor.DestinationPort, remoteAuthor.DestinationChannel)
+				if err != nil {
+					return err
+				}
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgSendIbcReaction(creator, argPostID, argReaction, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,6 +8,9 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// The params are set first, indexing the posts depends on them
+	k.SetParams(ctx, genState.Params)
+
 	// Set all the post
 	for _, elem := range genState.PostList {
 		k.SetPost(ctx, elem)
@@ -29,6 +32,69 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set timedoutPost count
 	k.SetTimedoutPostCount(ctx, genState.TimedoutPostCount)
+	// Set all the pendingPost
+	for _, elem := range genState.PendingPostList {
+		k.SetPendingPost(ctx, elem)
+	}
+	// Set all the failedPost
+	for _, elem := range genState.FailedPostList {
+		k.SetFailedPost(ctx, elem)
+	}
+
+	// Set failedPost count
+	k.SetFailedPostCount(ctx, genState.FailedPostCount)
+	// Set all the comment
+	for _, elem := range genState.CommentList {
+		k.SetComment(ctx, elem)
+	}
+
+	// Set comment count
+	k.SetCommentCount(ctx, genState.CommentCount)
+	// Set all the postRevision
+	for _, elem := range genState.PostRevisionList {
+		k.SetPostRevision(ctx, elem)
+	}
+	// Set all the notification, the count of an address follows its last notification
+	for _, elem := range genState.NotificationList {
+		k.SetNotification(ctx, elem)
+		if elem.Id >= k.GetNotificationCount(ctx, elem.Address) {
+			k.SetNotificationCount(ctx, elem.Address, elem.Id+1)
+		}
+	}
+	// Set all the reaction, the reaction counts follow the reactions
+	for _, elem := range genState.ReactionList {
+		k.SetReaction(ctx, elem)
+	}
+	// Set all the inboundPost
+	for _, elem := range genState.InboundPostList {
+		k.SetInboundPost(ctx, elem)
+	}
+
+	// Set inboundPost count
+	k.SetInboundPostCount(ctx, genState.InboundPostCount)
+	// Set all the moderator
+	for _, elem := range genState.ModeratorList {
+		k.SetModerator(ctx, elem)
+	}
+	// Set all the moderationAction
+	for _, elem := range genState.ModerationActionList {
+		k.SetModerationAction(ctx, elem)
+	}
+
+	// Set moderationAction count
+	k.SetModerationActionCount(ctx, genState.ModerationActionCount)
+	// Set all the bannedAuthor
+	for _, elem := range genState.BannedAuthorList {
+		k.SetBannedAuthor(ctx, elem)
+	}
+	// Set all the bannedChannel
+	for _, elem := range genState.BannedChannelList {
+		k.SetBannedChannel(ctx, elem)
+	}
+	// Set all the report, the report counts follow the reports
+	for _, elem := range genState.ReportList {
+		k.SetReport(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -41,7 +107,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic("could not claim port capability: " + err.Error())
 		}
 	}
-	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -56,6 +121,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.SentPostCount = k.GetSentPostCount(ctx)
 	genesis.TimedoutPostList = k.GetAllTimedoutPost(ctx)
 	genesis.TimedoutPostCount = k.GetTimedoutPostCount(ctx)
+	genesis.PendingPostList = k.GetAllPendingPost(ctx)
+	genesis.FailedPostList = k.GetAllFailedPost(ctx)
+	genesis.FailedPostCount = k.GetFailedPostCount(ctx)
+	genesis.CommentList = k.GetAllComment(ctx)
+	genesis.CommentCount = k.GetCommentCount(ctx)
+	genesis.PostRevisionList = k.GetAllPostRevision(ctx)
+	genesis.NotificationList = k.GetAllNotification(ctx)
+	genesis.ReactionList = k.GetAllReaction(ctx)
+	genesis.InboundPostList = k.GetAllInboundPost(ctx)
+	genesis.InboundPostCount = k.GetInboundPostCount(ctx)
+	genesis.ModeratorList = k.GetAllModerator(ctx)
+	genesis.ModerationActionList = k.GetAllModerationAction(ctx)
+	genesis.ModerationActionCount = k.GetModerationActionCount(ctx)
+	genesis.BannedAuthorList = k.GetAllBannedAuthor(ctx)
+	genesis.BannedChannelList = k.GetAllBannedChannel(ctx)
+	genesis.ReportList = k.GetAllReport(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
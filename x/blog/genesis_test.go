@@ -3,6 +3,7 @@ package blog_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "planet/testutil/keeper"
 	"planet/testutil/nullify"
@@ -16,7 +17,8 @@ func TestGenesis(t *testing.T) {
 		PortId: types.PortID,
 		PostList: []types.Post{
 			{
-				Id: 0,
+				Id:      0,
+				Creator: "A",
 			},
 			{
 				Id: 1,
@@ -25,7 +27,8 @@ func TestGenesis(t *testing.T) {
 		PostCount: 2,
 		SentPostList: []types.SentPost{
 			{
-				Id: 0,
+				Id:      0,
+				Creator: "A",
 			},
 			{
 				Id: 1,
@@ -34,18 +37,174 @@ func TestGenesis(t *testing.T) {
 		SentPostCount: 2,
 		TimedoutPostList: []types.TimedoutPost{
 			{
-				Id: 0,
+				Id:      0,
+				Creator: "A",
 			},
 			{
 				Id: 1,
 			},
 		},
 		TimedoutPostCount: 2,
+		PendingPostList: []types.PendingPost{
+			{
+				Port:      "0",
+				ChannelID: "0",
+				Sequence:  0,
+			},
+			{
+				Port:      "1",
+				ChannelID: "1",
+				Sequence:  1,
+			},
+		},
+		FailedPostList: []types.FailedPost{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		FailedPostCount: 2,
+		CommentList: []types.Comment{
+			{
+				Id:       0,
+				PostKind: types.CommentPostKindPost,
+				PostID:   1,
+			},
+			{
+				Id:        1,
+				PostKind:  types.CommentPostKindPost,
+				PostID:    1,
+				Status:    types.CommentStatusPending,
+				Port:      types.PortID,
+				ChannelID: "channel-0",
+				Sequence:  1,
+			},
+		},
+		CommentCount: 2,
+		PostRevisionList: []types.PostRevision{
+			{
+				PostID:   1,
+				Revision: 0,
+			},
+			{
+				PostID:   1,
+				Revision: 1,
+			},
+		},
+		NotificationList: []types.Notification{
+			{
+				Address: "A",
+				Id:      0,
+				Read:    true,
+			},
+			{
+				Address: "A",
+				Id:      1,
+			},
+		},
+		ReactionList: []types.Reaction{
+			{
+				PostKind: types.CommentPostKindPost,
+				PostID:   0,
+				Reaction: types.ReactionLike,
+				Creator:  "A",
+			},
+			{
+				PostKind: types.CommentPostKindPost,
+				PostID:   0,
+				Reaction: types.ReactionLike,
+				Creator:  "B",
+			},
+		},
+		InboundPostList: []types.InboundPost{
+			{
+				Id:        0,
+				ExpiresAt: 10,
+			},
+			{
+				Id:        1,
+				ExpiresAt: 20,
+			},
+		},
+		InboundPostCount: 2,
+		ModeratorList: []types.Moderator{
+			{
+				Address: "A",
+			},
+			{
+				Address: "B",
+			},
+		},
+		ModerationActionList: []types.ModerationAction{
+			{
+				Id:     0,
+				Action: types.ModerationActionHidePost,
+			},
+			{
+				Id:     1,
+				Action: types.ModerationActionUnhidePost,
+			},
+		},
+		ModerationActionCount: 2,
+		BannedAuthorList: []types.BannedAuthor{
+			{
+				ChannelID: "channel-0",
+				Address:   "A",
+			},
+			{
+				ChannelID: "channel-1",
+				Address:   "A",
+			},
+		},
+		BannedChannelList: []types.BannedChannel{
+			{
+				ChannelID: "channel-0",
+			},
+			{
+				ChannelID: "channel-1",
+			},
+		},
+		ReportList: []types.Report{
+			{
+				PostID:   1,
+				Reporter: "A",
+				Reason:   types.ReportReasonSpam,
+			},
+			{
+				PostID:   1,
+				Reporter: "B",
+				Reason:   types.ReportReasonAbuse,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
 	k, ctx := keepertest.BlogKeeper(t)
 	blog.InitGenesis(ctx, *k, genesisState)
+
+	// The secondary indexes are rebuilt from the genesis lists
+	postsRes, err := k.PostsByCreator(sdk.WrapSDKContext(ctx), &types.QueryPostsByCreatorRequest{Creator: "A"})
+	require.NoError(t, err)
+	require.Len(t, postsRes.Post, 1)
+	sentPostsRes, err := k.SentPostsByCreator(sdk.WrapSDKContext(ctx), &types.QuerySentPostsByCreatorRequest{Creator: "A"})
+	require.NoError(t, err)
+	require.Len(t, sentPostsRes.SentPost, 1)
+	timedoutPostsRes, err := k.TimedoutPostsByCreator(sdk.WrapSDKContext(ctx), &types.QueryTimedoutPostsByCreatorRequest{Creator: "A"})
+	require.NoError(t, err)
+	require.Len(t, timedoutPostsRes.TimedoutPost, 1)
+	threadRes, err := k.CommentThread(sdk.WrapSDKContext(ctx), &types.QueryCommentThreadRequest{PostKind: types.CommentPostKindPost, PostID: 1})
+	require.NoError(t, err)
+	require.Len(t, threadRes.Comment, 2)
+	_, found := k.GetCommentByPacket(ctx, types.PortID, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, uint64(2), k.GetPostRevisionCount(ctx, 1))
+	require.Equal(t, uint64(2), k.GetNotificationCount(ctx, "A"))
+	require.Equal(t, []uint64{1}, k.GetUnreadNotificationIDs(ctx, "A"))
+	require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLike, Count: 2}}, k.GetReactionCounts(ctx, types.CommentPostKindPost, 0))
+	require.Equal(t, uint64(2), k.GetInboundPostCount(ctx))
+	require.Equal(t, uint64(2), k.GetReportCount(ctx, 1))
 	got := blog.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
@@ -60,5 +219,21 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.SentPostCount, got.SentPostCount)
 	require.ElementsMatch(t, genesisState.TimedoutPostList, got.TimedoutPostList)
 	require.Equal(t, genesisState.TimedoutPostCount, got.TimedoutPostCount)
+	require.ElementsMatch(t, genesisState.PendingPostList, got.PendingPostList)
+	require.ElementsMatch(t, genesisState.FailedPostList, got.FailedPostList)
+	require.Equal(t, genesisState.FailedPostCount, got.FailedPostCount)
+	require.ElementsMatch(t, genesisState.CommentList, got.CommentList)
+	require.Equal(t, genesisState.CommentCount, got.CommentCount)
+	require.ElementsMatch(t, genesisState.PostRevisionList, got.PostRevisionList)
+	require.ElementsMatch(t, genesisState.NotificationList, got.NotificationList)
+	require.ElementsMatch(t, genesisState.ReactionList, got.ReactionList)
+	require.ElementsMatch(t, genesisState.InboundPostList, got.InboundPostList)
+	require.Equal(t, genesisState.InboundPostCount, got.InboundPostCount)
+	require.ElementsMatch(t, genesisState.ModeratorList, got.ModeratorList)
+	require.ElementsMatch(t, genesisState.ModerationActionList, got.ModerationActionList)
+	require.Equal(t, genesisState.ModerationActionCount, got.ModerationActionCount)
+	require.ElementsMatch(t, genesisState.BannedAuthorList, got.BannedAuthorList)
+	require.ElementsMatch(t, genesisState.BannedChannelList, got.BannedChannelList)
+	require.ElementsMatch(t, genesisState.ReportList, got.ReportList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+// SetBannedAuthor set a specific bannedAuthor in the store from its index
+func (k Keeper) SetBannedAuthor(ctx sdk.Context, bannedAuthor types.BannedAuthor) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedAuthorKey))
+	b := k.cdc.MustMarshal(&bannedAuthor)
+	store.Set(types.BannedAuthorStoreKey(bannedAuthor.ChannelID, bannedAuthor.Address), b)
+}
+
+// GetBannedAuthor returns a bannedAuthor from its index
+func (k Keeper) GetBannedAuthor(ctx sdk.Context, channelID string, address string) (val types.BannedAuthor, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedAuthorKey))
+	b := store.Get(types.BannedAuthorStoreKey(channelID, address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBannedAuthor removes a bannedAuthor from the store
+func (k Keeper) RemoveBannedAuthor(ctx sdk.Context, channelID string, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedAuthorKey))
+	store.Delete(types.BannedAuthorStoreKey(channelID, address))
+}
+
+// GetAllBannedAuthor returns all bannedAuthor
+func (k Keeper) GetAllBannedAuthor(ctx sdk.Context) (list []types.BannedAuthor) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedAuthorKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BannedAuthor
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetBannedChannel set a specific bannedChannel in the store from its index
+func (k Keeper) SetBannedChannel(ctx sdk.Context, bannedChannel types.BannedChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedChannelKey))
+	b := k.cdc.MustMarshal(&bannedChannel)
+	store.Set([]byte(bannedChannel.ChannelID), b)
+}
+
+// GetBannedChannel returns a bannedChannel from its index
+func (k Keeper) GetBannedChannel(ctx sdk.Context, channelID string) (val types.BannedChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedChannelKey))
+	b := store.Get([]byte(channelID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBannedChannel removes a bannedChannel from the store
+func (k Keeper) RemoveBannedChannel(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedChannelKey))
+	store.Delete([]byte(channelID))
+}
+
+// GetAllBannedChannel returns all bannedChannel
+func (k Keeper) GetAllBannedChannel(ctx sdk.Context) (list []types.BannedChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BannedChannelKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BannedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// checkBans returns an error if the channel a packet is received on or the author of the packet on this channel
+// is banned, the packets of every type are checked
+func (k Keeper) checkBans(ctx sdk.Context, channelID string, author string) error {
+	if _, banned := k.GetBannedChannel(ctx, channelID); banned {
+		return sdkerrors.Wrapf(types.ErrChannelBanned, "cannot receive packets on channel %s", channelID)
+	}
+	if _, banned := k.GetBannedAuthor(ctx, channelID, author); banned {
+		return sdkerrors.Wrapf(types.ErrAuthorBanned, "cannot receive packets of %s on channel %s", author, channelID)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNBannedAuthor(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.BannedAuthor {
+	items := make([]types.BannedAuthor, n)
+	for i := range items {
+		items[i].ChannelID = "channel-" + strconv.Itoa(i%2)
+		items[i].Address = strconv.Itoa(i)
+		keeper.SetBannedAuthor(ctx, items[i])
+	}
+	return items
+}
+
+func createNBannedChannel(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.BannedChannel {
+	items := make([]types.BannedChannel, n)
+	for i := range items {
+		items[i].ChannelID = "channel-" + strconv.Itoa(i)
+		keeper.SetBannedChannel(ctx, items[i])
+	}
+	return items
+}
+
+func TestBannedAuthorGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedAuthor(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetBannedAuthor(ctx, item.ChannelID, item.Address)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	// The author is banned on a channel only
+	_, found := keeper.GetBannedAuthor(ctx, "channel-1", items[0].Address)
+	require.False(t, found)
+}
+
+func TestBannedAuthorRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedAuthor(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveBannedAuthor(ctx, item.ChannelID, item.Address)
+		_, found := keeper.GetBannedAuthor(ctx, item.ChannelID, item.Address)
+		require.False(t, found)
+	}
+}
+
+func TestBannedAuthorGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedAuthor(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllBannedAuthor(ctx)),
+	)
+}
+
+func TestBannedChannelGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedChannel(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetBannedChannel(ctx, item.ChannelID)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestBannedChannelRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedChannel(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveBannedChannel(ctx, item.ChannelID)
+		_, found := keeper.GetBannedChannel(ctx, item.ChannelID)
+		require.False(t, found)
+	}
+}
+
+func TestBannedChannelGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNBannedChannel(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllBannedChannel(ctx)),
+	)
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNComment(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Comment {
+	items := make([]types.Comment, n)
+	for i := range items {
+		items[i].PostKind = types.CommentPostKindPost
+		items[i].Id = keeper.AppendComment(ctx, items[i])
+	}
+	return items
+}
+
+func TestCommentGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNComment(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetComment(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestCommentGetByPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNComment(keeper, ctx, 2)
+	items[1].Status = types.CommentStatusPending
+	items[1].Port = "blog"
+	items[1].ChannelID = "channel-0"
+	items[1].Sequence = 3
+	keeper.SetComment(ctx, items[1])
+
+	got, found := keeper.GetCommentByPacket(ctx, "blog", "channel-0", 3)
+	require.True(t, found)
+	require.Equal(t, items[1].Id, got.Id)
+
+	// The packet is no longer indexed once the comment is delivered
+	items[1].Status = types.CommentStatusDelivered
+	keeper.SetComment(ctx, items[1])
+	_, found = keeper.GetCommentByPacket(ctx, "blog", "channel-0", 3)
+	require.False(t, found)
+}
+
+func TestCommentRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNComment(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveComment(ctx, item.Id)
+		_, found := keeper.GetComment(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestCommentRemovePostComments(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	keeper.AppendComment(ctx, types.Comment{PostKind: types.CommentPostKindPost, PostID: 0})
+	keeper.AppendComment(ctx, types.Comment{
+		PostKind:  types.CommentPostKindPost,
+		PostID:    0,
+		Status:    types.CommentStatusPending,
+		Port:      "blog",
+		ChannelID: "channel-0",
+		Sequence:  3,
+	})
+	kept := []types.Comment{
+		{PostKind: types.CommentPostKindPost, PostID: 1},
+		{PostKind: types.CommentPostKindSentPost, PostID: 0},
+	}
+	for i := range kept {
+		kept[i].Id = keeper.AppendComment(ctx, kept[i])
+	}
+
+	keeper.RemovePostComments(ctx, types.CommentPostKindPost, 0)
+	requirePostThreadRemoved(t, keeper, ctx, types.CommentPostKindPost, 0)
+	_, found := keeper.GetCommentByPacket(ctx, "blog", "channel-0", 3)
+	require.False(t, found)
+	require.ElementsMatch(t,
+		nullify.Fill(kept),
+		nullify.Fill(keeper.GetAllComment(ctx)),
+	)
+}
+
+// requirePostThreadRemoved checks that no comment or reaction of a post is left in the store
+func requirePostThreadRemoved(t *testing.T, keeper *keeper.Keeper, ctx sdk.Context, postKind string, postID uint64) {
+	resp, err := keeper.CommentThread(sdk.WrapSDKContext(ctx), &types.QueryCommentThreadRequest{PostKind: postKind, PostID: postID})
+	require.NoError(t, err)
+	require.Empty(t, resp.Comment)
+	for _, comment := range keeper.GetAllComment(ctx) {
+		require.False(t, comment.PostKind == postKind && comment.PostID == postID, "comment %d left", comment.Id)
+	}
+	for _, reaction := range keeper.GetAllReaction(ctx) {
+		require.False(t, reaction.PostKind == postKind && reaction.PostID == postID, "reaction of %s left", reaction.Reactor())
+	}
+	require.Empty(t, keeper.GetReactionCounts(ctx, postKind, postID))
+}
+
+func TestCommentGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNComment(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllComment(ctx)),
+	)
+}
+
+func TestCommentCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNComment(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetCommentCount(ctx))
+}
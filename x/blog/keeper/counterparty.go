@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// CounterpartyChainID returns the chain ID of the counterparty chain of a channel, resolved from the client
+// of the channel connection. It returns an empty string if the client doesn't track a chain ID.
+func (k Keeper) CounterpartyChainID(ctx sdk.Context, portID, channelID string) string {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return ""
+	}
+
+	// Only some light clients, like tendermint, know the chain ID of the counterparty
+	if chainIDClientState, ok := clientState.(interface{ GetChainID() string }); ok {
+		return chainIDClientState.GetChainID()
+	}
+	return ""
+}
+
+// SentPostChannel returns the port and channel on this chain of the channel a sent post was delivered through.
+// Sent posts acknowledged before the sending end was recorded are resolved from the channels bound to the module
+// port, the channel must be the only one with the destination as counterparty.
+func (k Keeper) SentPostChannel(ctx sdk.Context, sentPost types.SentPost) (port, channelID string, found bool) {
+	if sentPost.SourceChannel != "" {
+		return sentPost.SourcePort, sentPost.SourceChannel, true
+	}
+	if sentPost.DestinationChannel == "" {
+		return "", "", false
+	}
+
+	boundPort := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != boundPort ||
+			channel.Counterparty.PortId != sentPost.DestinationPort ||
+			channel.Counterparty.ChannelId != sentPost.DestinationChannel {
+			continue
+		}
+		if sentPost.ChainID != "" && k.CounterpartyChainID(ctx, channel.PortId, channel.ChannelId) != sentPost.ChainID {
+			continue
+		}
+		if found {
+			// Ambiguous, the destination is the counterparty of several channels
+			return "", "", false
+		}
+		port, channelID, found = channel.PortId, channel.ChannelId, true
+	}
+	return port, channelID, found
+}
+
+// ReceivedPostChannel returns the port and channel on this chain of the channel whose counterparty is the sending
+// end of a received post. The channel must be the only one bound to the module port with this counterparty.
+func (k Keeper) ReceivedPostChannel(ctx sdk.Context, sourcePort, sourceChannel string) (port, channelID string, found bool) {
+	boundPort := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != boundPort ||
+			channel.Counterparty.PortId != sourcePort ||
+			channel.Counterparty.ChannelId != sourceChannel {
+			continue
+		}
+		if found {
+			// Ambiguous, the sending end is the counterparty of several channels
+			return "", "", false
+		}
+		port, channelID, found = channel.PortId, channel.ChannelId, true
+	}
+	return port, channelID, found
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestReceivedPostChannel(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+
+	port, channelID, found := keeper.ReceivedPostChannel(ctx, types.PortID, keepertest.CounterpartyChannelID)
+	require.True(t, found)
+	require.Equal(t, types.PortID, port)
+	require.Equal(t, keepertest.ChannelID, channelID)
+	require.Equal(t, keepertest.CounterpartyChainID, keeper.CounterpartyChainID(ctx, port, channelID))
+
+	// Only the channels bound to the module port are resolved
+	_, _, found = keeper.ReceivedPostChannel(ctx, "transfer", "channel-8")
+	require.False(t, found)
+	_, _, found = keeper.ReceivedPostChannel(ctx, types.PortID, "channel-9")
+	require.False(t, found)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNFailedPost(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.FailedPost {
+	items := make([]types.FailedPost, n)
+	for i := range items {
+		items[i].Id = keeper.AppendFailedPost(ctx, items[i])
+	}
+	return items
+}
+
+func TestFailedPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNFailedPost(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetFailedPost(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestFailedPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNFailedPost(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveFailedPost(ctx, item.Id)
+		_, found := keeper.GetFailedPost(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestFailedPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNFailedPost(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllFailedPost(ctx)),
+	)
+}
+
+func TestFailedPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNFailedPost(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetFailedPostCount(ctx))
+}
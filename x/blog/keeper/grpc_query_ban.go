@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) BannedAuthors(c context.Context, req *types.QueryBannedAuthorsRequest) (*types.QueryBannedAuthorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bannedAuthors []types.BannedAuthor
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The authors banned on a channel share the key prefix of the channel
+	keyPrefix := types.KeyPrefix(types.BannedAuthorKey)
+	if req.ChannelID != "" {
+		keyPrefix = append(keyPrefix, types.BannedAuthorChannelKey(req.ChannelID)...)
+	}
+	bannedAuthorStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(bannedAuthorStore, req.Pagination, func(key []byte, value []byte) error {
+		var bannedAuthor types.BannedAuthor
+		if err := k.cdc.Unmarshal(value, &bannedAuthor); err != nil {
+			return err
+		}
+
+		bannedAuthors = append(bannedAuthors, bannedAuthor)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBannedAuthorsResponse{BannedAuthor: bannedAuthors, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BannedChannels(c context.Context, req *types.QueryBannedChannelsRequest) (*types.QueryBannedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bannedChannels []types.BannedChannel
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	bannedChannelStore := prefix.NewStore(store, types.KeyPrefix(types.BannedChannelKey))
+
+	pageRes, err := query.Paginate(bannedChannelStore, req.Pagination, func(key []byte, value []byte) error {
+		var bannedChannel types.BannedChannel
+		if err := k.cdc.Unmarshal(value, &bannedChannel); err != nil {
+			return err
+		}
+
+		bannedChannels = append(bannedChannels, bannedChannel)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBannedChannelsResponse{BannedChannel: bannedChannels, Pagination: pageRes}, nil
+}
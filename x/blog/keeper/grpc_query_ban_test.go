@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestBannedAuthorsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNBannedAuthor(keeper, ctx, 5)
+
+	t.Run("Paginated", func(t *testing.T) {
+		var next []byte
+		for i := 0; i < len(msgs); i += 2 {
+			resp, err := keeper.BannedAuthors(wctx, &types.QueryBannedAuthorsRequest{Pagination: &query.PageRequest{Key: next, Limit: 2}})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.BannedAuthor), 2)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.BannedAuthor),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("ByChannel", func(t *testing.T) {
+		resp, err := keeper.BannedAuthors(wctx, &types.QueryBannedAuthorsRequest{ChannelID: "channel-1", Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, 2, int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill([]types.BannedAuthor{msgs[1], msgs[3]}),
+			nullify.Fill(resp.BannedAuthor),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.BannedAuthors(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestBannedChannelsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNBannedChannel(keeper, ctx, 5)
+
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.BannedChannels(wctx, &types.QueryBannedChannelsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.BannedChannel),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.BannedChannels(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) FailedPostAll(c context.Context, req *types.QueryAllFailedPostRequest) (*types.QueryAllFailedPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var failedPosts []types.FailedPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	failedPostStore := prefix.NewStore(store, types.KeyPrefix(types.FailedPostKey))
+
+	pageRes, err := query.Paginate(failedPostStore, req.Pagination, func(key []byte, value []byte) error {
+		var failedPost types.FailedPost
+		if err := k.cdc.Unmarshal(value, &failedPost); err != nil {
+			return err
+		}
+
+		failedPosts = append(failedPosts, failedPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFailedPostResponse{FailedPost: failedPosts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FailedPost(c context.Context, req *types.QueryGetFailedPostRequest) (*types.QueryGetFailedPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	failedPost, found := k.GetFailedPost(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetFailedPostResponse{FailedPost: failedPost}, nil
+}
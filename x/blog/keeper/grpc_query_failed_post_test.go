@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestFailedPostQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFailedPost(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetFailedPostRequest
+		response *types.QueryGetFailedPostResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetFailedPostRequest{Id: msgs[0].Id},
+			response: &types.QueryGetFailedPostResponse{FailedPost: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetFailedPostRequest{Id: msgs[1].Id},
+			response: &types.QueryGetFailedPostResponse{FailedPost: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetFailedPostRequest{Id: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.FailedPost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestFailedPostQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFailedPost(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllFailedPostRequest {
+		return &types.QueryAllFailedPostRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FailedPostAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedPost),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FailedPostAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedPost),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.FailedPostAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.FailedPost),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.FailedPostAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) InboundPostAll(c context.Context, req *types.QueryAllInboundPostRequest) (*types.QueryAllInboundPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var inboundPosts []types.InboundPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	inboundPostStore := prefix.NewStore(store, types.KeyPrefix(types.InboundPostKey))
+
+	pageRes, err := query.Paginate(inboundPostStore, req.Pagination, func(key []byte, value []byte) error {
+		var inboundPost types.InboundPost
+		if err := k.cdc.Unmarshal(value, &inboundPost); err != nil {
+			return err
+		}
+
+		inboundPosts = append(inboundPosts, inboundPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllInboundPostResponse{InboundPost: inboundPosts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InboundPost(c context.Context, req *types.QueryGetInboundPostRequest) (*types.QueryGetInboundPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inboundPost, found := k.GetInboundPost(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetInboundPostResponse{InboundPost: inboundPost}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) Moderators(c context.Context, req *types.QueryModeratorsRequest) (*types.QueryModeratorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var moderators []types.Moderator
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	moderatorStore := prefix.NewStore(store, types.KeyPrefix(types.ModeratorKey))
+
+	pageRes, err := query.Paginate(moderatorStore, req.Pagination, func(key []byte, value []byte) error {
+		var moderator types.Moderator
+		if err := k.cdc.Unmarshal(value, &moderator); err != nil {
+			return err
+		}
+
+		moderators = append(moderators, moderator)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModeratorsResponse{Moderator: moderators, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ModerationLog(c context.Context, req *types.QueryModerationLogRequest) (*types.QueryModerationLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var moderationActions []types.ModerationAction
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	moderationActionStore := prefix.NewStore(store, types.KeyPrefix(types.ModerationActionKey))
+
+	pageRes, err := query.Paginate(moderationActionStore, req.Pagination, func(key []byte, value []byte) error {
+		var moderationAction types.ModerationAction
+		if err := k.cdc.Unmarshal(value, &moderationAction); err != nil {
+			return err
+		}
+
+		moderationActions = append(moderationActions, moderationAction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModerationLogResponse{ModerationAction: moderationActions, Pagination: pageRes}, nil
+}
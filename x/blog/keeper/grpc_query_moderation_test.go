@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestModeratorsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNModerator(keeper, ctx, 5)
+
+	t.Run("Paginated", func(t *testing.T) {
+		var next []byte
+		for i := 0; i < len(msgs); i += 2 {
+			resp, err := keeper.Moderators(wctx, &types.QueryModeratorsRequest{Pagination: &query.PageRequest{Key: next, Limit: 2}})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Moderator), 2)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Moderator),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Moderators(wctx, &types.QueryModeratorsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Moderator),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.Moderators(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestModerationLogQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNModerationAction(keeper, ctx, 5)
+
+	t.Run("Oldest first", func(t *testing.T) {
+		resp, err := keeper.ModerationLog(wctx, &types.QueryModerationLogRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.Equal(t,
+			nullify.Fill(msgs[:3]),
+			nullify.Fill(resp.ModerationAction),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ModerationLog(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) Notifications(c context.Context, req *types.QueryNotificationsRequest) (*types.QueryNotificationsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var notifications []types.Notification
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The unread notifications are paginated over their index
+	if req.UnreadOnly {
+		pageRes, err := k.paginateIndex(ctx, types.NotificationUnreadKey, req.Address, req.Pagination, func(id uint64) error {
+			notification, found := k.GetNotification(ctx, req.Address, id)
+			if !found {
+				return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "indexed notification %d doesn't exist", id)
+			}
+
+			notifications = append(notifications, notification)
+			return nil
+		})
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryNotificationsResponse{Notification: notifications, Pagination: pageRes}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	notificationStore := prefix.NewStore(store, append(types.KeyPrefix(types.NotificationKeyPrefix), types.NotificationAddressKey(req.Address)...))
+
+	pageRes, err := query.Paginate(notificationStore, req.Pagination, func(key []byte, value []byte) error {
+		var notification types.Notification
+		if err := k.cdc.Unmarshal(value, &notification); err != nil {
+			return err
+		}
+
+		notifications = append(notifications, notification)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNotificationsResponse{Notification: notifications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Notification(c context.Context, req *types.QueryGetNotificationRequest) (*types.QueryGetNotificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	notification, found := k.GetNotification(ctx, req.Address, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetNotificationResponse{Notification: notification}, nil
+}
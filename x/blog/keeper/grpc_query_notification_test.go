@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestNotificationQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNNotification(keeper, ctx, "A", 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetNotificationRequest
+		response *types.QueryGetNotificationResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetNotificationRequest{Address: "A", Id: 0},
+			response: &types.QueryGetNotificationResponse{Notification: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetNotificationRequest{Address: "A", Id: 1},
+			response: &types.QueryGetNotificationResponse{Notification: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetNotificationRequest{Address: "B", Id: 0},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Notification(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestNotificationsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNNotification(keeper, ctx, "A", 5)
+	// Notifications of other addresses aren't returned
+	createNNotification(keeper, ctx, "AA", 2)
+	msgs[1].Read = true
+	keeper.SetNotification(ctx, msgs[1])
+	unread := append([]types.Notification{msgs[0]}, msgs[2:]...)
+
+	for _, tc := range []struct {
+		desc       string
+		unreadOnly bool
+		msgs       []types.Notification
+	}{
+		{desc: "All", msgs: msgs},
+		{desc: "UnreadOnly", unreadOnly: true, msgs: unread},
+	} {
+		request := func(next []byte, offset, limit uint64, total bool) *types.QueryNotificationsRequest {
+			return &types.QueryNotificationsRequest{
+				Address:    "A",
+				UnreadOnly: tc.unreadOnly,
+				Pagination: &query.PageRequest{
+					Key:        next,
+					Offset:     offset,
+					Limit:      limit,
+					CountTotal: total,
+				},
+			}
+		}
+		t.Run(tc.desc+"/ByOffset", func(t *testing.T) {
+			step := 2
+			for i := 0; i < len(tc.msgs); i += step {
+				resp, err := keeper.Notifications(wctx, request(nil, uint64(i), uint64(step), false))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Notification), step)
+				require.Subset(t,
+					nullify.Fill(tc.msgs),
+					nullify.Fill(resp.Notification),
+				)
+			}
+		})
+		t.Run(tc.desc+"/ByKey", func(t *testing.T) {
+			step := 2
+			var next []byte
+			for i := 0; i < len(tc.msgs); i += step {
+				resp, err := keeper.Notifications(wctx, request(next, 0, uint64(step), false))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Notification), step)
+				require.Subset(t,
+					nullify.Fill(tc.msgs),
+					nullify.Fill(resp.Notification),
+				)
+				next = resp.Pagination.NextKey
+			}
+		})
+		t.Run(tc.desc+"/Total", func(t *testing.T) {
+			resp, err := keeper.Notifications(wctx, request(nil, 0, 0, true))
+			require.NoError(t, err)
+			require.Equal(t, len(tc.msgs), int(resp.Pagination.Total))
+			require.Equal(t,
+				nullify.Fill(tc.msgs),
+				nullify.Fill(resp.Notification),
+			)
+		})
+	}
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.Notifications(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
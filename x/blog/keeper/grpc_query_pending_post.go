@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) PendingPostAll(c context.Context, req *types.QueryAllPendingPostRequest) (*types.QueryAllPendingPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingPosts []types.PendingPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingPostStore := prefix.NewStore(store, types.KeyPrefix(types.PendingPostKeyPrefix))
+
+	pageRes, err := query.Paginate(pendingPostStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingPost types.PendingPost
+		if err := k.cdc.Unmarshal(value, &pendingPost); err != nil {
+			return err
+		}
+
+		pendingPosts = append(pendingPosts, pendingPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingPostResponse{PendingPost: pendingPosts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingPost(c context.Context, req *types.QueryGetPendingPostRequest) (*types.QueryGetPendingPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPendingPost(
+		ctx,
+		req.Port,
+		req.ChannelID,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingPostResponse{PendingPost: val}, nil
+}
+
+func (k Keeper) PendingPostsByCreator(c context.Context, req *types.QueryPendingPostsByCreatorRequest) (*types.QueryPendingPostsByCreatorResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingPosts []types.PendingPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingPostStore := prefix.NewStore(store, types.KeyPrefix(types.PendingPostKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(pendingPostStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var pendingPost types.PendingPost
+		if err := k.cdc.Unmarshal(value, &pendingPost); err != nil {
+			return false, err
+		}
+
+		if pendingPost.Creator != req.Creator {
+			return false, nil
+		}
+
+		if accumulate {
+			pendingPosts = append(pendingPosts, pendingPost)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingPostsByCreatorResponse{PendingPost: pendingPosts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestPendingPostQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPendingPost(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetPendingPostRequest
+		response *types.QueryGetPendingPostResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetPendingPostRequest{
+				Port:      msgs[0].Port,
+				ChannelID: msgs[0].ChannelID,
+				Sequence:  msgs[0].Sequence,
+			},
+			response: &types.QueryGetPendingPostResponse{PendingPost: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetPendingPostRequest{
+				Port:      msgs[1].Port,
+				ChannelID: msgs[1].ChannelID,
+				Sequence:  msgs[1].Sequence,
+			},
+			response: &types.QueryGetPendingPostResponse{PendingPost: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetPendingPostRequest{
+				Port:      strconv.Itoa(100000),
+				ChannelID: strconv.Itoa(100000),
+				Sequence:  100000,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PendingPost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPendingPostQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPendingPost(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPendingPostRequest {
+		return &types.QueryAllPendingPostRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PendingPostAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PendingPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PendingPost),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PendingPostAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PendingPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PendingPost),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PendingPostAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PendingPost),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PendingPostAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestPendingPostQueryByCreator(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPendingPost(keeper, ctx, 5)
+	for i := range msgs {
+		msgs[i].Creator = "B"
+		if i%2 == 0 {
+			msgs[i].Creator = "A"
+		}
+		keeper.SetPendingPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.PendingPostsByCreator(wctx, &types.QueryPendingPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.ElementsMatch(t,
+		nullify.Fill([]types.PendingPost{msgs[0], msgs[2], msgs[4]}),
+		nullify.Fill(resp.PendingPost),
+	)
+
+	_, err = keeper.PendingPostsByCreator(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -16,6 +16,9 @@ func (k Keeper) PostAll(c context.Context, req *types.QueryAllPostRequest) (*typ
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.Origin != "" && req.Origin != types.PostOriginLocal && req.Origin != types.PostOriginRemote {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid origin %s", req.Origin)
+	}
 
 	var posts []types.Post
 	ctx := sdk.UnwrapSDKContext(c)
@@ -23,14 +26,20 @@ func (k Keeper) PostAll(c context.Context, req *types.QueryAllPostRequest) (*typ
 	store := ctx.KVStore(k.storeKey)
 	postStore := prefix.NewStore(store, types.KeyPrefix(types.PostKey))
 
-	pageRes, err := query.Paginate(postStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(postStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var post types.Post
 		if err := k.cdc.Unmarshal(value, &post); err != nil {
-			return err
+			return false, err
+		}
+
+		if !post.MatchOrigin(req.Origin) || (post.Hidden && !req.IncludeHidden) {
+			return false, nil
 		}
 
-		posts = append(posts, post)
-		return nil
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -47,9 +56,100 @@ func (k Keeper) Post(c context.Context, req *types.QueryGetPostRequest) (*types.
 
 	ctx := sdk.UnwrapSDKContext(c)
 	post, found := k.GetPost(ctx, req.Id)
-	if !found {
+	if !found || (post.Hidden && !req.IncludeHidden) {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryGetPostResponse{Post: post}, nil
+	return &types.QueryGetPostResponse{
+		Post:      post,
+		Reactions: k.GetReactionCounts(ctx, types.CommentPostKindPost, post.Id),
+	}, nil
+}
+
+func (k Keeper) PostsByCreator(c context.Context, req *types.QueryPostsByCreatorRequest) (*types.QueryPostsByCreatorResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var posts []types.Post
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageRes, err := k.filteredPaginateIndex(ctx, types.PostByCreatorKey, req.Creator, req.Pagination, k.collectPosts(ctx, &posts, req.IncludeHidden))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostsByCreatorResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostsBySourceChannel(c context.Context, req *types.QueryPostsBySourceChannelRequest) (*types.QueryPostsBySourceChannelResponse, error) {
+	if req == nil || req.ChannelID == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var posts []types.Post
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageRes, err := k.filteredPaginateIndex(ctx, types.PostBySourceChannelKey, req.ChannelID, req.Pagination, k.collectPosts(ctx, &posts, req.IncludeHidden))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostsBySourceChannelResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostsByRemoteAuthor(c context.Context, req *types.QueryPostsByRemoteAuthorRequest) (*types.QueryPostsByRemoteAuthorResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var posts []types.Post
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexValue := types.RemoteAuthorIndexValue(req.ChainID, req.Address)
+	pageRes, err := k.filteredPaginateIndex(ctx, types.PostByRemoteAuthorKey, indexValue, req.Pagination, k.collectPosts(ctx, &posts, req.IncludeHidden))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostsByRemoteAuthorResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostsByTag(c context.Context, req *types.QueryPostsByTagRequest) (*types.QueryPostsByTagResponse, error) {
+	if req == nil || types.NormalizeTag(req.Tag) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var posts []types.Post
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageRes, err := k.filteredPaginateIndex(ctx, types.PostByTagKey, types.NormalizeTag(req.Tag), req.Pagination, k.collectPosts(ctx, &posts, req.IncludeHidden))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostsByTagResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+// collectPosts returns the callback of a filtered pagination over post ids appending the posts of the page to the
+// list, the hidden posts are left out unless includeHidden is true
+func (k Keeper) collectPosts(ctx sdk.Context, posts *[]types.Post, includeHidden bool) func(id uint64, accumulate bool) (bool, error) {
+	return func(id uint64, accumulate bool) (bool, error) {
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			return false, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "indexed post %d doesn't exist", id)
+		}
+		if post.Hidden && !includeHidden {
+			return false, nil
+		}
+
+		if accumulate {
+			*posts = append(*posts, post)
+		}
+		return true, nil
+	}
 }
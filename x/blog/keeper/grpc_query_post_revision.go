@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) PostRevisions(c context.Context, req *types.QueryPostRevisionsRequest) (*types.QueryPostRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var postRevisions []types.PostRevision
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	postRevisionStore := prefix.NewStore(store, append(types.KeyPrefix(types.PostRevisionKeyPrefix), types.PostRevisionPostKey(req.PostID)...))
+
+	pageRes, err := query.Paginate(postRevisionStore, req.Pagination, func(key []byte, value []byte) error {
+		var postRevision types.PostRevision
+		if err := k.cdc.Unmarshal(value, &postRevision); err != nil {
+			return err
+		}
+
+		postRevisions = append(postRevisions, postRevision)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostRevisionsResponse{PostRevision: postRevisions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostAtRevision(c context.Context, req *types.QueryPostAtRevisionRequest) (*types.QueryPostAtRevisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	postRevision, found := k.GetPostRevision(ctx, req.PostID, req.Revision)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryPostAtRevisionResponse{PostRevision: postRevision}, nil
+}
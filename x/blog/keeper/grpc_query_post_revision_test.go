@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNPostRevision(keeper *keeper.Keeper, ctx sdk.Context, postID uint64, n int) []types.PostRevision {
+	items := make([]types.PostRevision, n)
+	for i := range items {
+		items[i].PostID = postID
+		items[i].Revision = keeper.AppendPostRevision(ctx, items[i])
+	}
+	return items
+}
+
+func TestPostAtRevisionQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPostRevision(keeper, ctx, 1, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPostAtRevisionRequest
+		response *types.QueryPostAtRevisionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryPostAtRevisionRequest{PostID: 1, Revision: 0},
+			response: &types.QueryPostAtRevisionResponse{PostRevision: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryPostAtRevisionRequest{PostID: 1, Revision: 1},
+			response: &types.QueryPostAtRevisionResponse{PostRevision: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryPostAtRevisionRequest{PostID: 0, Revision: 0},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PostAtRevision(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPostRevisionsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPostRevision(keeper, ctx, 1, 5)
+	// Revisions of other posts aren't returned
+	createNPostRevision(keeper, ctx, 0, 2)
+	createNPostRevision(keeper, ctx, 2, 2)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPostRevisionsRequest {
+		return &types.QueryPostRevisionsRequest{
+			PostID: 1,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostRevisions(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PostRevision),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostRevisions(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PostRevision),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PostRevisions(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		// The revisions are ordered from the oldest
+		require.Equal(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PostRevision),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PostRevisions(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -19,6 +19,7 @@ func TestPostQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	msgs := createNPost(keeper, ctx, 2)
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, PostID: msgs[1].Id, Reaction: types.ReactionLike, Creator: "A"})
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetPostRequest
@@ -31,9 +32,12 @@ func TestPostQuerySingle(t *testing.T) {
 			response: &types.QueryGetPostResponse{Post: msgs[0]},
 		},
 		{
-			desc:     "Second",
-			request:  &types.QueryGetPostRequest{Id: msgs[1].Id},
-			response: &types.QueryGetPostResponse{Post: msgs[1]},
+			desc:    "Second",
+			request: &types.QueryGetPostRequest{Id: msgs[1].Id},
+			response: &types.QueryGetPostResponse{
+				Post:      msgs[1],
+				Reactions: []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}},
+			},
 		},
 		{
 			desc:    "KeyNotFound",
@@ -115,3 +119,221 @@ func TestPostQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestPostQueryHidden(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 3)
+	msgs[1].Hidden = true
+	msgs[1].HiddenReason = "spam"
+	keeper.SetPost(ctx, msgs[1])
+
+	resp, err := keeper.PostAll(wctx, &types.QueryAllPostRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.Post{msgs[0], msgs[2]}),
+		nullify.Fill(resp.Post),
+	)
+	resp, err = keeper.PostAll(wctx, &types.QueryAllPostRequest{IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill(msgs),
+		nullify.Fill(resp.Post),
+	)
+
+	_, err = keeper.Post(wctx, &types.QueryGetPostRequest{Id: msgs[1].Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	postResp, err := keeper.Post(wctx, &types.QueryGetPostRequest{Id: msgs[1].Id, IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill(&msgs[1]),
+		nullify.Fill(&postResp.Post),
+	)
+}
+
+func TestPostQueryByCreator(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 5)
+	for i := range msgs {
+		msgs[i].Creator = "B"
+		if i%2 == 0 {
+			msgs[i].Creator = "A"
+		}
+		keeper.SetPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.PostsByCreator(wctx, &types.QueryPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.Post{msgs[0], msgs[2], msgs[4]}),
+		nullify.Fill(resp.Post),
+	)
+
+	_, err = keeper.PostsByCreator(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPostQueryBySourceChannel(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 5)
+	for i := range msgs {
+		// Local posts are not indexed
+		if i == 0 {
+			continue
+		}
+		msgs[i].RemoteAuthor = &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", Address: "A"}
+		if i%2 == 0 {
+			msgs[i].RemoteAuthor.SourceChannel = "channel-10"
+		}
+		keeper.SetPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.PostsBySourceChannel(wctx, &types.QueryPostsBySourceChannelRequest{
+		ChannelID:  "channel-1",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.Post{msgs[1], msgs[3]}),
+		nullify.Fill(resp.Post),
+	)
+
+	_, err = keeper.PostsBySourceChannel(wctx, &types.QueryPostsBySourceChannelRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPostQueryByRemoteAuthor(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 5)
+	for i := range msgs {
+		// Local posts are not indexed
+		if i == 0 {
+			msgs[i].Creator = "A"
+			keeper.SetPost(ctx, msgs[i])
+			continue
+		}
+		msgs[i].RemoteAuthor = &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", ChainID: "mars", Address: "A"}
+		if i%2 == 0 {
+			msgs[i].RemoteAuthor.ChainID = "venus"
+		}
+		keeper.SetPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.PostsByRemoteAuthor(wctx, &types.QueryPostsByRemoteAuthorRequest{
+		ChainID:    "mars",
+		Address:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.Post{msgs[1], msgs[3]}),
+		nullify.Fill(resp.Post),
+	)
+
+	_, err = keeper.PostsByRemoteAuthor(wctx, &types.QueryPostsByRemoteAuthorRequest{ChainID: "mars"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPostQueryByIndexHidden(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 3)
+	for i := range msgs {
+		msgs[i].Creator = "A"
+		msgs[i].RemoteAuthor = &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", ChainID: "mars", Address: "A"}
+		keeper.SetPost(ctx, msgs[i])
+	}
+	msgs[1].Hidden = true
+	msgs[1].HiddenReason = "spam"
+	keeper.SetPost(ctx, msgs[1])
+	visible := []types.Post{msgs[0], msgs[2]}
+
+	byCreator, err := keeper.PostsByCreator(wctx, &types.QueryPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(byCreator.Pagination.Total))
+	require.Equal(t, nullify.Fill(visible), nullify.Fill(byCreator.Post))
+	byCreator, err = keeper.PostsByCreator(wctx, &types.QueryPostsByCreatorRequest{Creator: "A", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(msgs), nullify.Fill(byCreator.Post))
+
+	byChannel, err := keeper.PostsBySourceChannel(wctx, &types.QueryPostsBySourceChannelRequest{
+		ChannelID:  "channel-1",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(byChannel.Pagination.Total))
+	require.Equal(t, nullify.Fill(visible), nullify.Fill(byChannel.Post))
+	byChannel, err = keeper.PostsBySourceChannel(wctx, &types.QueryPostsBySourceChannelRequest{ChannelID: "channel-1", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(msgs), nullify.Fill(byChannel.Post))
+
+	byAuthor, err := keeper.PostsByRemoteAuthor(wctx, &types.QueryPostsByRemoteAuthorRequest{
+		ChainID:    "mars",
+		Address:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(byAuthor.Pagination.Total))
+	require.Equal(t, nullify.Fill(visible), nullify.Fill(byAuthor.Post))
+	byAuthor, err = keeper.PostsByRemoteAuthor(wctx, &types.QueryPostsByRemoteAuthorRequest{ChainID: "mars", Address: "A", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(msgs), nullify.Fill(byAuthor.Post))
+
+	// A page is filled with the visible posts only
+	byCreator, err = keeper.PostsByCreator(wctx, &types.QueryPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(visible[1:]), nullify.Fill(byCreator.Post))
+}
+
+func TestPostQueryByOrigin(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPost(keeper, ctx, 5)
+	for i := range msgs {
+		if i%2 == 0 {
+			msgs[i].RemoteAuthor = &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", Address: "A"}
+			keeper.SetPost(ctx, msgs[i])
+		}
+	}
+
+	for _, tc := range []struct {
+		origin string
+		posts  []types.Post
+	}{
+		{origin: "", posts: msgs},
+		{origin: types.PostOriginLocal, posts: []types.Post{msgs[1], msgs[3]}},
+		{origin: types.PostOriginRemote, posts: []types.Post{msgs[0], msgs[2], msgs[4]}},
+	} {
+		t.Run(tc.origin, func(t *testing.T) {
+			resp, err := keeper.PostAll(wctx, &types.QueryAllPostRequest{
+				Origin:     tc.origin,
+				Pagination: &query.PageRequest{CountTotal: true},
+			})
+			require.NoError(t, err)
+			require.Equal(t, len(tc.posts), int(resp.Pagination.Total))
+			require.Equal(t,
+				nullify.Fill(tc.posts),
+				nullify.Fill(resp.Post),
+			)
+		})
+	}
+
+	_, err := keeper.PostAll(wctx, &types.QueryAllPostRequest{Origin: "unknown"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid origin unknown"))
+}
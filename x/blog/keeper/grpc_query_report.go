@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) ReportedPosts(c context.Context, req *types.QueryReportedPostsRequest) (*types.QueryReportedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var reportedPosts []types.ReportedPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	reportCountStore := prefix.NewStore(store, types.KeyPrefix(types.ReportCountKey))
+
+	pageRes, err := query.Paginate(reportCountStore, req.Pagination, func(key []byte, value []byte) error {
+		postID := sdk.BigEndianToUint64(key)
+		post, _ := k.GetPost(ctx, postID)
+
+		reportedPosts = append(reportedPosts, types.ReportedPost{
+			PostID:      postID,
+			ReportCount: sdk.BigEndianToUint64(value),
+			Hidden:      post.Hidden,
+			Reports:     k.GetPostReports(ctx, postID),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReportedPostsResponse{ReportedPost: reportedPosts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestReportedPostsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	posts := createNPost(keeper, ctx, 3)
+	posts[2].Hidden = true
+	keeper.SetPost(ctx, posts[2])
+	reports := []types.Report{
+		{PostID: 0, Reporter: "A", Reason: types.ReportReasonSpam},
+		{PostID: 0, Reporter: "B", Reason: types.ReportReasonAbuse},
+		{PostID: 2, Reporter: "A", Reason: types.ReportReasonIllegal},
+	}
+	for _, report := range reports {
+		keeper.SetReport(ctx, report)
+	}
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := keeper.ReportedPosts(wctx, &types.QueryReportedPostsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, 2, int(resp.Pagination.Total))
+		// The posts without open reports are left out
+		require.Equal(t,
+			nullify.Fill([]types.ReportedPost{
+				{PostID: 0, ReportCount: 2, Reports: reports[:2]},
+				{PostID: 2, ReportCount: 1, Hidden: true, Reports: reports[2:]},
+			}),
+			nullify.Fill(resp.ReportedPost),
+		)
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		resp, err := keeper.ReportedPosts(wctx, &types.QueryReportedPostsRequest{Pagination: &query.PageRequest{Limit: 1}})
+		require.NoError(t, err)
+		require.Len(t, resp.ReportedPost, 1)
+		require.Equal(t, uint64(0), resp.ReportedPost[0].PostID)
+		resp, err = keeper.ReportedPosts(wctx, &types.QueryReportedPostsRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}})
+		require.NoError(t, err)
+		require.Len(t, resp.ReportedPost, 1)
+		require.Equal(t, uint64(2), resp.ReportedPost[0].PostID)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ReportedPosts(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) SearchPosts(c context.Context, req *types.QuerySearchPostsRequest) (*types.QuerySearchPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !types.IsValidSearchOperator(req.Operator) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search operator %s", req.Operator)
+	}
+	tokens := types.SearchTokens(types.MaxSearchQueryTokens+1, req.Query)
+	if len(tokens) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "search query has no searchable word")
+	}
+	if len(tokens) > types.MaxSearchQueryTokens {
+		return nil, status.Errorf(codes.InvalidArgument, "search query has more than %d words", types.MaxSearchQueryTokens)
+	}
+
+	var posts []types.Post
+	ctx := sdk.UnwrapSDKContext(c)
+
+	matchAll := req.Operator != types.SearchOperatorOr
+	pageRes, err := k.paginateSearch(ctx, tokens, matchAll, req.Pagination, k.collectPosts(ctx, &posts, req.IncludeHidden))
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySearchPostsResponse{Post: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestSearchPostsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	posts := []types.Post{
+		{Creator: "A", Title: "Hello Mars", Content: "A post written on earth"},
+		{Creator: "A", Title: "Hello Venus"},
+		{Creator: "A", Title: "Mars and Venus"},
+	}
+	for i := range posts {
+		posts[i].Id = keeper.AppendPost(ctx, posts[i])
+	}
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QuerySearchPostsRequest
+		response []types.Post
+		err      error
+	}{
+		{
+			desc:     "Word",
+			request:  &types.QuerySearchPostsRequest{Query: "hello"},
+			response: posts[:2],
+		},
+		{
+			desc:     "And",
+			request:  &types.QuerySearchPostsRequest{Query: "Mars, Venus!", Operator: types.SearchOperatorAnd},
+			response: posts[2:],
+		},
+		{
+			desc:     "AndContent",
+			request:  &types.QuerySearchPostsRequest{Query: "hello earth"},
+			response: posts[:1],
+		},
+		{
+			desc:     "Or",
+			request:  &types.QuerySearchPostsRequest{Query: "earth venus", Operator: types.SearchOperatorOr},
+			response: posts,
+		},
+		{
+			desc:    "NoMatch",
+			request: &types.QuerySearchPostsRequest{Query: "jupiter", Operator: types.SearchOperatorOr},
+		},
+		{
+			desc:    "InvalidOperator",
+			request: &types.QuerySearchPostsRequest{Query: "hello", Operator: "xor"},
+			err:     status.Error(codes.InvalidArgument, "invalid search operator xor"),
+		},
+		{
+			desc:    "EmptyQuery",
+			request: &types.QuerySearchPostsRequest{Query: "a !"},
+			err:     status.Error(codes.InvalidArgument, "search query has no searchable word"),
+		},
+		{
+			desc:    "TooManyWords",
+			request: &types.QuerySearchPostsRequest{Query: "aa bb cc dd ee ff gg hh ii"},
+			err:     status.Error(codes.InvalidArgument, "search query has more than 8 words"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SearchPosts(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response.Post),
+				)
+			}
+		})
+	}
+}
+
+func TestSearchPostsQueryHidden(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	posts := []types.Post{
+		{Creator: "A", Title: "Hello Mars"},
+		{Creator: "A", Title: "Hello Venus", Hidden: true, HiddenReason: "spam"},
+		{Creator: "A", Title: "Hello Jupiter"},
+	}
+	for i := range posts {
+		posts[i].Id = keeper.AppendPost(ctx, posts[i])
+	}
+
+	resp, err := keeper.SearchPosts(wctx, &types.QuerySearchPostsRequest{
+		Query:      "hello",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, int(resp.Pagination.Total))
+	require.Equal(t, nullify.Fill([]types.Post{posts[0], posts[2]}), nullify.Fill(resp.Post))
+
+	resp, err = keeper.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "venus"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Post)
+
+	resp, err = keeper.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "hello", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(posts), nullify.Fill(resp.Post))
+}
+
+func TestSearchPostsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	matches := make(map[string][]types.Post)
+	for i := 0; i < 15; i++ {
+		post := types.Post{Creator: "A", Title: "hello"}
+		if i%2 == 0 {
+			post.Title = "hello mars"
+		}
+		if i%3 == 0 {
+			post.Title = "jupiter"
+		}
+		post.Id = keeper.AppendPost(ctx, post)
+		if post.Title != "jupiter" {
+			matches[types.SearchOperatorOr] = append(matches[types.SearchOperatorOr], post)
+		}
+		if post.Title == "hello mars" {
+			matches[types.SearchOperatorAnd] = append(matches[types.SearchOperatorAnd], post)
+		}
+	}
+
+	for _, operator := range []string{types.SearchOperatorAnd, types.SearchOperatorOr} {
+		msgs := matches[operator]
+		request := func(next []byte, offset, limit uint64, total bool) *types.QuerySearchPostsRequest {
+			return &types.QuerySearchPostsRequest{
+				Query:    "hello mars",
+				Operator: operator,
+				Pagination: &query.PageRequest{
+					Key:        next,
+					Offset:     offset,
+					Limit:      limit,
+					CountTotal: total,
+				},
+			}
+		}
+		t.Run(operator+"/ByOffset", func(t *testing.T) {
+			step := 2
+			var posts []types.Post
+			for i := 0; i < len(msgs); i += step {
+				resp, err := keeper.SearchPosts(wctx, request(nil, uint64(i), uint64(step), false))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Post), step)
+				posts = append(posts, resp.Post...)
+			}
+			require.Equal(t, nullify.Fill(msgs), nullify.Fill(posts))
+		})
+		t.Run(operator+"/ByKey", func(t *testing.T) {
+			step := 2
+			var (
+				next  []byte
+				posts []types.Post
+			)
+			for i := 0; i < len(msgs); i += step {
+				resp, err := keeper.SearchPosts(wctx, request(next, 0, uint64(step), false))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Post), step)
+				posts = append(posts, resp.Post...)
+				next = resp.Pagination.NextKey
+			}
+			require.Equal(t, nullify.Fill(msgs), nullify.Fill(posts))
+			require.Nil(t, next)
+		})
+		t.Run(operator+"/Total", func(t *testing.T) {
+			resp, err := keeper.SearchPosts(wctx, request(nil, 0, 0, true))
+			require.NoError(t, err)
+			require.Equal(t, len(msgs), int(resp.Pagination.Total))
+			require.Equal(t, nullify.Fill(msgs), nullify.Fill(resp.Post))
+		})
+		t.Run(operator+"/KeyAndOffset", func(t *testing.T) {
+			_, err := keeper.SearchPosts(wctx, request([]byte{1}, 1, 0, false))
+			require.ErrorIs(t, err, status.Error(codes.Internal, "invalid request, either offset or key is expected, got both"))
+		})
+	}
+}
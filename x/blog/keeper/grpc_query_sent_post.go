@@ -51,5 +51,33 @@ func (k Keeper) SentPost(c context.Context, req *types.QueryGetSentPostRequest)
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryGetSentPostResponse{SentPost: sentPost}, nil
+	return &types.QueryGetSentPostResponse{
+		SentPost:  sentPost,
+		Reactions: k.GetReactionCounts(ctx, types.CommentPostKindSentPost, sentPost.Id),
+	}, nil
+}
+
+func (k Keeper) SentPostsByCreator(c context.Context, req *types.QuerySentPostsByCreatorRequest) (*types.QuerySentPostsByCreatorResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sentPosts []types.SentPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageRes, err := k.paginateIndex(ctx, types.SentPostByCreatorKey, req.Creator, req.Pagination, func(id uint64) error {
+		sentPost, found := k.GetSentPost(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "indexed sentPost %d doesn't exist", id)
+		}
+
+		sentPosts = append(sentPosts, sentPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySentPostsByCreatorResponse{SentPost: sentPosts, Pagination: pageRes}, nil
 }
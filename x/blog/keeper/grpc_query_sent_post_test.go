@@ -19,6 +19,12 @@ func TestSentPostQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	msgs := createNSentPost(keeper, ctx, 2)
+	keeper.SetReaction(ctx, types.Reaction{
+		PostKind:     types.CommentPostKindSentPost,
+		PostID:       msgs[1].Id,
+		Reaction:     types.ReactionLike,
+		RemoteAuthor: &types.RemoteAuthor{ChainID: "mars", Address: "A"},
+	})
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetSentPostRequest
@@ -31,9 +37,12 @@ func TestSentPostQuerySingle(t *testing.T) {
 			response: &types.QueryGetSentPostResponse{SentPost: msgs[0]},
 		},
 		{
-			desc:     "Second",
-			request:  &types.QueryGetSentPostRequest{Id: msgs[1].Id},
-			response: &types.QueryGetSentPostResponse{SentPost: msgs[1]},
+			desc:    "Second",
+			request: &types.QueryGetSentPostRequest{Id: msgs[1].Id},
+			response: &types.QueryGetSentPostResponse{
+				SentPost:  msgs[1],
+				Reactions: []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}},
+			},
 		},
 		{
 			desc:    "KeyNotFound",
@@ -115,3 +124,30 @@ func TestSentPostQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestSentPostQueryByCreator(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNSentPost(keeper, ctx, 5)
+	for i := range msgs {
+		msgs[i].Creator = "B"
+		if i%2 == 0 {
+			msgs[i].Creator = "A"
+		}
+		keeper.SetSentPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.SentPostsByCreator(wctx, &types.QuerySentPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.SentPost{msgs[0], msgs[2], msgs[4]}),
+		nullify.Fill(resp.SentPost),
+	)
+
+	_, err = keeper.SentPostsByCreator(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
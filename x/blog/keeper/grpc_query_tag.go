@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) TopTags(c context.Context, req *types.QueryTopTagsRequest) (*types.QueryTopTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tagCounts []types.TagCount
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	byCountStore := prefix.NewStore(store, types.KeyPrefix(types.TagByCountKeyPrefix))
+
+	pageRes, err := query.Paginate(byCountStore, req.Pagination, func(key []byte, _ []byte) error {
+		tag, count := types.ParseTagByCountKey(key)
+		tagCounts = append(tagCounts, types.TagCount{Tag: tag, Count: count})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopTagsResponse{TagCount: tagCounts, Pagination: pageRes}, nil
+}
@@ -53,3 +53,28 @@ func (k Keeper) TimedoutPost(c context.Context, req *types.QueryGetTimedoutPostR
 
 	return &types.QueryGetTimedoutPostResponse{TimedoutPost: timedoutPost}, nil
 }
+
+func (k Keeper) TimedoutPostsByCreator(c context.Context, req *types.QueryTimedoutPostsByCreatorRequest) (*types.QueryTimedoutPostsByCreatorResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var timedoutPosts []types.TimedoutPost
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageRes, err := k.paginateIndex(ctx, types.TimedoutPostByCreatorKey, req.Creator, req.Pagination, func(id uint64) error {
+		timedoutPost, found := k.GetTimedoutPost(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "indexed timedoutPost %d doesn't exist", id)
+		}
+
+		timedoutPosts = append(timedoutPosts, timedoutPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTimedoutPostsByCreatorResponse{TimedoutPost: timedoutPosts, Pagination: pageRes}, nil
+}
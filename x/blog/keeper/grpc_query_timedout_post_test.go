@@ -115,3 +115,30 @@ func TestTimedoutPostQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestTimedoutPostQueryByCreator(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNTimedoutPost(keeper, ctx, 5)
+	for i := range msgs {
+		msgs[i].Creator = "B"
+		if i%2 == 0 {
+			msgs[i].Creator = "A"
+		}
+		keeper.SetTimedoutPost(ctx, msgs[i])
+	}
+
+	resp, err := keeper.TimedoutPostsByCreator(wctx, &types.QueryTimedoutPostsByCreatorRequest{
+		Creator:    "A",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	require.Equal(t,
+		nullify.Fill([]types.TimedoutPost{msgs[0], msgs[2], msgs[4]}),
+		nullify.Fill(resp.TimedoutPost),
+	)
+
+	_, err = keeper.TimedoutPostsByCreator(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"planet/x/blog/types"
+)
+
+// TransmitIbcCommentPacket transmits the packet over IBC with the specified source port and source channel
+// and returns the sequence of the sent packet
+func (k Keeper) TransmitIbcCommentPacket(
+	ctx sdk.Context,
+	packetData types.IbcCommentPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+
+	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	packet := channeltypes.NewPacket(
+		packetBytes,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvIbcCommentPacket processes packet reception, the comment is added to the thread of the post sent to the
+// counterparty
+func (k Keeper) OnRecvIbcCommentPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcCommentPacketData) (packetAck types.IbcCommentPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsSourceChannelAllowed(packet.DestinationChannel) {
+		return packetAck, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot receive comments on channel %s", packet.DestinationChannel)
+	}
+	if err := k.checkBans(ctx, packet.DestinationChannel, data.Creator); err != nil {
+		return packetAck, err
+	}
+	if err := params.ValidatePostLength("", data.Content); err != nil {
+		return packetAck, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	// The counterparty refers to the post with the ID it acknowledged
+	sentPost, found := k.GetSentPostByRemotePost(ctx, packet.SourcePort, packet.SourceChannel, data.PostID)
+	if !found {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no post %d sent to %s/%s", data.PostID, packet.SourcePort, packet.SourceChannel)
+	}
+
+	packetAck.CommentID = k.AppendComment(
+		ctx,
+		types.Comment{
+			PostKind:      types.CommentPostKindSentPost,
+			PostID:        sentPost.Id,
+			Content:       data.Content,
+			CreatedAt:     ctx.BlockTime().Unix(),
+			CreatedHeight: ctx.BlockHeight(),
+			TxHash:        txHash(ctx),
+			RemoteAuthor: &types.RemoteAuthor{
+				SourcePort:         packet.SourcePort,
+				SourceChannel:      packet.SourceChannel,
+				ChainID:            k.CounterpartyChainID(ctx, packet.DestinationPort, packet.DestinationChannel),
+				Address:            data.Creator,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			},
+		},
+	)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementIbcCommentPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementIbcCommentPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcCommentPacketData, ack channeltypes.Acknowledgement) error {
+	comment, found := k.GetCommentByPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		comment.Status = types.CommentStatusFailed
+		comment.Error = dispatchedAck.Error
+		k.SetComment(ctx, comment)
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.IbcCommentPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		comment.Status = types.CommentStatusDelivered
+		comment.RemoteCommentID = packetAck.CommentID
+		k.SetComment(ctx, comment)
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutIbcCommentPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutIbcCommentPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcCommentPacketData) error {
+	comment, found := k.GetCommentByPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	comment.Status = types.CommentStatusTimedout
+	k.SetComment(ctx, comment)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvIbcCommentPacket(t *testing.T) {
+	data := types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"}
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		params         types.Params
+		data           types.IbcCommentPacketData
+		bannedAuthors  []types.BannedAuthor
+		bannedChannels []types.BannedChannel
+		err            error
+	}{
+		{
+			desc:   "Completed",
+			params: types.DefaultParams(),
+			data:   data,
+		},
+		{
+			desc:   "ChannelNotAllowed",
+			params: types.NewParams(10, 10, []string{"channel-5"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrChannelNotAllowed,
+		},
+		{
+			desc:           "ChannelBanned",
+			params:         types.DefaultParams(),
+			data:           data,
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-0"}},
+			err:            types.ErrChannelBanned,
+		},
+		{
+			desc:          "AuthorBanned",
+			params:        types.DefaultParams(),
+			data:          data,
+			bannedAuthors: []types.BannedAuthor{{ChannelID: "channel-0", Address: "A"}},
+			err:           types.ErrAuthorBanned,
+		},
+		{
+			desc:   "ContentTooLong",
+			params: types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrPostTooLong,
+		},
+		{
+			desc:   "PostNotFound",
+			params: types.DefaultParams(),
+			data:   types.IbcCommentPacketData{PostID: 8, Content: "content", Creator: "A"},
+			err:    sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:   "EmptyContent",
+			params: types.DefaultParams(),
+			data:   types.IbcCommentPacketData{PostID: 7, Creator: "A"},
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+			keeper.SetParams(ctx, tc.params)
+			for _, bannedAuthor := range tc.bannedAuthors {
+				keeper.SetBannedAuthor(ctx, bannedAuthor)
+			}
+			for _, bannedChannel := range tc.bannedChannels {
+				keeper.SetBannedChannel(ctx, bannedChannel)
+			}
+			sentPostID := keeper.AppendSentPost(ctx, types.SentPost{
+				PostID:             7,
+				DestinationPort:    packet.SourcePort,
+				DestinationChannel: packet.SourceChannel,
+			})
+
+			ack, err := keeper.OnRecvIbcCommentPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, keeper.GetCommentCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(0), ack.CommentID)
+			comment, found := keeper.GetComment(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, types.CommentPostKindSentPost, comment.PostKind)
+			require.Equal(t, sentPostID, comment.PostID)
+			require.Equal(t, int64(1000), comment.CreatedAt)
+			require.Empty(t, comment.Creator)
+			require.Equal(t, &types.RemoteAuthor{
+				SourcePort:         packet.SourcePort,
+				SourceChannel:      packet.SourceChannel,
+				ChainID:            keepertest.CounterpartyChainID,
+				Address:            data.Creator,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			}, comment.RemoteAuthor)
+		})
+	}
+}
+
+func TestOnAcknowledgementIbcCommentPacket(t *testing.T) {
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"}
+
+	for _, tc := range []struct {
+		desc     string
+		ack      channeltypes.Acknowledgement
+		expected types.Comment
+	}{
+		{
+			desc: "Delivered",
+			ack:  channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcCommentPacketAck{CommentID: 3})),
+			expected: types.Comment{
+				Status:          types.CommentStatusDelivered,
+				RemoteCommentID: 3,
+			},
+		},
+		{
+			desc: "Failed",
+			ack:  channeltypes.NewErrorAcknowledgement(sdkerrors.ErrKeyNotFound),
+			expected: types.Comment{
+				Status: types.CommentStatusFailed,
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			keeper.AppendComment(ctx, types.Comment{
+				PostKind:  types.CommentPostKindPost,
+				PostID:    data.PostID,
+				Status:    types.CommentStatusPending,
+				Port:      packet.SourcePort,
+				ChannelID: packet.SourceChannel,
+				Sequence:  packet.Sequence,
+			})
+
+			require.NoError(t, keeper.OnAcknowledgementIbcCommentPacket(ctx, packet, data, tc.ack))
+
+			comment, found := keeper.GetComment(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, tc.expected.Status, comment.Status)
+			require.Equal(t, tc.expected.RemoteCommentID, comment.RemoteCommentID)
+			require.Equal(t, tc.ack.GetError(), comment.Error)
+			_, found = keeper.GetCommentByPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestOnTimeoutIbcCommentPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"}
+	keeper.AppendComment(ctx, types.Comment{
+		PostKind:  types.CommentPostKindPost,
+		PostID:    data.PostID,
+		Status:    types.CommentStatusPending,
+		Port:      packet.SourcePort,
+		ChannelID: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+	})
+
+	require.NoError(t, keeper.OnTimeoutIbcCommentPacket(ctx, packet, data))
+
+	comment, found := keeper.GetComment(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.CommentStatusTimedout, comment.Status)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"planet/x/blog/types"
+)
+
+// TransmitIbcDeletePostPacket transmits the packet over IBC with the specified source port and source channel
+// and returns the sequence of the sent packet
+func (k Keeper) TransmitIbcDeletePostPacket(
+	ctx sdk.Context,
+	packetData types.IbcDeletePostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+
+	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	packet := channeltypes.NewPacket(
+		packetBytes,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvIbcDeletePostPacket processes packet reception, the post received from the counterparty is removed with
+// its revisions, reports, comments and reactions
+func (k Keeper) OnRecvIbcDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcDeletePostPacketData) (packetAck types.IbcDeletePostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	if _, err := k.receivedPost(ctx, packet, data.PostID, data.Creator); err != nil {
+		return packetAck, err
+	}
+
+	k.RemovePost(ctx, data.PostID)
+	k.RemovePostRevisions(ctx, data.PostID)
+	k.RemovePostReports(ctx, data.PostID)
+	k.RemovePostComments(ctx, types.CommentPostKindPost, data.PostID)
+	k.RemovePostReactions(ctx, types.CommentPostKindPost, data.PostID)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementIbcDeletePostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementIbcDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcDeletePostPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		// The post is still on the receiving chain
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.IbcDeletePostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		// The post has been retracted, it is no longer delivered
+		sentPost, found := k.GetSentPostByRemotePost(ctx, packet.DestinationPort, packet.DestinationChannel, data.PostID)
+		if found {
+			k.RemoveSentPost(ctx, sentPost.Id)
+			k.RemovePostComments(ctx, types.CommentPostKindSentPost, sentPost.Id)
+			k.RemovePostReactions(ctx, types.CommentPostKindSentPost, sentPost.Id)
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutIbcDeletePostPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutIbcDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcDeletePostPacketData) error {
+	// The post is still on the receiving chain
+	return nil
+}
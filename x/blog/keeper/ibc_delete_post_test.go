@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvIbcDeletePostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+	remoteAuthor := &types.RemoteAuthor{
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		Address:            "A",
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		post           types.Post
+		data           types.IbcDeletePostPacketData
+		bannedAuthors  []types.BannedAuthor
+		bannedChannels []types.BannedChannel
+		err            error
+	}{
+		{
+			desc: "Completed",
+			post: types.Post{RemoteAuthor: remoteAuthor},
+			data: types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+		},
+		{
+			desc: "PostNotFound",
+			post: types.Post{RemoteAuthor: remoteAuthor},
+			data: types.IbcDeletePostPacketData{PostID: 1, Creator: "A"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "OtherSourceChannel",
+			post: types.Post{RemoteAuthor: &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-3", Address: "A"}},
+			data: types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "MigratedPostUnknownChannel",
+			post: types.Post{RemoteAuthor: &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", Address: "A"}},
+			data: types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "MigratedPostOtherLocalChannel",
+			post: types.Post{RemoteAuthor: &types.RemoteAuthor{
+				SourcePort:         "blog",
+				SourceChannel:      "channel-1",
+				Address:            "A",
+				DestinationPort:    "blog",
+				DestinationChannel: "channel-4",
+			}},
+			data: types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "OtherCreator",
+			post: types.Post{RemoteAuthor: remoteAuthor},
+			data: types.IbcDeletePostPacketData{PostID: 0, Creator: "B"},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:           "ChannelBanned",
+			post:           types.Post{RemoteAuthor: remoteAuthor},
+			data:           types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-0"}},
+			err:            types.ErrChannelBanned,
+		},
+		{
+			desc:          "AuthorBanned",
+			post:          types.Post{RemoteAuthor: remoteAuthor},
+			data:          types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			bannedAuthors: []types.BannedAuthor{{ChannelID: "channel-0", Address: "A"}},
+			err:           types.ErrAuthorBanned,
+		},
+		{
+			desc: "EmptyCreator",
+			post: types.Post{RemoteAuthor: remoteAuthor},
+			data: types.IbcDeletePostPacketData{PostID: 0},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			for _, bannedAuthor := range tc.bannedAuthors {
+				keeper.SetBannedAuthor(ctx, bannedAuthor)
+			}
+			for _, bannedChannel := range tc.bannedChannels {
+				keeper.SetBannedChannel(ctx, bannedChannel)
+			}
+			keeper.AppendPost(ctx, tc.post)
+			keeper.AppendPostRevision(ctx, types.PostRevision{PostID: 0})
+			keeper.AppendComment(ctx, types.Comment{PostKind: types.CommentPostKindPost, PostID: 0, Creator: "B"})
+			keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, PostID: 0, Reaction: types.ReactionLike, Creator: "B"})
+
+			_, err := keeper.OnRecvIbcDeletePostPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				_, found := keeper.GetPost(ctx, 0)
+				require.True(t, found)
+				return
+			}
+			require.NoError(t, err)
+			_, found := keeper.GetPost(ctx, 0)
+			require.False(t, found)
+			require.Empty(t, keeper.GetPostRevisions(ctx, 0))
+			requirePostThreadRemoved(t, keeper, ctx, types.CommentPostKindPost, 0)
+		})
+	}
+}
+
+func TestOnAcknowledgementIbcDeletePostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+	}
+	data := types.IbcDeletePostPacketData{PostID: 7, Creator: "A"}
+
+	for _, tc := range []struct {
+		desc  string
+		ack   channeltypes.Acknowledgement
+		found bool
+	}{
+		{
+			desc: "Deleted",
+			ack:  channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcDeletePostPacketAck{})),
+		},
+		{
+			desc:  "Failed",
+			ack:   channeltypes.NewErrorAcknowledgement(sdkerrors.ErrUnauthorized),
+			found: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			id := keeper.AppendSentPost(ctx, types.SentPost{
+				PostID:             data.PostID,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			})
+
+			keeper.AppendComment(ctx, types.Comment{PostKind: types.CommentPostKindSentPost, PostID: id, Creator: "B"})
+			keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindSentPost, PostID: id, Reaction: types.ReactionLike, Creator: "B"})
+
+			require.NoError(t, keeper.OnAcknowledgementIbcDeletePostPacket(ctx, packet, data, tc.ack))
+
+			_, found := keeper.GetSentPost(ctx, id)
+			require.Equal(t, tc.found, found)
+			_, found = keeper.GetSentPostByRemotePost(ctx, packet.DestinationPort, packet.DestinationChannel, data.PostID)
+			require.Equal(t, tc.found, found)
+			if tc.found {
+				require.Len(t, keeper.GetAllComment(ctx), 1)
+				require.Len(t, keeper.GetAllReaction(ctx), 1)
+				return
+			}
+			requirePostThreadRemoved(t, keeper, ctx, types.CommentPostKindSentPost, id)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"planet/x/blog/types"
+)
+
+// TransmitIbcEditPostPacket transmits the packet over IBC with the specified source port and source channel
+// and returns the sequence of the sent packet
+func (k Keeper) TransmitIbcEditPostPacket(
+	ctx sdk.Context,
+	packetData types.IbcEditPostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+
+	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	packet := channeltypes.NewPacket(
+		packetBytes,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvIbcEditPostPacket processes packet reception, the post received from the counterparty is edited and the
+// edit is recorded in the revisions of the post
+func (k Keeper) OnRecvIbcEditPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcEditPostPacketData) (packetAck types.IbcEditPostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	post, err := k.receivedPost(ctx, packet, data.PostID, data.Creator)
+	if err != nil {
+		return packetAck, err
+	}
+	if err := k.GetParams(ctx).ValidatePostLength(data.Title, data.Content); err != nil {
+		return packetAck, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	packetAck.Revision = k.EditPost(ctx, post, data.Title, data.Content, data.Creator)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementIbcEditPostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementIbcEditPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcEditPostPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		// The post is unchanged on the receiving chain
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.IbcEditPostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		// Keep the title of the sent post in sync with the edited post
+		sentPost, found := k.GetSentPostByRemotePost(ctx, packet.DestinationPort, packet.DestinationChannel, data.PostID)
+		if found {
+			sentPost.Title = data.Title
+			k.SetSentPost(ctx, sentPost)
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutIbcEditPostPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutIbcEditPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcEditPostPacketData) error {
+	// The post is unchanged on the receiving chain
+	return nil
+}
+
+// receivedPost returns the post received from the counterparty that an edit or a retraction refers to. Only the
+// author of the post can change it, through the channel the post was received on.
+func (k Keeper) receivedPost(ctx sdk.Context, packet channeltypes.Packet, postID uint64, creator string) (types.Post, error) {
+	if !k.GetParams(ctx).IsSourceChannelAllowed(packet.DestinationChannel) {
+		return types.Post{}, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot receive post changes on channel %s", packet.DestinationChannel)
+	}
+	if err := k.checkBans(ctx, packet.DestinationChannel, creator); err != nil {
+		return types.Post{}, err
+	}
+
+	post, found := k.GetPost(ctx, postID)
+	if !found {
+		return post, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "key %d doesn't exist", postID)
+	}
+	if !post.IsRemote() {
+		return post, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "post %d wasn't received from another chain", postID)
+	}
+
+	// The source channel identifies the channel on the counterparty only, any chain can open a channel with the
+	// same identifier on its end. The post must have come through the same channel, identified by both its ends.
+	remoteAuthor := post.RemoteAuthor
+	if !remoteAuthor.IsChannelKnown() {
+		return post, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "the channel post %d was received on is unknown", postID)
+	}
+	if remoteAuthor.SourcePort != packet.SourcePort || remoteAuthor.SourceChannel != packet.SourceChannel ||
+		remoteAuthor.DestinationPort != packet.DestinationPort || remoteAuthor.DestinationChannel != packet.DestinationChannel {
+		return post, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "post %d wasn't received on channel %s", postID, packet.DestinationChannel)
+	}
+	if remoteAuthor.Address != creator {
+		return post, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	return post, nil
+}
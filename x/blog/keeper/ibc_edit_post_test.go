@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvIbcEditPostPacket(t *testing.T) {
+	data := types.IbcEditPostPacketData{PostID: 0, Title: "edited", Content: "edited content", Creator: "A"}
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+	remoteAuthor := &types.RemoteAuthor{
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		Address:            "A",
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		params         types.Params
+		post           types.Post
+		data           types.IbcEditPostPacketData
+		bannedAuthors  []types.BannedAuthor
+		bannedChannels []types.BannedChannel
+		err            error
+	}{
+		{
+			desc:   "Completed",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   data,
+		},
+		{
+			desc:   "MigratedPostUnknownChannel",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", Address: "A"}},
+			data:   data,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "MigratedPostOtherLocalChannel",
+			params: types.DefaultParams(),
+			post: types.Post{Title: "title", RemoteAuthor: &types.RemoteAuthor{
+				SourcePort:         "blog",
+				SourceChannel:      "channel-1",
+				ChainID:            "mars",
+				Address:            "A",
+				DestinationPort:    "blog",
+				DestinationChannel: "channel-4",
+			}},
+			data: data,
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "PostNotFound",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   types.IbcEditPostPacketData{PostID: 1, Title: "edited", Creator: "A"},
+			err:    sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:   "LocalPost",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", Creator: "A"},
+			data:   data,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "OtherSourceChannel",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-3", Address: "A"}},
+			data:   data,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "OtherDestinationChannel",
+			params: types.DefaultParams(),
+			post: types.Post{Title: "title", RemoteAuthor: &types.RemoteAuthor{
+				SourcePort:         "blog",
+				SourceChannel:      "channel-1",
+				Address:            "A",
+				DestinationPort:    "blog",
+				DestinationChannel: "channel-2",
+			}},
+			data: data,
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "OtherCreator",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   types.IbcEditPostPacketData{PostID: 0, Title: "edited", Creator: "B"},
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:   "ChannelNotAllowed",
+			params: types.NewParams(10, 20, []string{"channel-5"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   data,
+			err:    types.ErrChannelNotAllowed,
+		},
+		{
+			desc:           "ChannelBanned",
+			params:         types.DefaultParams(),
+			post:           types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:           data,
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-0"}},
+			err:            types.ErrChannelBanned,
+		},
+		{
+			desc:          "AuthorBanned",
+			params:        types.DefaultParams(),
+			post:          types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:          data,
+			bannedAuthors: []types.BannedAuthor{{ChannelID: "channel-0", Address: "A"}},
+			err:           types.ErrAuthorBanned,
+		},
+		{
+			desc:   "PostTooLong",
+			params: types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   data,
+			err:    types.ErrPostTooLong,
+		},
+		{
+			desc:   "EmptyTitle",
+			params: types.DefaultParams(),
+			post:   types.Post{Title: "title", RemoteAuthor: remoteAuthor},
+			data:   types.IbcEditPostPacketData{PostID: 0, Creator: "A"},
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			keeper.SetParams(ctx, tc.params)
+			for _, bannedAuthor := range tc.bannedAuthors {
+				keeper.SetBannedAuthor(ctx, bannedAuthor)
+			}
+			for _, bannedChannel := range tc.bannedChannels {
+				keeper.SetBannedChannel(ctx, bannedChannel)
+			}
+			keeper.AppendPost(ctx, tc.post)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+			ack, err := keeper.OnRecvIbcEditPostPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				post, found := keeper.GetPost(ctx, 0)
+				require.True(t, found)
+				require.Equal(t, tc.post.Title, post.Title)
+				require.Empty(t, keeper.GetPostRevisions(ctx, 0))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), ack.Revision)
+			post, found := keeper.GetPost(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, data.Title, post.Title)
+			require.Equal(t, data.Content, post.Content)
+			revision, found := keeper.GetPostRevision(ctx, 0, 1)
+			require.True(t, found)
+			require.Equal(t, data.Creator, revision.Editor)
+			require.Equal(t, int64(10), revision.EditedHeight)
+		})
+	}
+}
+
+func TestOnAcknowledgementIbcEditPostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+	}
+	data := types.IbcEditPostPacketData{PostID: 7, Title: "edited", Content: "content", Creator: "A"}
+
+	for _, tc := range []struct {
+		desc  string
+		ack   channeltypes.Acknowledgement
+		title string
+	}{
+		{
+			desc:  "Edited",
+			ack:   channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcEditPostPacketAck{Revision: 1})),
+			title: data.Title,
+		},
+		{
+			desc:  "Failed",
+			ack:   channeltypes.NewErrorAcknowledgement(sdkerrors.ErrUnauthorized),
+			title: "title",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			id := keeper.AppendSentPost(ctx, types.SentPost{
+				Title:              "title",
+				PostID:             data.PostID,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			})
+
+			require.NoError(t, keeper.OnAcknowledgementIbcEditPostPacket(ctx, packet, data, tc.ack))
+
+			sentPost, found := keeper.GetSentPost(ctx, id)
+			require.True(t, found)
+			require.Equal(t, tc.title, sentPost.Title)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 )
 
 // TransmitIbcPostPacket transmits the packet over IBC with the specified source port and source channel
+// and returns the sequence of the sent packet
 func (k Keeper) TransmitIbcPostPacket(
 	ctx sdk.Context,
 	packetData types.IbcPostPacketData,
@@ -19,11 +20,11 @@ func (k Keeper) TransmitIbcPostPacket(
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
-) error {
+) (uint64, error) {
 
 	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
@@ -32,7 +33,7 @@ func (k Keeper) TransmitIbcPostPacket(
 	// get the next sequence
 	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
-		return sdkerrors.Wrapf(
+		return 0, sdkerrors.Wrapf(
 			channeltypes.ErrSequenceSendNotFound,
 			"source port: %s, source channel: %s", sourcePort, sourceChannel,
 		)
@@ -40,12 +41,12 @@ func (k Keeper) TransmitIbcPostPacket(
 
 	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
 	packetBytes, err := packetData.GetBytes()
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
 	}
 
 	packet := channeltypes.NewPacket(
@@ -60,41 +61,93 @@ func (k Keeper) TransmitIbcPostPacket(
 	)
 
 	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return sequence, nil
 }
 
 // OnRecvIbcPostPacket processes packet reception
 func (k Keeper) OnRecvIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) (packetAck types.IbcPostPacketAck, err error) {
-	// validate packet data upon receiving
-	if err := data.ValidateBasic(); err != nil {
+	if err := k.validateIbcPostPacket(ctx, packet, data); err != nil {
 		return packetAck, err
 	}
 
-	id := k.AppendPost(
-		ctx,
-		types.Post{
-			Creator: packet.SourcePort + "-" + packet.SourceChannel + "-" + data.Creator,
-			Title:   data.Title,
-			Content: data.Content,
+	post := types.Post{
+		Title:         data.Title,
+		Content:       data.Content,
+		Tags:          data.Tags,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+		TxHash:        txHash(ctx),
+		RemoteAuthor: &types.RemoteAuthor{
+			SourcePort:         packet.SourcePort,
+			SourceChannel:      packet.SourceChannel,
+			ChainID:            k.CounterpartyChainID(ctx, packet.DestinationPort, packet.DestinationChannel),
+			Address:            data.Creator,
+			DestinationPort:    packet.DestinationPort,
+			DestinationChannel: packet.DestinationChannel,
 		},
-	)
+	}
+	post.Id = k.AppendPost(ctx, post)
+	k.notifyMentions(ctx, post)
 
-	packetAck.PostID = strconv.FormatUint(id, 10)
+	packetAck.PostID = strconv.FormatUint(post.Id, 10)
 
 	return packetAck, nil
 }
 
+// validateIbcPostPacket validates a received post against the params and the bans, before it is published or
+// queued for moderation
+func (k Keeper) validateIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsSourceChannelAllowed(packet.DestinationChannel) {
+		return sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot receive posts on channel %s", packet.DestinationChannel)
+	}
+	if err := k.checkBans(ctx, packet.DestinationChannel, data.Creator); err != nil {
+		return err
+	}
+	if err := params.ValidatePostLength(data.Title, data.Content); err != nil {
+		return sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+	if err := params.ValidatePostTags(data.Tags); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidTags, err.Error())
+	}
+	return nil
+}
+
 // OnAcknowledgementIbcPostPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain.
 func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData, ack channeltypes.Acknowledgement) error {
+	// The packet is no longer in flight whatever the outcome
+	pendingPost, _ := k.GetPendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.RemovePendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-
-		// TODO: failed acknowledgement logic
-		_ = dispatchedAck.Error
+		k.AppendFailedPost(
+			ctx,
+			types.FailedPost{
+				Creator:            data.Creator,
+				Title:              data.Title,
+				ChainID:            k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel),
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+				Error:              dispatchedAck.Error,
+				Content:            data.Content,
+				Tags:               data.Tags,
+				SentAt:             pendingPost.SentAt,
+				SentHeight:         pendingPost.SentHeight,
+				SentTxHash:         pendingPost.SentTxHash,
+				FailedAt:           ctx.BlockTime().Unix(),
+				FailedHeight:       ctx.BlockHeight(),
+			},
+		)
 
 		return nil
 	case *channeltypes.Acknowledgement_Result:
@@ -105,14 +158,28 @@ func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channelty
 			// The counter-party module doesn't implement the correct acknowledgment format
 			return errors.New("cannot unmarshal acknowledgment")
 		}
+		postID, err := strconv.ParseUint(packetAck.PostID, 10, 64)
+		if err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("invalid acknowledgment post ID")
+		}
 
 		k.AppendSentPost(
 			ctx,
 			types.SentPost{
-				Creator: data.Creator,
-				PostID:  packetAck.PostID,
-				Title:   data.Title,
-				Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
+				Creator:            data.Creator,
+				PostID:             postID,
+				Title:              data.Title,
+				ChainID:            k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel),
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+				SourcePort:         packet.SourcePort,
+				SourceChannel:      packet.SourceChannel,
+				SentAt:             pendingPost.SentAt,
+				SentHeight:         pendingPost.SentHeight,
+				SentTxHash:         pendingPost.SentTxHash,
+				AckedAt:            ctx.BlockTime().Unix(),
+				AckedHeight:        ctx.BlockHeight(),
 			},
 		)
 
@@ -125,13 +192,24 @@ func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channelty
 
 // OnTimeoutIbcPostPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) error {
+	pendingPost, _ := k.GetPendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.RemovePendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
 	k.AppendTimedoutPost(
 		ctx,
 		types.TimedoutPost{
-			Creator: data.Creator,
-			Title:   data.Title,
-			Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
+			Creator:            data.Creator,
+			Title:              data.Title,
+			ChainID:            k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel),
+			DestinationPort:    packet.DestinationPort,
+			DestinationChannel: packet.DestinationChannel,
+			Content:            data.Content,
+			Tags:               data.Tags,
+			SentAt:             pendingPost.SentAt,
+			SentHeight:         pendingPost.SentHeight,
+			SentTxHash:         pendingPost.SentTxHash,
+			TimedoutAt:         ctx.BlockTime().Unix(),
+			TimedoutHeight:     ctx.BlockHeight(),
 		},
 	)
 
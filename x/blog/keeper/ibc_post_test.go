@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnAcknowledgementIbcPostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A", Tags: []string{"mars"}}
+	keeper.SetPendingPost(ctx, types.PendingPost{
+		Port:       packet.SourcePort,
+		ChannelID:  packet.SourceChannel,
+		Sequence:   packet.Sequence,
+		Creator:    data.Creator,
+		SentAt:     500,
+		SentHeight: 5,
+		SentTxHash: "ABCD",
+	})
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	ack := channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcPostPacketAck{PostID: "7"}))
+	require.NoError(t, keeper.OnAcknowledgementIbcPostPacket(ctx, packet, data, ack))
+
+	_, found := keeper.GetPendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.False(t, found)
+	sentPost, found := keeper.GetSentPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(7), sentPost.PostID)
+	require.Equal(t, keepertest.CounterpartyChainID, sentPost.ChainID)
+	require.Equal(t, packet.SourcePort, sentPost.SourcePort)
+	require.Equal(t, packet.SourceChannel, sentPost.SourceChannel)
+	require.Equal(t, int64(500), sentPost.SentAt)
+	require.Equal(t, int64(5), sentPost.SentHeight)
+	require.Equal(t, "ABCD", sentPost.SentTxHash)
+	require.Equal(t, int64(1000), sentPost.AckedAt)
+	require.Equal(t, int64(10), sentPost.AckedHeight)
+}
+
+func TestOnAcknowledgementIbcPostPacketInvalidPostID(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A", Tags: []string{"mars"}}
+
+	ack := channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcPostPacketAck{PostID: "post"}))
+	require.Error(t, keeper.OnAcknowledgementIbcPostPacket(ctx, packet, data, ack))
+	require.Zero(t, keeper.GetSentPostCount(ctx))
+}
+
+func TestOnTimeoutIbcPostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A", Tags: []string{"mars"}}
+	keeper.SetPendingPost(ctx, types.PendingPost{
+		Port:       packet.SourcePort,
+		ChannelID:  packet.SourceChannel,
+		Sequence:   packet.Sequence,
+		Creator:    data.Creator,
+		SentAt:     500,
+		SentHeight: 5,
+		SentTxHash: "ABCD",
+	})
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, keeper.OnTimeoutIbcPostPacket(ctx, packet, data))
+
+	_, found := keeper.GetPendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.False(t, found)
+	timedoutPost, found := keeper.GetTimedoutPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, data.Title, timedoutPost.Title)
+	require.Equal(t, data.Tags, timedoutPost.Tags)
+	require.Equal(t, keepertest.CounterpartyChainID, timedoutPost.ChainID)
+	require.Equal(t, int64(500), timedoutPost.SentAt)
+	require.Equal(t, int64(5), timedoutPost.SentHeight)
+	require.Equal(t, "ABCD", timedoutPost.SentTxHash)
+	require.Equal(t, int64(1000), timedoutPost.TimedoutAt)
+	require.Equal(t, int64(10), timedoutPost.TimedoutHeight)
+}
+
+func TestOnAcknowledgementIbcPostPacketError(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+		Sequence:           1,
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A", Tags: []string{"mars"}}
+	keeper.SetPendingPost(ctx, types.PendingPost{
+		Port:       packet.SourcePort,
+		ChannelID:  packet.SourceChannel,
+		Sequence:   packet.Sequence,
+		Creator:    data.Creator,
+		SentAt:     500,
+		SentHeight: 5,
+		SentTxHash: "ABCD",
+	})
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrSample)
+	require.NoError(t, keeper.OnAcknowledgementIbcPostPacket(ctx, packet, data, ack))
+
+	_, found := keeper.GetPendingPost(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.False(t, found)
+	failedPost, found := keeper.GetFailedPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.FailedPost{
+		Id:                 0,
+		Title:              data.Title,
+		ChainID:            keepertest.CounterpartyChainID,
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+		Creator:            data.Creator,
+		Error:              ack.GetError(),
+		Content:            data.Content,
+		Tags:               data.Tags,
+		SentAt:             500,
+		SentHeight:         5,
+		SentTxHash:         "ABCD",
+		FailedAt:           1000,
+		FailedHeight:       10,
+	}, failedPost)
+}
+
+func TestOnRecvIbcPostPacket(t *testing.T) {
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A", Tags: []string{"mars"}}
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		params         types.Params
+		data           types.IbcPostPacketData
+		bannedAuthors  []types.BannedAuthor
+		bannedChannels []types.BannedChannel
+		err            error
+	}{
+		{
+			desc:   "Completed",
+			params: types.DefaultParams(),
+			data:   data,
+		},
+		{
+			desc:   "ChannelAllowed",
+			params: types.NewParams(10, 10, []string{"channel-0"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+		},
+		{
+			desc:   "ChannelNotAllowed",
+			params: types.NewParams(10, 10, []string{"channel-5"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrChannelNotAllowed,
+		},
+		{
+			desc:   "TitleTooLong",
+			params: types.NewParams(2, 10, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrPostTooLong,
+		},
+		{
+			desc:   "ContentTooLong",
+			params: types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrPostTooLong,
+		},
+		{
+			desc:   "EmptyTitle",
+			params: types.DefaultParams(),
+			data:   types.IbcPostPacketData{Creator: "A"},
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "InvalidTag",
+			params: types.DefaultParams(),
+			data:   types.IbcPostPacketData{Title: "title", Creator: "A", Tags: []string{"#mars"}},
+			err:    types.ErrInvalidTags,
+		},
+		{
+			desc:   "TooManyTags",
+			params: types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, 0, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrInvalidTags,
+		},
+		{
+			desc:   "TagTooLong",
+			params: types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, 2, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrInvalidTags,
+		},
+		{
+			desc:           "ChannelBanned",
+			params:         types.DefaultParams(),
+			data:           data,
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-0"}},
+			err:            types.ErrChannelBanned,
+		},
+		{
+			desc:          "AuthorBanned",
+			params:        types.DefaultParams(),
+			data:          data,
+			bannedAuthors: []types.BannedAuthor{{ChannelID: "channel-0", Address: "A"}},
+			err:           types.ErrAuthorBanned,
+		},
+		{
+			desc:           "OtherBans",
+			params:         types.DefaultParams(),
+			data:           data,
+			bannedAuthors:  []types.BannedAuthor{{ChannelID: "channel-1", Address: "A"}, {ChannelID: "channel-0", Address: "B"}},
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-1"}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+			keeper.SetParams(ctx, tc.params)
+			for _, bannedAuthor := range tc.bannedAuthors {
+				keeper.SetBannedAuthor(ctx, bannedAuthor)
+			}
+			for _, bannedChannel := range tc.bannedChannels {
+				keeper.SetBannedChannel(ctx, bannedChannel)
+			}
+
+			ack, err := keeper.OnRecvIbcPostPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, keeper.GetPostCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "0", ack.PostID)
+			post, found := keeper.GetPost(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, int64(1000), post.CreatedAt)
+			require.Equal(t, int64(10), post.CreatedHeight)
+			require.Empty(t, post.Creator)
+			require.Equal(t, data.Tags, post.Tags)
+			require.Equal(t, data.Tags, keeper.GetPostTags(ctx, 0))
+			require.Equal(t, &types.RemoteAuthor{
+				SourcePort:         packet.SourcePort,
+				SourceChannel:      packet.SourceChannel,
+				ChainID:            keepertest.CounterpartyChainID,
+				Address:            data.Creator,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			}, post.RemoteAuthor)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvIbcReactionPacket(t *testing.T) {
+	data := types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"}
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		params         types.Params
+		previous       string
+		data           types.IbcReactionPacketData
+		bannedAuthors  []types.BannedAuthor
+		bannedChannels []types.BannedChannel
+		err            error
+	}{
+		{
+			desc:   "Completed",
+			params: types.DefaultParams(),
+			data:   data,
+		},
+		{
+			desc:     "Replaced",
+			params:   types.DefaultParams(),
+			previous: types.ReactionAngry,
+			data:     data,
+		},
+		{
+			desc:     "AlreadyReacted",
+			params:   types.DefaultParams(),
+			previous: types.ReactionLike,
+			data:     data,
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:   "ChannelNotAllowed",
+			params: types.NewParams(10, 10, []string{"channel-5"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			data:   data,
+			err:    types.ErrChannelNotAllowed,
+		},
+		{
+			desc:           "ChannelBanned",
+			params:         types.DefaultParams(),
+			data:           data,
+			bannedChannels: []types.BannedChannel{{ChannelID: "channel-0"}},
+			err:            types.ErrChannelBanned,
+		},
+		{
+			desc:          "AuthorBanned",
+			params:        types.DefaultParams(),
+			data:          data,
+			bannedAuthors: []types.BannedAuthor{{ChannelID: "channel-0", Address: "A"}},
+			err:           types.ErrAuthorBanned,
+		},
+		{
+			desc:   "PostNotFound",
+			params: types.DefaultParams(),
+			data:   types.IbcReactionPacketData{PostID: 8, Reaction: types.ReactionLike, Creator: "A"},
+			err:    sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:   "UnknownReaction",
+			params: types.DefaultParams(),
+			data:   types.IbcReactionPacketData{PostID: 7, Reaction: "dislike", Creator: "A"},
+			err:    types.ErrInvalidReaction,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+			keeper.SetParams(ctx, tc.params)
+			for _, bannedAuthor := range tc.bannedAuthors {
+				keeper.SetBannedAuthor(ctx, bannedAuthor)
+			}
+			for _, bannedChannel := range tc.bannedChannels {
+				keeper.SetBannedChannel(ctx, bannedChannel)
+			}
+			sentPostID := keeper.AppendSentPost(ctx, types.SentPost{
+				PostID:             7,
+				DestinationPort:    packet.SourcePort,
+				DestinationChannel: packet.SourceChannel,
+			})
+			remoteAuthor := &types.RemoteAuthor{
+				SourcePort:         packet.SourcePort,
+				SourceChannel:      packet.SourceChannel,
+				ChainID:            keepertest.CounterpartyChainID,
+				Address:            data.Creator,
+				DestinationPort:    packet.DestinationPort,
+				DestinationChannel: packet.DestinationChannel,
+			}
+			if tc.previous != "" {
+				keeper.SetReaction(ctx, types.Reaction{
+					PostKind:     types.CommentPostKindSentPost,
+					PostID:       sentPostID,
+					Reaction:     tc.previous,
+					RemoteAuthor: remoteAuthor,
+				})
+			}
+
+			_, err := keeper.OnRecvIbcReactionPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			reaction, found := keeper.GetReaction(ctx, types.CommentPostKindSentPost, sentPostID, types.RemoteAuthorIndexValue(keepertest.CounterpartyChainID, data.Creator))
+			require.True(t, found)
+			require.Equal(t, types.Reaction{
+				PostKind:      types.CommentPostKindSentPost,
+				PostID:        sentPostID,
+				Reaction:      data.Reaction,
+				RemoteAuthor:  remoteAuthor,
+				CreatedAt:     1000,
+				CreatedHeight: 10,
+			}, reaction)
+			require.Equal(t, []types.ReactionCount{{Reaction: data.Reaction, Count: 1}}, keeper.GetReactionCounts(ctx, types.CommentPostKindSentPost, sentPostID))
+		})
+	}
+}
+
+func TestOnAcknowledgementIbcReactionPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{SourcePort: "blog", SourceChannel: "channel-0", Sequence: 1}
+	data := types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"}
+
+	ack := channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.IbcReactionPacketAck{}))
+	require.NoError(t, keeper.OnAcknowledgementIbcReactionPacket(ctx, packet, data, ack))
+	require.NoError(t, keeper.OnAcknowledgementIbcReactionPacket(ctx, packet, data, channeltypes.NewErrorAcknowledgement(sdkerrors.ErrKeyNotFound)))
+	require.Error(t, keeper.OnAcknowledgementIbcReactionPacket(ctx, packet, data, channeltypes.NewResultAcknowledgement([]byte("invalid"))))
+}
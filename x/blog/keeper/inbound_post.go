@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"planet/x/blog/types"
+)
+
+// GetInboundPostCount get the total number of inboundPost
+func (k Keeper) GetInboundPostCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.InboundPostCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetInboundPostCount set the total number of inboundPost
+func (k Keeper) SetInboundPostCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.InboundPostCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendInboundPost appends an inboundPost in the store with a new id and update the count
+func (k Keeper) AppendInboundPost(
+	ctx sdk.Context,
+	inboundPost types.InboundPost,
+) uint64 {
+	// Create the inboundPost
+	count := k.GetInboundPostCount(ctx)
+
+	// Set the ID of the appended value
+	inboundPost.Id = count
+	k.SetInboundPost(ctx, inboundPost)
+
+	// Update inboundPost count
+	k.SetInboundPostCount(ctx, count+1)
+
+	return count
+}
+
+// SetInboundPost set a specific inboundPost in the store
+func (k Keeper) SetInboundPost(ctx sdk.Context, inboundPost types.InboundPost) {
+	if previous, found := k.GetInboundPost(ctx, inboundPost.Id); found {
+		k.removeInboundPostExpiry(ctx, previous)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostKey))
+	b := k.cdc.MustMarshal(&inboundPost)
+	store.Set(GetInboundPostIDBytes(inboundPost.Id), b)
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostByExpiryKey))
+	expiryStore.Set(types.InboundPostExpiryKey(inboundPost.ExpiresAt, inboundPost.Id), GetInboundPostIDBytes(inboundPost.Id))
+}
+
+// GetInboundPost returns an inboundPost from its id
+func (k Keeper) GetInboundPost(ctx sdk.Context, id uint64) (val types.InboundPost, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostKey))
+	b := store.Get(GetInboundPostIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveInboundPost removes an inboundPost from the store
+func (k Keeper) RemoveInboundPost(ctx sdk.Context, id uint64) {
+	if previous, found := k.GetInboundPost(ctx, id); found {
+		k.removeInboundPostExpiry(ctx, previous)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostKey))
+	store.Delete(GetInboundPostIDBytes(id))
+}
+
+// removeInboundPostExpiry removes an inboundPost from the expiry index
+func (k Keeper) removeInboundPostExpiry(ctx sdk.Context, inboundPost types.InboundPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostByExpiryKey))
+	store.Delete(types.InboundPostExpiryKey(inboundPost.ExpiresAt, inboundPost.Id))
+}
+
+// GetAllInboundPost returns all inboundPost
+func (k Keeper) GetAllInboundPost(ctx sdk.Context) (list []types.InboundPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InboundPost
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetInboundPostIDBytes returns the byte representation of the ID
+func GetInboundPostIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetInboundPostIDFromBytes returns ID in uint64 format from a byte array
+func GetInboundPostIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// QueueInboundPost queues a post received on a moderated channel, the post is validated first so that an invalid
+// post is acknowledged with an error right away
+func (k Keeper) QueueInboundPost(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) (uint64, error) {
+	if err := k.validateIbcPostPacket(ctx, packet, data); err != nil {
+		return 0, err
+	}
+
+	id := k.AppendInboundPost(ctx, types.InboundPost{
+		Packet:         packet,
+		Post:           data,
+		ChainID:        k.CounterpartyChainID(ctx, packet.DestinationPort, packet.DestinationChannel),
+		ReceivedAt:     ctx.BlockTime().Unix(),
+		ReceivedHeight: ctx.BlockHeight(),
+		ExpiresAt:      ctx.BlockHeight() + int64(k.ModerationExpiryBlocks(ctx)),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueInboundPost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyInboundPostID, strconv.FormatUint(id, 10)),
+		),
+	)
+
+	return id, nil
+}
+
+// publishInboundPost publishes a queued post and writes the acknowledgement of its packet. The post is validated
+// again against the params, a post no longer valid stays queued so that it can be rejected.
+func (k Keeper) publishInboundPost(ctx sdk.Context, inboundPost types.InboundPost) (uint64, error) {
+	packetAck, err := k.OnRecvIbcPostPacket(ctx, inboundPost.Packet, inboundPost.Post)
+	if err != nil {
+		return 0, err
+	}
+
+	// Encode packet acknowledgment
+	packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	ack := channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+	if err := k.writeInboundPostAck(ctx, inboundPost, ack); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(packetAck.PostID, 10, 64)
+}
+
+// rejectInboundPost drops a queued post and writes an error acknowledgement for its packet
+func (k Keeper) rejectInboundPost(ctx sdk.Context, inboundPost types.InboundPost, reason error) error {
+	return k.writeInboundPostAck(ctx, inboundPost, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// ExpireInboundPosts rejects the queued posts expiring at the current height or before. The posts whose
+// acknowledgement can't be written, because their channel was closed, are dropped without an acknowledgement and
+// reported by a distinct event.
+func (k Keeper) ExpireInboundPosts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InboundPostByExpiryKey))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	// The queue is changed by the rejections, the ids are collected first
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, GetInboundPostIDFromBytes(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		inboundPost, found := k.GetInboundPost(ctx, id)
+		if !found {
+			continue
+		}
+
+		if err := k.rejectInboundPost(ctx, inboundPost, types.ErrInboundPostExpired); err != nil {
+			k.Logger(ctx).Error("cannot acknowledge expired inbound post", "id", id, "error", err)
+			k.RemoveInboundPost(ctx, id)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDropInboundPost,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyInboundPostID, strconv.FormatUint(id, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireInboundPost,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyInboundPostID, strconv.FormatUint(id, 10)),
+			),
+		)
+	}
+}
+
+// writeInboundPostAck writes the acknowledgement of the packet of a queued post and removes it from the queue
+func (k Keeper) writeInboundPostAck(ctx sdk.Context, inboundPost types.InboundPost, ack channeltypes.Acknowledgement) error {
+	packet := inboundPost.Packet
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ack); err != nil {
+		return err
+	}
+
+	k.RemoveInboundPost(ctx, inboundPost.Id)
+	return nil
+}
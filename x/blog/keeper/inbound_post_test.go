@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNInboundPost(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.InboundPost {
+	items := make([]types.InboundPost, n)
+	for i := range items {
+		items[i].Id = keeper.AppendInboundPost(ctx, items[i])
+	}
+	return items
+}
+
+// writtenAcks returns the acknowledgements written by the channel keeper stub
+func writtenAcks(ctx sdk.Context) (acks []string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == channeltypes.AttributeKeyAck {
+				acks = append(acks, string(attribute.Value))
+			}
+		}
+	}
+	return acks
+}
+
+// eventTypes returns the types of the events emitted in the context among the filtered types
+func eventTypes(ctx sdk.Context, filter ...string) []string {
+	var emitted []string
+	for _, event := range ctx.EventManager().Events() {
+		for _, eventType := range filter {
+			if event.Type == eventType {
+				emitted = append(emitted, event.Type)
+			}
+		}
+	}
+	return emitted
+}
+
+func TestInboundPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNInboundPost(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetInboundPost(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestInboundPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNInboundPost(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveInboundPost(ctx, item.Id)
+		_, found := keeper.GetInboundPost(ctx, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestInboundPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNInboundPost(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllInboundPost(ctx)),
+	)
+}
+
+func TestInboundPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNInboundPost(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetInboundPostCount(ctx))
+}
+
+func TestQueueInboundPost(t *testing.T) {
+	packet := channeltypes.Packet{
+		Sequence:           3,
+		SourcePort:         "blog",
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		DestinationPort:    "blog",
+		DestinationChannel: keepertest.ChannelID,
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A"}
+
+	t.Run("Queued", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		ctx = ctx.WithBlockHeight(10)
+		params := types.DefaultParams()
+		params.ModerationExpiryBlocks = 5
+		k.SetParams(ctx, params)
+
+		id, err := k.QueueInboundPost(ctx, packet, data)
+		require.NoError(t, err)
+		inboundPost, found := k.GetInboundPost(ctx, id)
+		require.True(t, found)
+		require.Equal(t, packet, inboundPost.Packet)
+		require.Equal(t, data, inboundPost.Post)
+		require.Equal(t, keepertest.CounterpartyChainID, inboundPost.ChainID)
+		require.Equal(t, int64(10), inboundPost.ReceivedHeight)
+		require.Equal(t, int64(15), inboundPost.ExpiresAt)
+		// The post isn't published until approved
+		require.Zero(t, k.GetPostCount(ctx))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		_, err := k.QueueInboundPost(ctx, packet, types.IbcPostPacketData{Content: "content", Creator: "A"})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		require.Zero(t, k.GetInboundPostCount(ctx))
+	})
+}
+
+func TestExpireInboundPosts(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: keepertest.ChannelID}
+	closed := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-5"}
+	for _, inboundPost := range []types.InboundPost{
+		{Packet: packet, ExpiresAt: 10},
+		{Packet: closed, ExpiresAt: 9},
+		{Packet: packet, ExpiresAt: 11},
+	} {
+		k.AppendInboundPost(ctx, inboundPost)
+	}
+
+	// The post of a channel that can't be acknowledged is dropped and reported as such
+	dropCtx := ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	k.ExpireInboundPosts(dropCtx)
+	require.Equal(t, []string{types.EventTypeDropInboundPost}, eventTypes(dropCtx, types.EventTypeDropInboundPost, types.EventTypeExpireInboundPost))
+	require.Empty(t, writtenAcks(dropCtx))
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	k.ExpireInboundPosts(ctx)
+	require.Equal(t, []string{types.EventTypeExpireInboundPost}, eventTypes(ctx, types.EventTypeDropInboundPost, types.EventTypeExpireInboundPost))
+
+	require.Equal(t, []types.InboundPost{{Id: 2, Packet: packet, ExpiresAt: 11}}, k.GetAllInboundPost(ctx))
+	require.Equal(t, []string{string(channeltypes.NewErrorAcknowledgement(types.ErrInboundPostExpired).Acknowledgement())}, writtenAcks(ctx))
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"planet/x/blog/types"
+)
+
+// setIndex adds the id of a list element to the secondary index of a value, empty values are not indexed
+func (k Keeper) setIndex(ctx sdk.Context, indexKey string, value string, id uint64) {
+	if value == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexKey))
+	store.Set(types.IndexKey(value, id), []byte{})
+}
+
+// removeIndex removes the id of a list element from the secondary index of a value
+func (k Keeper) removeIndex(ctx sdk.Context, indexKey string, value string, id uint64) {
+	if value == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexKey))
+	store.Delete(types.IndexKey(value, id))
+}
+
+// paginateIndex paginates over the ids indexed for a value in the secondary index
+func (k Keeper) paginateIndex(
+	ctx sdk.Context,
+	indexKey string,
+	value string,
+	pageReq *query.PageRequest,
+	onResult func(id uint64) error,
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexKey))
+	valueStore := prefix.NewStore(store, types.IndexKeyPrefix(value))
+
+	return query.Paginate(valueStore, pageReq, func(key []byte, _ []byte) error {
+		return onResult(sdk.BigEndianToUint64(key))
+	})
+}
+
+// filteredPaginateIndex paginates over the ids indexed for a value in the secondary index that onResult accepts,
+// the pagination follows query.FilteredPaginate
+func (k Keeper) filteredPaginateIndex(
+	ctx sdk.Context,
+	indexKey string,
+	value string,
+	pageReq *query.PageRequest,
+	onResult func(id uint64, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexKey))
+	valueStore := prefix.NewStore(store, types.IndexKeyPrefix(value))
+
+	return query.FilteredPaginate(valueStore, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		return onResult(sdk.BigEndianToUint64(key), accumulate)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ignite/cli/ignite/pkg/cosmosibckeeper"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	"planet/x/blog/types"
 )
@@ -19,6 +20,11 @@ type (
 		storeKey   storetypes.StoreKey
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
+
+		channelKeeper types.ChannelKeeper
+
+		// authority is the address allowed to execute MsgUpdateParams, usually the gov module account
+		authority string
 	}
 )
 
@@ -27,10 +33,10 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
-	channelKeeper cosmosibckeeper.ChannelKeeper,
+	channelKeeper types.ChannelKeeper,
 	portKeeper cosmosibckeeper.PortKeeper,
 	scopedKeeper cosmosibckeeper.ScopedKeeper,
-
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -45,13 +51,28 @@ func NewKeeper(
 			portKeeper,
 			scopedKeeper,
 		),
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		memKey:        memKey,
+		paramstore:    ps,
+		channelKeeper: channelKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module params
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// txHash returns the hex encoded hash of the transaction being executed, or an empty string outside of a transaction
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "planet/x/blog/migrations/v2"
+	"planet/x/blog/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// v1 has no params, they are initialized with their default values
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, func(ctx sdk.Context, sourcePort, sourceChannel string) (string, string, string, bool) {
+		port, channelID, found := m.keeper.ReceivedPostChannel(ctx, sourcePort, sourceChannel)
+		if !found {
+			return "", "", "", false
+		}
+		return port, channelID, m.keeper.CounterpartyChainID(ctx, port, channelID), true
+	})
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// GetModerationActionCount get the total number of moderationAction
+func (k Keeper) GetModerationActionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.ModerationActionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetModerationActionCount set the total number of moderationAction
+func (k Keeper) SetModerationActionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.ModerationActionCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendModerationAction appends a moderationAction in the store with a new id and update the count
+func (k Keeper) AppendModerationAction(
+	ctx sdk.Context,
+	moderationAction types.ModerationAction,
+) uint64 {
+	// Create the moderationAction
+	count := k.GetModerationActionCount(ctx)
+
+	// Set the ID of the appended value
+	moderationAction.Id = count
+	k.SetModerationAction(ctx, moderationAction)
+
+	// Update moderationAction count
+	k.SetModerationActionCount(ctx, count+1)
+
+	return count
+}
+
+// SetModerationAction set a specific moderationAction in the store
+func (k Keeper) SetModerationAction(ctx sdk.Context, moderationAction types.ModerationAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModerationActionKey))
+	b := k.cdc.MustMarshal(&moderationAction)
+	store.Set(sdk.Uint64ToBigEndian(moderationAction.Id), b)
+}
+
+// GetModerationAction returns a moderationAction from its id
+func (k Keeper) GetModerationAction(ctx sdk.Context, id uint64) (val types.ModerationAction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModerationActionKey))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllModerationAction returns all moderationAction
+func (k Keeper) GetAllModerationAction(ctx sdk.Context) (list []types.ModerationAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModerationActionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ModerationAction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// logModerationAction records a moderation action in the moderation log
+func (k Keeper) logModerationAction(ctx sdk.Context, moderator string, action string, target string, reason string) uint64 {
+	return k.AppendModerationAction(ctx, types.ModerationAction{
+		Moderator:     moderator,
+		Action:        action,
+		Target:        target,
+		Reason:        reason,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+		TxHash:        txHash(ctx),
+	})
+}
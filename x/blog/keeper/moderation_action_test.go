@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNModerationAction(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ModerationAction {
+	items := make([]types.ModerationAction, n)
+	for i := range items {
+		items[i].Action = types.ModerationActionHidePost
+		items[i].Id = keeper.AppendModerationAction(ctx, items[i])
+	}
+	return items
+}
+
+func TestModerationActionGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerationAction(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetModerationAction(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestModerationActionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerationAction(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllModerationAction(ctx)),
+	)
+}
+
+func TestModerationActionCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerationAction(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetModerationActionCount(ctx))
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// SetModerator set a specific moderator in the store from its address
+func (k Keeper) SetModerator(ctx sdk.Context, moderator types.Moderator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModeratorKey))
+	b := k.cdc.MustMarshal(&moderator)
+	store.Set([]byte(moderator.Address), b)
+}
+
+// GetModerator returns a moderator from its address
+func (k Keeper) GetModerator(ctx sdk.Context, address string) (val types.Moderator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModeratorKey))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveModerator removes a moderator from the store
+func (k Keeper) RemoveModerator(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModeratorKey))
+	store.Delete([]byte(address))
+}
+
+// GetAllModerator returns all moderator
+func (k Keeper) GetAllModerator(ctx sdk.Context) (list []types.Moderator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ModeratorKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Moderator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// isModerator returns true if the address can moderate posts, the module authority is always a moderator
+func (k Keeper) isModerator(ctx sdk.Context, address string) bool {
+	if address == k.authority {
+		return true
+	}
+	_, found := k.GetModerator(ctx, address)
+	return found
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNModerator(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Moderator {
+	items := make([]types.Moderator, n)
+	for i := range items {
+		items[i].Address = strconv.Itoa(i)
+		items[i].AddedHeight = int64(i)
+		keeper.SetModerator(ctx, items[i])
+	}
+	return items
+}
+
+func TestModeratorGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerator(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetModerator(ctx, item.Address)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestModeratorRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerator(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveModerator(ctx, item.Address)
+		_, found := keeper.GetModerator(ctx, item.Address)
+		require.False(t, found)
+	}
+}
+
+func TestModeratorGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNModerator(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllModerator(ctx)),
+	)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) BanAuthor(goCtx context.Context, msg *types.MsgBanAuthor) (*types.MsgBanAuthorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+	if _, found := k.GetBannedAuthor(ctx, msg.ChannelID, msg.Address); found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already banned on channel %s", msg.Address, msg.ChannelID)
+	}
+
+	k.SetBannedAuthor(ctx, types.BannedAuthor{
+		ChannelID:    msg.ChannelID,
+		Address:      msg.Address,
+		BannedBy:     msg.Creator,
+		Reason:       msg.Reason,
+		BannedAt:     ctx.BlockTime().Unix(),
+		BannedHeight: ctx.BlockHeight(),
+	})
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionBanAuthor, types.BannedAuthorTarget(msg.ChannelID, msg.Address), msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBanAuthor,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgBanAuthorResponse{}, nil
+}
+
+func (k msgServer) UnbanAuthor(goCtx context.Context, msg *types.MsgUnbanAuthor) (*types.MsgUnbanAuthorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+	if _, found := k.GetBannedAuthor(ctx, msg.ChannelID, msg.Address); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not banned on channel %s", msg.Address, msg.ChannelID)
+	}
+
+	k.RemoveBannedAuthor(ctx, msg.ChannelID, msg.Address)
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionUnbanAuthor, types.BannedAuthorTarget(msg.ChannelID, msg.Address), msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbanAuthor,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUnbanAuthorResponse{}, nil
+}
+
+func (k msgServer) BanChannel(goCtx context.Context, msg *types.MsgBanChannel) (*types.MsgBanChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+	if _, found := k.GetBannedChannel(ctx, msg.ChannelID); found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is already banned", msg.ChannelID)
+	}
+
+	k.SetBannedChannel(ctx, types.BannedChannel{
+		ChannelID:    msg.ChannelID,
+		BannedBy:     msg.Creator,
+		Reason:       msg.Reason,
+		BannedAt:     ctx.BlockTime().Unix(),
+		BannedHeight: ctx.BlockHeight(),
+	})
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionBanChannel, msg.ChannelID, msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBanChannel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgBanChannelResponse{}, nil
+}
+
+func (k msgServer) UnbanChannel(goCtx context.Context, msg *types.MsgUnbanChannel) (*types.MsgUnbanChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+	if _, found := k.GetBannedChannel(ctx, msg.ChannelID); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "channel %s is not banned", msg.ChannelID)
+	}
+
+	k.RemoveBannedChannel(ctx, msg.ChannelID)
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionUnbanChannel, msg.ChannelID, msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbanChannel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUnbanChannelResponse{}, nil
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestBanAuthorMsgServer(t *testing.T) {
+	moderator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc    string
+		creator string
+		banned  bool
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			creator: moderator,
+		},
+		{
+			desc:    "NotModerator",
+			creator: sample.AccAddress(),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "AlreadyBanned",
+			creator: moderator,
+			banned:  true,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(5)
+			k.SetModerator(ctx, types.Moderator{Address: moderator})
+			if tc.banned {
+				k.SetBannedAuthor(ctx, types.BannedAuthor{ChannelID: "channel-0", Address: "A"})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+
+			_, err := srv.BanAuthor(sdk.WrapSDKContext(ctx), &types.MsgBanAuthor{Creator: tc.creator, ChannelID: "channel-0", Address: "A", Reason: "spam"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, k.GetModerationActionCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			bannedAuthor, found := k.GetBannedAuthor(ctx, "channel-0", "A")
+			require.True(t, found)
+			require.Equal(t, moderator, bannedAuthor.BannedBy)
+			require.Equal(t, "spam", bannedAuthor.Reason)
+			require.Equal(t, int64(5), bannedAuthor.BannedHeight)
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 1)
+			require.Equal(t, types.ModerationActionBanAuthor, log[0].Action)
+			require.Equal(t, "channel-0/A", log[0].Target)
+		})
+	}
+}
+
+func TestUnbanAuthorMsgServer(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		moderator bool
+		banned    bool
+		err       error
+	}{
+		{
+			desc:      "Completed",
+			moderator: true,
+			banned:    true,
+		},
+		{
+			desc:   "NotModerator",
+			banned: true,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:      "NotBanned",
+			moderator: true,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			if tc.banned {
+				k.SetBannedAuthor(ctx, types.BannedAuthor{ChannelID: "channel-0", Address: "A"})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+			creator := sample.AccAddress()
+			if tc.moderator {
+				creator = k.GetAuthority()
+			}
+
+			_, err := srv.UnbanAuthor(sdk.WrapSDKContext(ctx), &types.MsgUnbanAuthor{Creator: creator, ChannelID: "channel-0", Address: "A"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := k.GetBannedAuthor(ctx, "channel-0", "A")
+			require.False(t, found)
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 1)
+			require.Equal(t, types.ModerationActionUnbanAuthor, log[0].Action)
+		})
+	}
+}
+
+func TestBanChannelMsgServer(t *testing.T) {
+	moderator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc    string
+		creator string
+		banned  bool
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			creator: moderator,
+		},
+		{
+			desc:    "NotModerator",
+			creator: sample.AccAddress(),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "AlreadyBanned",
+			creator: moderator,
+			banned:  true,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetModerator(ctx, types.Moderator{Address: moderator})
+			if tc.banned {
+				k.SetBannedChannel(ctx, types.BannedChannel{ChannelID: "channel-0"})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+
+			_, err := srv.BanChannel(sdk.WrapSDKContext(ctx), &types.MsgBanChannel{Creator: tc.creator, ChannelID: "channel-0", Reason: "spam"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, k.GetModerationActionCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			bannedChannel, found := k.GetBannedChannel(ctx, "channel-0")
+			require.True(t, found)
+			require.Equal(t, moderator, bannedChannel.BannedBy)
+			require.Equal(t, "spam", bannedChannel.Reason)
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 1)
+			require.Equal(t, types.ModerationActionBanChannel, log[0].Action)
+			require.Equal(t, "channel-0", log[0].Target)
+		})
+	}
+}
+
+func TestUnbanChannelMsgServer(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		moderator bool
+		banned    bool
+		err       error
+	}{
+		{
+			desc:      "Completed",
+			moderator: true,
+			banned:    true,
+		},
+		{
+			desc:   "NotModerator",
+			banned: true,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:      "NotBanned",
+			moderator: true,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			if tc.banned {
+				k.SetBannedChannel(ctx, types.BannedChannel{ChannelID: "channel-0"})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+			creator := sample.AccAddress()
+			if tc.moderator {
+				creator = k.GetAuthority()
+			}
+
+			_, err := srv.UnbanChannel(sdk.WrapSDKContext(ctx), &types.MsgUnbanChannel{Creator: creator, ChannelID: "channel-0"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := k.GetBannedChannel(ctx, "channel-0")
+			require.False(t, found)
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 1)
+			require.Equal(t, types.ModerationActionUnbanChannel, log[0].Action)
+		})
+	}
+}
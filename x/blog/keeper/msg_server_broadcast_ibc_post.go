@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) BroadcastIbcPost(goCtx context.Context, msg *types.MsgBroadcastIbcPost) (*types.MsgBroadcastIbcPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+
+	// Construct the packet
+	var packet types.IbcPostPacketData
+
+	packet.Title = msg.Title
+	packet.Content = msg.Content
+	packet.Creator = msg.Creator
+	packet.Tags = msg.Tags
+
+	if err := validateSentPost(params, packet); err != nil {
+		return nil, err
+	}
+
+	destinations := msg.Destinations
+	if msg.AllChannels {
+		destinations = k.BroadcastDestinations(ctx)
+		if len(destinations) == 0 {
+			return nil, sdkerrors.Wrap(types.ErrChannelNotAllowed, "no open channel to broadcast the post to")
+		}
+	}
+	for _, destination := range destinations {
+		if !params.IsDestinationChannelAllowed(destination.ChannelID) {
+			return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send posts to channel %s", destination.ChannelID)
+		}
+	}
+
+	// Each destination gets its own packet, tracked separately until it is acknowledged or timed out
+	sequences := make([]types.IbcPostSequence, 0, len(destinations))
+	for _, destination := range destinations {
+		sequence, err := k.sendIbcPost(ctx, packet, destination.Port, destination.ChannelID, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "cannot send post to %s/%s", destination.Port, destination.ChannelID)
+		}
+		sequences = append(sequences, types.IbcPostSequence{
+			Port:      destination.Port,
+			ChannelID: destination.ChannelID,
+			Sequence:  sequence,
+		})
+	}
+
+	return &types.MsgBroadcastIbcPostResponse{Sequences: sequences}, nil
+}
+
+// BroadcastDestinations returns the open channels bound to the module port that posts can be sent to
+func (k Keeper) BroadcastDestinations(ctx sdk.Context) (destinations []types.IbcPostDestination) {
+	port := k.GetPort(ctx)
+	params := k.GetParams(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != port || channel.State != channeltypes.OPEN {
+			continue
+		}
+		if !params.IsDestinationChannelAllowed(channel.ChannelId) {
+			continue
+		}
+		destinations = append(destinations, types.IbcPostDestination{
+			Port:      channel.PortId,
+			ChannelID: channel.ChannelId,
+		})
+	}
+	return destinations
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestBroadcastIbcPostMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		request *types.MsgBroadcastIbcPost
+		err     error
+	}{
+		{
+			desc:   "Destinations",
+			params: types.DefaultParams(),
+			request: &types.MsgBroadcastIbcPost{
+				Destinations: []types.IbcPostDestination{{Port: types.PortID, ChannelID: keepertest.ChannelID}},
+				Title:        "title",
+			},
+		},
+		{
+			desc:    "AllChannels",
+			params:  types.DefaultParams(),
+			request: &types.MsgBroadcastIbcPost{AllChannels: true, Title: "title", Tags: []string{"mars"}},
+		},
+		{
+			desc:   "ChannelNotFound",
+			params: types.DefaultParams(),
+			request: &types.MsgBroadcastIbcPost{
+				Destinations: []types.IbcPostDestination{{Port: types.PortID, ChannelID: "channel-9"}},
+				Title:        "title",
+			},
+			err: channeltypes.ErrChannelNotFound,
+		},
+		{
+			desc:   "ChannelNotAllowed",
+			params: types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgBroadcastIbcPost{
+				Destinations: []types.IbcPostDestination{{Port: types.PortID, ChannelID: keepertest.ChannelID}},
+				Title:        "title",
+			},
+			err: types.ErrChannelNotAllowed,
+		},
+		{
+			desc:    "NoChannelAllowed",
+			params:  types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgBroadcastIbcPost{AllChannels: true, Title: "title"},
+			err:     types.ErrChannelNotAllowed,
+		},
+		{
+			desc:    "PostTooLong",
+			params:  types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgBroadcastIbcPost{AllChannels: true, Title: "title", Content: "a long content"},
+			err:     types.ErrPostTooLong,
+		},
+		{
+			desc:    "TooManyTags",
+			params:  types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, 1, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgBroadcastIbcPost{AllChannels: true, Title: "title", Tags: []string{"mars", "venus"}},
+			err:     types.ErrInvalidTags,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.Creator = creator
+			tc.request.TimeoutTimestamp = 100
+			resp, err := srv.BroadcastIbcPost(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, k.GetAllPendingPost(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			// Only the open channel of the blog port is broadcasted to
+			require.Len(t, resp.Sequences, 1)
+			require.Equal(t, types.PortID, resp.Sequences[0].Port)
+			require.Equal(t, keepertest.ChannelID, resp.Sequences[0].ChannelID)
+			pendingPost, found := k.GetPendingPost(ctx, types.PortID, keepertest.ChannelID, resp.Sequences[0].Sequence)
+			require.True(t, found)
+			require.Equal(t, creator, pendingPost.Creator)
+			require.Equal(t, tc.request.Tags, pendingPost.Tags)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) HidePost(goCtx context.Context, msg *types.MsgHidePost) (*types.MsgHidePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+
+	post, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if post.Hidden {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is already hidden", msg.Id)
+	}
+
+	post.Hidden = true
+	post.HiddenBy = msg.Creator
+	post.HiddenReason = msg.Reason
+	k.SetPost(ctx, post)
+	// The moderator reviewed the post, its reports are closed
+	k.RemovePostReports(ctx, msg.Id)
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionHidePost, strconv.FormatUint(msg.Id, 10), msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHidePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgHidePostResponse{}, nil
+}
+
+func (k msgServer) UnhidePost(goCtx context.Context, msg *types.MsgUnhidePost) (*types.MsgUnhidePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+
+	post, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if !post.Hidden {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is not hidden", msg.Id)
+	}
+
+	post.Hidden = false
+	post.HiddenBy = ""
+	post.HiddenReason = ""
+	k.SetPost(ctx, post)
+	// The moderator reviewed the post, its reports are closed
+	k.RemovePostReports(ctx, msg.Id)
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionUnhidePost, strconv.FormatUint(msg.Id, 10), msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnhidePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUnhidePostResponse{}, nil
+}
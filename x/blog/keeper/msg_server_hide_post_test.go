@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestHidePostMsgServer(t *testing.T) {
+	moderator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc    string
+		creator string
+		id      uint64
+		hidden  bool
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			creator: moderator,
+		},
+		{
+			desc:    "NotModerator",
+			creator: sample.AccAddress(),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			creator: moderator,
+			id:      1,
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "AlreadyHidden",
+			creator: moderator,
+			hidden:  true,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetModerator(ctx, types.Moderator{Address: moderator})
+			k.AppendPost(ctx, types.Post{Title: "title", Creator: "A", Hidden: tc.hidden})
+			k.SetReport(ctx, types.Report{PostID: 0, Reporter: "B", Reason: types.ReportReasonSpam})
+			srv := keeper.NewMsgServerImpl(*k)
+
+			_, err := srv.HidePost(sdk.WrapSDKContext(ctx), &types.MsgHidePost{Creator: tc.creator, Id: tc.id, Reason: "spam"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, k.GetModerationActionCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			// The post is kept along with the reason
+			post, found := k.GetPost(ctx, 0)
+			require.True(t, found)
+			require.True(t, post.Hidden)
+			require.Equal(t, moderator, post.HiddenBy)
+			require.Equal(t, "spam", post.HiddenReason)
+			// The reports of the post are closed
+			require.Zero(t, k.GetReportCount(ctx, 0))
+			require.Empty(t, k.GetAllReport(ctx))
+			require.Equal(t, []types.ModerationAction{{
+				Moderator: moderator,
+				Action:    types.ModerationActionHidePost,
+				Target:    "0",
+				Reason:    "spam",
+				CreatedAt: ctx.BlockTime().Unix(),
+			}}, k.GetAllModerationAction(ctx))
+		})
+	}
+}
+
+func TestUnhidePostMsgServer(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		moderator bool
+		hidden    bool
+		err       error
+	}{
+		{
+			desc:      "Completed",
+			moderator: true,
+			hidden:    true,
+		},
+		{
+			desc:   "NotModerator",
+			hidden: true,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:      "NotHidden",
+			moderator: true,
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.AppendPost(ctx, types.Post{Title: "title", Creator: "A", Hidden: tc.hidden, HiddenBy: "B", HiddenReason: "spam"})
+			k.SetReport(ctx, types.Report{PostID: 0, Reporter: "B", Reason: types.ReportReasonSpam})
+			srv := keeper.NewMsgServerImpl(*k)
+			creator := sample.AccAddress()
+			if tc.moderator {
+				creator = k.GetAuthority()
+			}
+
+			_, err := srv.UnhidePost(sdk.WrapSDKContext(ctx), &types.MsgUnhidePost{Creator: creator, Id: 0, Reason: "appealed"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			post, _ := k.GetPost(ctx, 0)
+			require.False(t, post.Hidden)
+			require.Empty(t, post.HiddenBy)
+			require.Empty(t, post.HiddenReason)
+			require.Zero(t, k.GetReportCount(ctx, 0))
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 1)
+			require.Equal(t, types.ModerationActionUnhidePost, log[0].Action)
+			require.Equal(t, "appealed", log[0].Reason)
+		})
+	}
+}
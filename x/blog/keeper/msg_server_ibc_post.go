@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	"planet/x/blog/types"
 )
@@ -11,7 +12,10 @@ import (
 func (k msgServer) SendIbcPost(goCtx context.Context, msg *types.MsgSendIbcPost) (*types.MsgSendIbcPostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: logic before transmitting the packet
+	params := k.GetParams(ctx)
+	if !params.IsDestinationChannelAllowed(msg.ChannelID) {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send posts to channel %s", msg.ChannelID)
+	}
 
 	// Construct the packet
 	var packet types.IbcPostPacketData
@@ -19,19 +23,69 @@ func (k msgServer) SendIbcPost(goCtx context.Context, msg *types.MsgSendIbcPost)
 	packet.Title = msg.Title
 	packet.Content = msg.Content
 	packet.Creator = msg.Creator
+	packet.Tags = msg.Tags
+
+	if err := validateSentPost(params, packet); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.sendIbcPost(ctx, packet, msg.Port, msg.ChannelID, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendIbcPostResponse{Sequence: sequence}, nil
+}
+
+// validateSentPost checks a post about to be sent against the length and tag limits of the params
+func validateSentPost(params types.Params, packet types.IbcPostPacketData) error {
+	if err := params.ValidatePostLength(packet.Title, packet.Content); err != nil {
+		return sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+	if err := params.ValidatePostTags(packet.Tags); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidTags, err.Error())
+	}
+	return nil
+}
 
-	// Transmit the packet
-	err := k.TransmitIbcPostPacket(
+// sendIbcPost transmits the packet and keeps track of it until it is acknowledged or timed out, the post counts
+// against the rate limit of its creator
+func (k Keeper) sendIbcPost(
+	ctx sdk.Context,
+	packet types.IbcPostPacketData,
+	port string,
+	channelID string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if err := k.countSentPost(ctx, packet.Creator); err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.TransmitIbcPostPacket(
 		ctx,
 		packet,
-		msg.Port,
-		msg.ChannelID,
-		clienttypes.ZeroHeight(),
-		msg.TimeoutTimestamp,
+		port,
+		channelID,
+		timeoutHeight,
+		timeoutTimestamp,
 	)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return &types.MsgSendIbcPostResponse{}, nil
+	k.SetPendingPost(ctx, types.PendingPost{
+		Port:       port,
+		ChannelID:  channelID,
+		Sequence:   sequence,
+		Title:      packet.Title,
+		Content:    packet.Content,
+		Creator:    packet.Creator,
+		Tags:       packet.Tags,
+		SentAt:     ctx.BlockTime().Unix(),
+		SentHeight: ctx.BlockHeight(),
+		SentTxHash: txHash(ctx),
+	})
+
+	return sequence, nil
 }
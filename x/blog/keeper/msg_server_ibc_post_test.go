@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestSendIbcPostMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		request *types.MsgSendIbcPost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			params:  types.DefaultParams(),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title"},
+		},
+		{
+			desc:    "TimeoutHeight",
+			params:  types.DefaultParams(),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title", TimeoutHeight: clienttypes.NewHeight(0, 100)},
+		},
+		{
+			desc:    "ChannelNotFound",
+			params:  types.DefaultParams(),
+			request: &types.MsgSendIbcPost{ChannelID: "channel-9", Title: "title"},
+			err:     channeltypes.ErrChannelNotFound,
+		},
+		{
+			desc:    "ChannelNotAllowed",
+			params:  types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title"},
+			err:     types.ErrChannelNotAllowed,
+		},
+		{
+			desc:    "PostTooLong",
+			params:  types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title", Content: "a long content"},
+			err:     types.ErrPostTooLong,
+		},
+		{
+			desc:    "Tags",
+			params:  types.DefaultParams(),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title", Tags: []string{"mars", "venus"}},
+		},
+		{
+			desc:    "TooManyTags",
+			params:  types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, 1, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title", Tags: []string{"mars", "venus"}},
+			err:     types.ErrInvalidTags,
+		},
+		{
+			desc:    "TagTooLong",
+			params:  types.NewParams(10, 10, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, 4, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			request: &types.MsgSendIbcPost{ChannelID: keepertest.ChannelID, Title: "title", Tags: []string{"mars", "venus"}},
+			err:     types.ErrInvalidTags,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.Creator = creator
+			tc.request.Port = types.PortID
+			if tc.request.TimeoutHeight.IsZero() {
+				tc.request.TimeoutTimestamp = 100
+			}
+			resp, err := srv.SendIbcPost(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, k.GetAllPendingPost(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			pendingPost, found := k.GetPendingPost(ctx, types.PortID, keepertest.ChannelID, resp.Sequence)
+			require.True(t, found)
+			require.Equal(t, creator, pendingPost.Creator)
+			require.Equal(t, tc.request.Tags, pendingPost.Tags)
+		})
+	}
+}
+
+func TestSendIbcPostRateLimit(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	params := types.DefaultParams()
+	params.RateLimitWindowBlocks = 10
+	params.MaxSentPostsPerWindow = 2
+	k.SetParams(ctx, params)
+	srv := keeper.NewMsgServerImpl(*k)
+	send := func(ctx sdk.Context, creator string) error {
+		_, err := srv.SendIbcPost(sdk.WrapSDKContext(ctx), &types.MsgSendIbcPost{
+			Creator:          creator,
+			Port:             types.PortID,
+			ChannelID:        keepertest.ChannelID,
+			Title:            "title",
+			TimeoutTimestamp: 100,
+		})
+		return err
+	}
+
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, send(ctx, "A"))
+	require.NoError(t, send(ctx.WithBlockHeight(9), "A"))
+	require.ErrorIs(t, send(ctx.WithBlockHeight(9), "A"), types.ErrRateLimited)
+	require.Equal(t, uint64(2), k.GetSenderRateLimitCount(ctx, "A"))
+
+	// The limit is per account and per window
+	require.NoError(t, send(ctx, "B"))
+	require.NoError(t, send(ctx.WithBlockHeight(10), "A"))
+}
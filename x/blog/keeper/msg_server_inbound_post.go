@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) ApproveInboundPost(goCtx context.Context, msg *types.MsgApproveInboundPost) (*types.MsgApproveInboundPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+
+	inboundPost, found := k.GetInboundPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	postID, err := k.publishInboundPost(ctx, inboundPost)
+	if err != nil {
+		return nil, err
+	}
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionApproveInboundPost, strconv.FormatUint(msg.Id, 10), "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveInboundPost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyInboundPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(postID, 10)),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+		),
+	)
+
+	return &types.MsgApproveInboundPostResponse{PostID: postID}, nil
+}
+
+func (k msgServer) RejectInboundPost(goCtx context.Context, msg *types.MsgRejectInboundPost) (*types.MsgRejectInboundPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isModerator(ctx, msg.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a moderator", msg.Creator)
+	}
+
+	inboundPost, found := k.GetInboundPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// The error acknowledgement only carries the code of the error, the reason is in the event
+	if err := k.rejectInboundPost(ctx, inboundPost, types.ErrInboundPostRejected); err != nil {
+		return nil, err
+	}
+	k.logModerationAction(ctx, msg.Creator, types.ModerationActionRejectInboundPost, strconv.FormatUint(msg.Id, 10), msg.Reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectInboundPost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyInboundPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyModerator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgRejectInboundPostResponse{}, nil
+}
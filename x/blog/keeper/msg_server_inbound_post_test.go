@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestApproveInboundPostMsgServer(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.ChannelID,
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A"}
+
+	for _, tc := range []struct {
+		desc     string
+		params   types.Params
+		creator  func(k *keeper.Keeper) string
+		id       uint64
+		inbound  types.InboundPost
+		err      error
+		postAck  string
+		approved bool
+	}{
+		{
+			desc:     "Completed",
+			params:   types.DefaultParams(),
+			inbound:  types.InboundPost{Packet: packet, Post: data},
+			approved: true,
+		},
+		{
+			desc:    "NotModerator",
+			params:  types.DefaultParams(),
+			creator: func(*keeper.Keeper) string { return sample.AccAddress() },
+			inbound: types.InboundPost{Packet: packet, Post: data},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			params:  types.DefaultParams(),
+			id:      1,
+			inbound: types.InboundPost{Packet: packet, Post: data},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "NoLongerValid",
+			params:  types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, []string{keepertest.ChannelID}, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			inbound: types.InboundPost{Packet: packet, Post: data},
+			err:     types.ErrPostTooLong,
+		},
+		{
+			desc:    "ChannelNotFound",
+			params:  types.DefaultParams(),
+			inbound: types.InboundPost{Packet: channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-5"}, Post: data},
+			err:     channeltypes.ErrChannelCapabilityNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			k.AppendInboundPost(ctx, tc.inbound)
+			srv := keeper.NewMsgServerImpl(*k)
+			creator := k.GetAuthority()
+			if tc.creator != nil {
+				creator = tc.creator(k)
+			}
+
+			resp, err := srv.ApproveInboundPost(sdk.WrapSDKContext(ctx), &types.MsgApproveInboundPost{Creator: creator, Id: tc.id})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, uint64(1), uint64(len(k.GetAllInboundPost(ctx))))
+				return
+			}
+			require.NoError(t, err)
+
+			post, found := k.GetPost(ctx, resp.PostID)
+			require.True(t, found)
+			require.Equal(t, data.Title, post.Title)
+			require.Equal(t, data.Creator, post.RemoteAuthor.Address)
+			require.Empty(t, k.GetAllInboundPost(ctx))
+
+			packetAck := types.ModuleCdc.MustMarshalJSON(&types.IbcPostPacketAck{PostID: "0"})
+			require.Equal(t, []string{string(channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAck)).Acknowledgement())}, writtenAcks(ctx))
+			require.Equal(t, []types.ModerationAction{{Moderator: creator, Action: types.ModerationActionApproveInboundPost, Target: "0", CreatedAt: ctx.BlockTime().Unix()}}, k.GetAllModerationAction(ctx))
+		})
+	}
+}
+
+func TestRejectInboundPostMsgServer(t *testing.T) {
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: keepertest.ChannelID}
+
+	for _, tc := range []struct {
+		desc      string
+		moderator bool
+		id        uint64
+		err       error
+	}{
+		{
+			desc:      "Completed",
+			moderator: true,
+		},
+		{
+			desc: "NotModerator",
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:      "KeyNotFound",
+			moderator: true,
+			id:        1,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.AppendInboundPost(ctx, types.InboundPost{Packet: packet})
+			srv := keeper.NewMsgServerImpl(*k)
+			creator := sample.AccAddress()
+			if tc.moderator {
+				creator = k.GetAuthority()
+			}
+
+			_, err := srv.RejectInboundPost(sdk.WrapSDKContext(ctx), &types.MsgRejectInboundPost{Creator: creator, Id: tc.id, Reason: "spam"})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Len(t, k.GetAllInboundPost(ctx), 1)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, k.GetAllInboundPost(ctx))
+			require.Zero(t, k.GetPostCount(ctx))
+			require.Equal(t, []string{string(channeltypes.NewErrorAcknowledgement(types.ErrInboundPostRejected).Acknowledgement())}, writtenAcks(ctx))
+			require.Equal(t, []types.ModerationAction{{Moderator: creator, Action: types.ModerationActionRejectInboundPost, Target: "0", Reason: "spam", CreatedAt: ctx.BlockTime().Unix()}}, k.GetAllModerationAction(ctx))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) MarkNotificationsRead(goCtx context.Context, msg *types.MsgMarkNotificationsRead) (*types.MsgMarkNotificationsReadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ids := msg.Ids
+	if len(ids) == 0 {
+		ids = k.GetUnreadNotificationIDs(ctx, msg.Creator)
+	}
+
+	var marked uint64
+	for _, id := range ids {
+		notification, found := k.GetNotification(ctx, msg.Creator, id)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "notification %d doesn't exist", id)
+		}
+		if notification.Read {
+			continue
+		}
+		notification.Read = true
+		k.SetNotification(ctx, notification)
+		marked++
+	}
+
+	return &types.MsgMarkNotificationsReadResponse{Marked: marked}, nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMarkNotificationsReadMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgMarkNotificationsRead
+		marked  uint64
+		unread  []uint64
+		err     error
+	}{
+		{
+			desc:    "All",
+			request: &types.MsgMarkNotificationsRead{Creator: creator},
+			marked:  2,
+		},
+		{
+			desc:    "Ids",
+			request: &types.MsgMarkNotificationsRead{Creator: creator, Ids: []uint64{0}},
+			marked:  1,
+			unread:  []uint64{2},
+		},
+		{
+			desc:    "AlreadyRead",
+			request: &types.MsgMarkNotificationsRead{Creator: creator, Ids: []uint64{1}},
+			unread:  []uint64{0, 2},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgMarkNotificationsRead{Creator: creator, Ids: []uint64{0, 3}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "OtherAddress",
+			request: &types.MsgMarkNotificationsRead{Creator: "B", Ids: []uint64{0}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			createNNotification(k, ctx, creator, 3)
+			k.SetNotification(ctx, types.Notification{Address: creator, Id: 1, Read: true})
+
+			resp, err := srv.MarkNotificationsRead(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.marked, resp.Marked)
+			require.Equal(t, tc.unread, k.GetUnreadNotificationIDs(ctx, creator))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.GetParams(ctx).ValidatePostLength(msg.Title, msg.Content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	var post = types.Post{
+		Creator:       msg.Creator,
+		Title:         msg.Title,
+		Content:       msg.Content,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+		TxHash:        txHash(ctx),
+	}
+
+	id := k.AppendPost(
+		ctx,
+		post,
+	)
+	post.Id = id
+	k.notifyMentions(ctx, post)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgCreatePostResponse{
+		Id: id,
+	}, nil
+}
+
+func (k msgServer) UpdatePost(goCtx context.Context, msg *types.MsgUpdatePost) (*types.MsgUpdatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if err := k.GetParams(ctx).ValidatePostLength(msg.Title, msg.Content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	// The creation metadata of the original post is kept, the update is recorded in the revisions of the post
+	revision := k.EditPost(ctx, val, msg.Title, msg.Content, msg.Creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUpdatePostResponse{Revision: revision}, nil
+}
+
+func (k msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	k.RemovePost(ctx, msg.Id)
+	k.RemovePostRevisions(ctx, msg.Id)
+	k.RemovePostReports(ctx, msg.Id)
+	k.RemovePostComments(ctx, types.CommentPostKindPost, msg.Id)
+	k.RemovePostReactions(ctx, types.CommentPostKindPost, msg.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeletePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgDeletePostResponse{}, nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestPostMsgServerCreate(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	creator := "A"
+	for i := 0; i < 5; i++ {
+		resp, err := srv.CreatePost(ctx, &types.MsgCreatePost{Creator: creator})
+		require.NoError(t, err)
+		require.Equal(t, i, int(resp.Id))
+	}
+}
+
+func TestPostMsgServerUpdate(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdatePost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdatePost{Creator: creator},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdatePost{Creator: "B"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgUpdatePost{Creator: creator, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			srv, ctx := setupMsgServer(t)
+			_, err := srv.CreatePost(ctx, &types.MsgCreatePost{Creator: creator})
+			require.NoError(t, err)
+
+			_, err = srv.UpdatePost(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPostMsgServerDelete(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgDeletePost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgDeletePost{Creator: creator},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgDeletePost{Creator: "B"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgDeletePost{Creator: creator, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			srv, ctx := setupMsgServer(t)
+
+			_, err := srv.CreatePost(ctx, &types.MsgCreatePost{Creator: creator})
+			require.NoError(t, err)
+			_, err = srv.DeletePost(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPostMsgServerDeleteRemovesThread(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	resp, err := srv.CreatePost(wctx, &types.MsgCreatePost{Creator: "A", Title: "title"})
+	require.NoError(t, err)
+	k.AppendComment(ctx, types.Comment{PostKind: types.CommentPostKindPost, PostID: resp.Id, Creator: "B"})
+	k.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, PostID: resp.Id, Reaction: types.ReactionLike, Creator: "B"})
+
+	_, err = srv.DeletePost(wctx, &types.MsgDeletePost{Creator: "A", Id: resp.Id})
+	require.NoError(t, err)
+	requirePostThreadRemoved(t, k, ctx, types.CommentPostKindPost, resp.Id)
+	require.Empty(t, k.GetAllComment(ctx))
+	require.Empty(t, k.GetAllReaction(ctx))
+}
+
+func TestPostMsgServerUpdateKeepsCreationMetadata(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	creator := "A"
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	resp, err := srv.CreatePost(sdk.WrapSDKContext(ctx), &types.MsgCreatePost{Creator: creator, Title: "title"})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(time.Unix(2000, 0))
+	_, err = srv.UpdatePost(sdk.WrapSDKContext(ctx), &types.MsgUpdatePost{Creator: creator, Id: resp.Id, Title: "new title"})
+	require.NoError(t, err)
+
+	post, found := k.GetPost(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, "new title", post.Title)
+	require.Equal(t, int64(1000), post.CreatedAt)
+	require.Equal(t, int64(10), post.CreatedHeight)
+}
+
+func TestPostMsgServerUpdateRecordsRevisions(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	creator := "A"
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	resp, err := srv.CreatePost(sdk.WrapSDKContext(ctx), &types.MsgCreatePost{Creator: creator, Title: "title"})
+	require.NoError(t, err)
+	require.Zero(t, k.GetPostRevisionCount(ctx, resp.Id))
+
+	for i, title := range []string{"title 1", "title 2"} {
+		ctx = ctx.WithBlockHeight(int64(20 + i))
+		updateResp, err := srv.UpdatePost(sdk.WrapSDKContext(ctx), &types.MsgUpdatePost{Creator: creator, Id: resp.Id, Title: title})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), updateResp.Revision)
+	}
+
+	revisions := k.GetPostRevisions(ctx, resp.Id)
+	require.Len(t, revisions, 3)
+	require.Equal(t, "title", revisions[0].Title)
+	require.Equal(t, int64(10), revisions[0].EditedHeight)
+	require.Equal(t, "title 2", revisions[2].Title)
+	require.Equal(t, creator, revisions[2].Editor)
+	require.Equal(t, int64(21), revisions[2].EditedHeight)
+
+	// The revisions are removed with the post
+	_, err = srv.DeletePost(sdk.WrapSDKContext(ctx), &types.MsgDeletePost{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+	require.Empty(t, k.GetPostRevisions(ctx, resp.Id))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) ReactToPost(goCtx context.Context, msg *types.MsgReactToPost) (*types.MsgReactToPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, found := k.GetPost(ctx, msg.PostID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.PostID))
+	}
+	// The reactions to a post received from another chain are sent to the chain of the post
+	if post.IsRemote() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d was received from another chain", msg.PostID)
+	}
+
+	err := k.react(ctx, types.Reaction{
+		PostKind:      types.CommentPostKindPost,
+		PostID:        msg.PostID,
+		Reaction:      msg.Reaction,
+		Creator:       msg.Creator,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReactToPost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.PostID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReaction, msg.Reaction),
+		),
+	)
+
+	return &types.MsgReactToPostResponse{}, nil
+}
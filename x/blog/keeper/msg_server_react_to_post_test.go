@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestReactToPostMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc     string
+		post     types.Post
+		previous string
+		request  *types.MsgReactToPost
+		counts   []types.ReactionCount
+		err      error
+	}{
+		{
+			desc:    "Completed",
+			post:    types.Post{Creator: "B"},
+			request: &types.MsgReactToPost{PostID: 0, Reaction: types.ReactionLike},
+			counts:  []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}},
+		},
+		{
+			desc:     "Replaced",
+			post:     types.Post{Creator: "B"},
+			previous: types.ReactionSad,
+			request:  &types.MsgReactToPost{PostID: 0, Reaction: types.ReactionLike},
+			counts:   []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}},
+		},
+		{
+			desc:     "AlreadyReacted",
+			post:     types.Post{Creator: "B"},
+			previous: types.ReactionLike,
+			request:  &types.MsgReactToPost{PostID: 0, Reaction: types.ReactionLike},
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "PostNotFound",
+			post:    types.Post{Creator: "B"},
+			request: &types.MsgReactToPost{PostID: 1, Reaction: types.ReactionLike},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "RemotePost",
+			post:    types.Post{RemoteAuthor: &types.RemoteAuthor{Address: "B"}},
+			request: &types.MsgReactToPost{PostID: 0, Reaction: types.ReactionLike},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+			k.AppendPost(ctx, tc.post)
+			if tc.previous != "" {
+				k.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, Reaction: tc.previous, Creator: creator})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.Creator = creator
+			_, err := srv.ReactToPost(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			reaction, found := k.GetReaction(ctx, types.CommentPostKindPost, tc.request.PostID, creator)
+			require.True(t, found)
+			require.Equal(t, types.Reaction{
+				PostKind:      types.CommentPostKindPost,
+				PostID:        tc.request.PostID,
+				Reaction:      tc.request.Reaction,
+				Creator:       creator,
+				CreatedAt:     1000,
+				CreatedHeight: 10,
+			}, reaction)
+			require.Equal(t, tc.counts, k.GetReactionCounts(ctx, types.CommentPostKindPost, tc.request.PostID))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) ReportPost(goCtx context.Context, msg *types.MsgReportPost) (*types.MsgReportPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	// A hidden post waits for a moderator, more reports wouldn't change it
+	if post.Hidden {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is hidden", msg.Id)
+	}
+
+	hidden, err := k.reportPost(ctx, post, types.Report{
+		PostID:        msg.Id,
+		Reporter:      msg.Creator,
+		Reason:        msg.Reason,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReportPost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReporter, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyHidden, strconv.FormatBool(hidden)),
+		),
+	)
+
+	return &types.MsgReportPostResponse{Hidden: hidden}, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestReportPostMsgServer(t *testing.T) {
+	reporter := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc      string
+		threshold uint64
+		reports   []string
+		id        uint64
+		hidden    bool
+		err       error
+		hides     bool
+	}{
+		{
+			desc:      "Completed",
+			threshold: 3,
+			reports:   []string{"A"},
+		},
+		{
+			desc:      "ThresholdReached",
+			threshold: 3,
+			reports:   []string{"A", "B"},
+			hides:     true,
+		},
+		{
+			desc:    "HidingDisabled",
+			reports: []string{"A", "B"},
+		},
+		{
+			desc:      "AlreadyReported",
+			threshold: 3,
+			reports:   []string{reporter},
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:      "KeyNotFound",
+			threshold: 3,
+			id:        1,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:      "Hidden",
+			threshold: 3,
+			hidden:    true,
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			params := types.DefaultParams()
+			params.ReportHideThreshold = tc.threshold
+			k.SetParams(ctx, params)
+			k.AppendPost(ctx, types.Post{Title: "title", Creator: "A", Hidden: tc.hidden})
+			for _, address := range tc.reports {
+				k.SetReport(ctx, types.Report{PostID: 0, Reporter: address, Reason: types.ReportReasonSpam})
+			}
+			srv := keeper.NewMsgServerImpl(*k)
+
+			resp, err := srv.ReportPost(sdk.WrapSDKContext(ctx), &types.MsgReportPost{Creator: reporter, Id: tc.id, Reason: types.ReportReasonAbuse})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, uint64(len(tc.reports)), k.GetReportCount(ctx, 0))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.hides, resp.Hidden)
+
+			report, found := k.GetReport(ctx, 0, reporter)
+			require.True(t, found)
+			require.Equal(t, types.ReportReasonAbuse, report.Reason)
+			require.Equal(t, uint64(len(tc.reports)+1), k.GetReportCount(ctx, 0))
+
+			post, _ := k.GetPost(ctx, 0)
+			require.Equal(t, tc.hides, post.Hidden)
+			if !tc.hides {
+				require.Zero(t, k.GetModerationActionCount(ctx))
+				return
+			}
+			// The post is hidden by the module, its reports stay open for the moderators
+			require.Empty(t, post.HiddenBy)
+			require.Equal(t, "reported by 3 accounts", post.HiddenReason)
+			require.Equal(t, []types.ModerationAction{{
+				Action:    types.ModerationActionAutoHidePost,
+				Target:    "0",
+				Reason:    "reported by 3 accounts",
+				CreatedAt: ctx.BlockTime().Unix(),
+			}}, k.GetAllModerationAction(ctx))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) RetryIbcPost(goCtx context.Context, msg *types.MsgRetryIbcPost) (*types.MsgRetryIbcPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Rebuild the packet from the stored record
+	var packet types.IbcPostPacketData
+	switch msg.Kind {
+	case types.RetryKindTimedout:
+		timedoutPost, found := k.GetTimedoutPost(ctx, msg.Id)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("timedoutPost %d doesn't exist", msg.Id))
+		}
+		if msg.Creator != timedoutPost.Creator {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+		}
+		if timedoutPost.RetrySequence != 0 {
+			return nil, sdkerrors.Wrapf(types.ErrPostAlreadyRetried, "timedoutPost %d", msg.Id)
+		}
+		packet.Title = timedoutPost.Title
+		packet.Content = timedoutPost.Content
+		packet.Creator = timedoutPost.Creator
+		packet.Tags = timedoutPost.Tags
+	case types.RetryKindFailed:
+		failedPost, found := k.GetFailedPost(ctx, msg.Id)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("failedPost %d doesn't exist", msg.Id))
+		}
+		if msg.Creator != failedPost.Creator {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+		}
+		if failedPost.RetrySequence != 0 {
+			return nil, sdkerrors.Wrapf(types.ErrPostAlreadyRetried, "failedPost %d", msg.Id)
+		}
+		packet.Title = failedPost.Title
+		packet.Content = failedPost.Content
+		packet.Creator = failedPost.Creator
+		packet.Tags = failedPost.Tags
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid retry kind (%s)", msg.Kind)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsDestinationChannelAllowed(msg.ChannelID) {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send posts to channel %s", msg.ChannelID)
+	}
+	// The params may have changed since the post was first sent
+	if err := validateSentPost(params, packet); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.sendIbcPost(ctx, packet, msg.Port, msg.ChannelID, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Link the retried record to the new in-flight packet
+	switch msg.Kind {
+	case types.RetryKindTimedout:
+		timedoutPost, _ := k.GetTimedoutPost(ctx, msg.Id)
+		timedoutPost.RetryPort = msg.Port
+		timedoutPost.RetryChannelID = msg.ChannelID
+		timedoutPost.RetrySequence = sequence
+		k.SetTimedoutPost(ctx, timedoutPost)
+	case types.RetryKindFailed:
+		failedPost, _ := k.GetFailedPost(ctx, msg.Id)
+		failedPost.RetryPort = msg.Port
+		failedPost.RetryChannelID = msg.ChannelID
+		failedPost.RetrySequence = sequence
+		k.SetFailedPost(ctx, failedPost)
+	}
+
+	return &types.MsgRetryIbcPostResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestRetryIbcPostMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgRetryIbcPost
+		err     error
+	}{
+		{
+			desc:    "TimedoutCompleted",
+			request: &types.MsgRetryIbcPost{Creator: creator, Kind: types.RetryKindTimedout},
+		},
+		{
+			desc:    "FailedCompleted",
+			request: &types.MsgRetryIbcPost{Creator: creator, Kind: types.RetryKindFailed},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgRetryIbcPost{Creator: "B", Kind: types.RetryKindTimedout},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgRetryIbcPost{Creator: creator, Kind: types.RetryKindFailed, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "ChannelNotFound",
+			request: &types.MsgRetryIbcPost{Creator: creator, Kind: types.RetryKindTimedout, ChannelID: "channel-9"},
+			err:     channeltypes.ErrChannelNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+			k.AppendTimedoutPost(ctx, types.TimedoutPost{Creator: creator, Title: "title", Content: "content", Tags: []string{"mars"}})
+			k.AppendFailedPost(ctx, types.FailedPost{Creator: creator, Title: "title", Content: "content", Tags: []string{"mars"}})
+
+			tc.request.Port = types.PortID
+			if tc.request.ChannelID == "" {
+				tc.request.ChannelID = keepertest.ChannelID
+			}
+			tc.request.TimeoutTimestamp = 100
+			resp, err := srv.RetryIbcPost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			pendingPost, found := k.GetPendingPost(ctx, types.PortID, keepertest.ChannelID, resp.Sequence)
+			require.True(t, found)
+			require.Equal(t, "content", pendingPost.Content)
+			require.Equal(t, []string{"mars"}, pendingPost.Tags)
+
+			// The record is linked to the new packet and cannot be retried twice
+			if tc.request.Kind == types.RetryKindTimedout {
+				timedoutPost, _ := k.GetTimedoutPost(ctx, 0)
+				require.Equal(t, resp.Sequence, timedoutPost.RetrySequence)
+			} else {
+				failedPost, _ := k.GetFailedPost(ctx, 0)
+				require.Equal(t, resp.Sequence, failedPost.RetrySequence)
+			}
+			_, err = srv.RetryIbcPost(wctx, tc.request)
+			require.ErrorIs(t, err, types.ErrPostAlreadyRetried)
+		})
+	}
+}
+
+func TestRetryIbcPostMsgServerTightenedParams(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := "A"
+
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: creator, Tags: []string{"mars", "venus"}}
+	resp, err := srv.SendIbcPost(wctx, &types.MsgSendIbcPost{
+		Creator:          creator,
+		Port:             types.PortID,
+		ChannelID:        keepertest.ChannelID,
+		TimeoutTimestamp: 100,
+		Title:            data.Title,
+		Content:          data.Content,
+		Tags:             data.Tags,
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.OnTimeoutIbcPostPacket(ctx, channeltypes.Packet{
+		SourcePort:    types.PortID,
+		SourceChannel: keepertest.ChannelID,
+		Sequence:      resp.Sequence,
+	}, data))
+
+	retry := &types.MsgRetryIbcPost{
+		Creator:          creator,
+		Kind:             types.RetryKindTimedout,
+		Port:             types.PortID,
+		ChannelID:        keepertest.ChannelID,
+		TimeoutTimestamp: 100,
+	}
+
+	// The retried post is checked against the params in force at the retry
+	params := types.DefaultParams()
+	params.MaxContentLength = 2
+	k.SetParams(ctx, params)
+	_, err = srv.RetryIbcPost(wctx, retry)
+	require.ErrorIs(t, err, types.ErrPostTooLong)
+
+	params = types.DefaultParams()
+	params.MaxTags = 1
+	k.SetParams(ctx, params)
+	_, err = srv.RetryIbcPost(wctx, retry)
+	require.ErrorIs(t, err, types.ErrInvalidTags)
+
+	timedoutPost, found := k.GetTimedoutPost(ctx, 0)
+	require.True(t, found)
+	require.Zero(t, timedoutPost.RetrySequence)
+
+	k.SetParams(ctx, types.DefaultParams())
+	_, err = srv.RetryIbcPost(wctx, retry)
+	require.NoError(t, err)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendIbcComment(goCtx context.Context, msg *types.MsgSendIbcComment) (*types.MsgSendIbcCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, found := k.GetPost(ctx, msg.PostID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.PostID))
+	}
+	if !post.IsRemote() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d wasn't received from another chain", msg.PostID)
+	}
+
+	// The comment is sent back through the channel the post was received on
+	if !post.RemoteAuthor.IsChannelKnown() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the channel post %d was received on is unknown", msg.PostID)
+	}
+	port, channelID := post.RemoteAuthor.DestinationPort, post.RemoteAuthor.DestinationChannel
+
+	params := k.GetParams(ctx)
+	if !params.IsDestinationChannelAllowed(channelID) {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send comments to channel %s", channelID)
+	}
+	if err := params.ValidatePostLength("", msg.Content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	// Construct the packet, the post is identified by its ID on this chain as acknowledged to the chain of the post
+	var packet types.IbcCommentPacketData
+
+	packet.PostID = msg.PostID
+	packet.Content = msg.Content
+	packet.Creator = msg.Creator
+
+	// Transmit the packet
+	sequence, err := k.TransmitIbcCommentPacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the comment in the thread of the post, its status tracks the delivery of the packet
+	id := k.AppendComment(ctx, types.Comment{
+		PostKind:      types.CommentPostKindPost,
+		PostID:        msg.PostID,
+		Content:       msg.Content,
+		Creator:       msg.Creator,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		CreatedHeight: ctx.BlockHeight(),
+		TxHash:        txHash(ctx),
+		Status:        types.CommentStatusPending,
+		Port:          port,
+		ChannelID:     channelID,
+		Sequence:      sequence,
+	})
+
+	return &types.MsgSendIbcCommentResponse{Id: id, Sequence: sequence}, nil
+}
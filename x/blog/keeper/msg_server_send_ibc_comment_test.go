@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestSendIbcCommentMsgServer(t *testing.T) {
+	creator := "A"
+	remoteAuthor := &types.RemoteAuthor{
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		Address:            "B",
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.ChannelID,
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		post    types.Post
+		request *types.MsgSendIbcComment
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcComment{PostID: 0, Content: "content"},
+		},
+		{
+			desc:    "PostNotFound",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcComment{PostID: 1, Content: "content"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "LocalPost",
+			params:  types.DefaultParams(),
+			post:    types.Post{Creator: creator},
+			request: &types.MsgSendIbcComment{PostID: 0, Content: "content"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "UnknownChannel",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: &types.RemoteAuthor{SourcePort: types.PortID, SourceChannel: "channel-1", Address: "B"}},
+			request: &types.MsgSendIbcComment{PostID: 0, Content: "content"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "ChannelNotAllowed",
+			params:  types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcComment{PostID: 0, Content: "content"},
+			err:     types.ErrChannelNotAllowed,
+		},
+		{
+			desc:    "CommentTooLong",
+			params:  types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcComment{PostID: 0, Content: "content"},
+			err:     types.ErrPostTooLong,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			k.AppendPost(ctx, tc.post)
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.Creator = creator
+			tc.request.TimeoutTimestamp = 100
+			resp, err := srv.SendIbcComment(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Zero(t, k.GetCommentCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			comment, found := k.GetCommentByPacket(ctx, types.PortID, keepertest.ChannelID, resp.Sequence)
+			require.True(t, found)
+			require.Equal(t, resp.Id, comment.Id)
+			require.Equal(t, creator, comment.Creator)
+			require.Equal(t, types.CommentPostKindPost, comment.PostKind)
+			require.Equal(t, types.CommentStatusPending, comment.Status)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendIbcDeletePost(goCtx context.Context, msg *types.MsgSendIbcDeletePost) (*types.MsgSendIbcDeletePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sentPost, port, channelID, err := k.deliveredSentPost(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the packet, the post is identified by its ID on the destination chain
+	var packet types.IbcDeletePostPacketData
+
+	packet.PostID = sentPost.PostID
+	packet.Creator = msg.Creator
+
+	// Transmit the packet, the sent post is removed once the retraction is acknowledged
+	sequence, err := k.TransmitIbcDeletePostPacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendIbcDeletePostResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestSendIbcDeletePostMsgServer(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSendIbcDeletePost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgSendIbcDeletePost{Creator: creator, Id: 0},
+		},
+		{
+			desc:    "SentPostNotFound",
+			request: &types.MsgSendIbcDeletePost{Creator: creator, Id: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSendIbcDeletePost{Creator: "B", Id: 0},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.AppendSentPost(ctx, types.SentPost{
+				Creator:            creator,
+				PostID:             7,
+				DestinationPort:    types.PortID,
+				DestinationChannel: keepertest.CounterpartyChannelID,
+				SourcePort:         types.PortID,
+				SourceChannel:      keepertest.ChannelID,
+			})
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.TimeoutTimestamp = 100
+			resp, err := srv.SendIbcDeletePost(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), resp.Sequence)
+
+			// The sent post is kept until the retraction is acknowledged
+			_, found := k.GetSentPost(ctx, tc.request.Id)
+			require.True(t, found)
+		})
+	}
+}
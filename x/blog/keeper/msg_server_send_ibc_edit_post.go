@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendIbcEditPost(goCtx context.Context, msg *types.MsgSendIbcEditPost) (*types.MsgSendIbcEditPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sentPost, port, channelID, err := k.deliveredSentPost(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.GetParams(ctx).ValidatePostLength(msg.Title, msg.Content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPostTooLong, err.Error())
+	}
+
+	// Construct the packet, the post is identified by its ID on the destination chain
+	var packet types.IbcEditPostPacketData
+
+	packet.PostID = sentPost.PostID
+	packet.Title = msg.Title
+	packet.Content = msg.Content
+	packet.Creator = msg.Creator
+
+	// Transmit the packet
+	sequence, err := k.TransmitIbcEditPostPacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendIbcEditPostResponse{Sequence: sequence}, nil
+}
+
+// deliveredSentPost returns a sent post that its creator changes, with the channel it was delivered through
+func (k Keeper) deliveredSentPost(ctx sdk.Context, id uint64, creator string) (sentPost types.SentPost, port, channelID string, err error) {
+	sentPost, found := k.GetSentPost(ctx, id)
+	if !found {
+		return sentPost, "", "", sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if creator != sentPost.Creator {
+		return sentPost, "", "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	port, channelID, found = k.SentPostChannel(ctx, sentPost)
+	if !found {
+		return sentPost, "", "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the channel sent post %d was delivered through is unknown", id)
+	}
+	if !k.GetParams(ctx).IsDestinationChannelAllowed(channelID) {
+		return sentPost, "", "", sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send post changes to channel %s", channelID)
+	}
+	return sentPost, port, channelID, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestSendIbcEditPostMsgServer(t *testing.T) {
+	creator := "A"
+	sentPost := types.SentPost{
+		Creator:            creator,
+		PostID:             7,
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.CounterpartyChannelID,
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.ChannelID,
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		params   types.Params
+		sentPost types.SentPost
+		request  *types.MsgSendIbcEditPost
+		err      error
+	}{
+		{
+			desc:     "Completed",
+			params:   types.DefaultParams(),
+			sentPost: sentPost,
+			request:  &types.MsgSendIbcEditPost{Creator: creator, Id: 0, Title: "title", Content: "content"},
+		},
+		{
+			desc:   "LegacySentPost",
+			params: types.DefaultParams(),
+			sentPost: types.SentPost{
+				Creator:            creator,
+				PostID:             7,
+				ChainID:            keepertest.CounterpartyChainID,
+				DestinationPort:    types.PortID,
+				DestinationChannel: keepertest.CounterpartyChannelID,
+			},
+			request: &types.MsgSendIbcEditPost{Creator: creator, Id: 0, Title: "title", Content: "content"},
+		},
+		{
+			desc:   "UnknownChannel",
+			params: types.DefaultParams(),
+			sentPost: types.SentPost{
+				Creator:            creator,
+				PostID:             7,
+				DestinationPort:    types.PortID,
+				DestinationChannel: "channel-9",
+			},
+			request: &types.MsgSendIbcEditPost{Creator: creator, Id: 0, Title: "title", Content: "content"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:     "SentPostNotFound",
+			params:   types.DefaultParams(),
+			sentPost: sentPost,
+			request:  &types.MsgSendIbcEditPost{Creator: creator, Id: 1, Title: "title", Content: "content"},
+			err:      sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:     "Unauthorized",
+			params:   types.DefaultParams(),
+			sentPost: sentPost,
+			request:  &types.MsgSendIbcEditPost{Creator: "B", Id: 0, Title: "title", Content: "content"},
+			err:      sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:     "ChannelNotAllowed",
+			params:   types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			sentPost: sentPost,
+			request:  &types.MsgSendIbcEditPost{Creator: creator, Id: 0, Title: "title", Content: "content"},
+			err:      types.ErrChannelNotAllowed,
+		},
+		{
+			desc:     "PostTooLong",
+			params:   types.NewParams(10, 2, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			sentPost: sentPost,
+			request:  &types.MsgSendIbcEditPost{Creator: creator, Id: 0, Title: "title", Content: "content"},
+			err:      types.ErrPostTooLong,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			k.AppendSentPost(ctx, tc.sentPost)
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.TimeoutTimestamp = 100
+			resp, err := srv.SendIbcEditPost(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), resp.Sequence)
+		})
+	}
+}
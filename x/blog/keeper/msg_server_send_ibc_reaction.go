@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendIbcReaction(goCtx context.Context, msg *types.MsgSendIbcReaction) (*types.MsgSendIbcReactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, found := k.GetPost(ctx, msg.PostID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.PostID))
+	}
+	if !post.IsRemote() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d wasn't received from another chain", msg.PostID)
+	}
+
+	// The reaction is sent back through the channel the post was received on
+	if !post.RemoteAuthor.IsChannelKnown() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the channel post %d was received on is unknown", msg.PostID)
+	}
+	port, channelID := post.RemoteAuthor.DestinationPort, post.RemoteAuthor.DestinationChannel
+	if !k.GetParams(ctx).IsDestinationChannelAllowed(channelID) {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotAllowed, "cannot send reactions to channel %s", channelID)
+	}
+
+	// Construct the packet, the post is identified by its ID on this chain as acknowledged to the chain of the post
+	var packet types.IbcReactionPacketData
+
+	packet.PostID = msg.PostID
+	packet.Reaction = msg.Reaction
+	packet.Creator = msg.Creator
+
+	// Transmit the packet, the reaction is only kept by the chain of the post
+	sequence, err := k.TransmitIbcReactionPacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendIbcReactionResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestSendIbcReactionMsgServer(t *testing.T) {
+	creator := "A"
+	remoteAuthor := &types.RemoteAuthor{
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		Address:            "B",
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.ChannelID,
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		post    types.Post
+		request *types.MsgSendIbcReaction
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcReaction{PostID: 0, Reaction: types.ReactionLike},
+		},
+		{
+			desc:    "PostNotFound",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcReaction{PostID: 1, Reaction: types.ReactionLike},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "LocalPost",
+			params:  types.DefaultParams(),
+			post:    types.Post{Creator: creator},
+			request: &types.MsgSendIbcReaction{PostID: 0, Reaction: types.ReactionLike},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "UnknownChannel",
+			params:  types.DefaultParams(),
+			post:    types.Post{RemoteAuthor: &types.RemoteAuthor{SourcePort: types.PortID, SourceChannel: "channel-1", Address: "B"}},
+			request: &types.MsgSendIbcReaction{PostID: 0, Reaction: types.ReactionLike},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "ChannelNotAllowed",
+			params:  types.NewParams(10, 10, nil, []string{"channel-9"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+			post:    types.Post{RemoteAuthor: remoteAuthor},
+			request: &types.MsgSendIbcReaction{PostID: 0, Reaction: types.ReactionLike},
+			err:     types.ErrChannelNotAllowed,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.SetParams(ctx, tc.params)
+			k.AppendPost(ctx, tc.post)
+			srv := keeper.NewMsgServerImpl(*k)
+
+			tc.request.Creator = creator
+			tc.request.TimeoutTimestamp = 100
+			_, err := srv.SendIbcReaction(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			// The reaction is only kept by the chain of the post
+			require.Empty(t, k.GetAllReaction(ctx))
+		})
+	}
+}
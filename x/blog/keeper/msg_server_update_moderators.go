@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) UpdateModerators(goCtx context.Context, msg *types.MsgUpdateModerators) (*types.MsgUpdateModeratorsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, address := range msg.Remove {
+		if _, found := k.GetModerator(ctx, address); !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not a moderator", address)
+		}
+		k.RemoveModerator(ctx, address)
+		k.logModerationAction(ctx, msg.Authority, types.ModerationActionRemoveModerator, address, "")
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveModerator,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+			),
+		)
+	}
+
+	for _, address := range msg.Add {
+		if _, found := k.GetModerator(ctx, address); found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a moderator", address)
+		}
+		k.SetModerator(ctx, types.Moderator{
+			Address:     address,
+			AddedAt:     ctx.BlockTime().Unix(),
+			AddedHeight: ctx.BlockHeight(),
+		})
+		k.logModerationAction(ctx, msg.Authority, types.ModerationActionAddModerator, address, "")
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddModerator,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+			),
+		)
+	}
+
+	return &types.MsgUpdateModeratorsResponse{}, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestUpdateModeratorsMsgServer(t *testing.T) {
+	existing := sample.AccAddress()
+	added := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc       string
+		authority  func(k *keeper.Keeper) string
+		add        []string
+		remove     []string
+		err        error
+		moderators []string
+	}{
+		{
+			desc:      "InvalidAuthority",
+			authority: func(*keeper.Keeper) string { return sample.AccAddress() },
+			add:       []string{added},
+			err:       govtypes.ErrInvalidSigner,
+		},
+		{
+			desc:   "NotModerator",
+			remove: []string{added},
+			err:    sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "AlreadyModerator",
+			add:  []string{existing},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:       "Completed",
+			add:        []string{added},
+			remove:     []string{existing},
+			moderators: []string{added},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(5)
+			k.SetModerator(ctx, types.Moderator{Address: existing})
+			srv := keeper.NewMsgServerImpl(*k)
+			authority := k.GetAuthority()
+			if tc.authority != nil {
+				authority = tc.authority(k)
+			}
+
+			_, err := srv.UpdateModerators(sdk.WrapSDKContext(ctx), &types.MsgUpdateModerators{Authority: authority, Add: tc.add, Remove: tc.remove})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var moderators []string
+			for _, moderator := range k.GetAllModerator(ctx) {
+				moderators = append(moderators, moderator.Address)
+			}
+			require.ElementsMatch(t, tc.moderators, moderators)
+			moderator, _ := k.GetModerator(ctx, added)
+			require.Equal(t, int64(5), moderator.AddedHeight)
+
+			log := k.GetAllModerationAction(ctx)
+			require.Len(t, log, 2)
+			require.Equal(t, types.ModerationActionRemoveModerator, log[0].Action)
+			require.Equal(t, existing, log[0].Target)
+			require.Equal(t, types.ModerationActionAddModerator, log[1].Action)
+			require.Equal(t, added, log[1].Target)
+			require.Equal(t, authority, log[1].Moderator)
+		})
+	}
+}
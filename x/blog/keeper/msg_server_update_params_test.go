@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestUpdateParamsMsgServer(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := types.NewParams(100, 1000, []string{"channel-0"}, []string{"channel-1"}, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, []string{"channel-0"}, 10, 3, 50, 5, 500)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdateParams
+		err     error
+	}{
+		{
+			desc:    "InvalidAuthority",
+			request: &types.MsgUpdateParams{Authority: sample.AccAddress(), Params: params},
+			err:     govtypes.ErrInvalidSigner,
+		},
+		{
+			desc:    "InvalidParams",
+			request: &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(0, 1000, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow)},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "InvalidModerationExpiry",
+			request: &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(100, 1000, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, []string{"channel-0"}, 0, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow)},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdateParams(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params, k.GetParams(ctx))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// GetNotificationCount returns the number of notifications of an address
+func (k Keeper) GetNotificationCount(ctx sdk.Context, address string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationCountKey))
+	bz := store.Get([]byte(address))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNotificationCount sets the number of notifications of an address
+func (k Keeper) SetNotificationCount(ctx sdk.Context, address string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationCountKey))
+	store.Set([]byte(address), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendNotification appends a notification to the inbox of its address and returns its id
+func (k Keeper) AppendNotification(ctx sdk.Context, notification types.Notification) uint64 {
+	count := k.GetNotificationCount(ctx, notification.Address)
+
+	notification.Id = count
+	k.SetNotification(ctx, notification)
+	k.SetNotificationCount(ctx, notification.Address, count+1)
+
+	return count
+}
+
+// SetNotification set a specific notification in the store from its index
+func (k Keeper) SetNotification(ctx sdk.Context, notification types.Notification) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationKeyPrefix))
+	b := k.cdc.MustMarshal(&notification)
+	store.Set(types.NotificationKey(
+		notification.Address,
+		notification.Id,
+	), b)
+
+	if notification.Read {
+		k.removeIndex(ctx, types.NotificationUnreadKey, notification.Address, notification.Id)
+	} else {
+		k.setIndex(ctx, types.NotificationUnreadKey, notification.Address, notification.Id)
+	}
+}
+
+// GetNotification returns a notification from its index
+func (k Keeper) GetNotification(
+	ctx sdk.Context,
+	address string,
+	id uint64,
+) (val types.Notification, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationKeyPrefix))
+
+	b := store.Get(types.NotificationKey(
+		address,
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetUnreadNotificationIDs returns the ids of the unread notifications of an address, from the oldest
+func (k Keeper) GetUnreadNotificationIDs(ctx sdk.Context, address string) (ids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationUnreadKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.IndexKeyPrefix(address))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+
+	return
+}
+
+// GetAllNotification returns all notification
+func (k Keeper) GetAllNotification(ctx sdk.Context) (list []types.Notification) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Notification
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// notifyMentions notifies the addresses mentioned in the title and content of a post, bounded by the MaxMentions
+// param. The author of a local post isn't notified of its own mentions.
+func (k Keeper) notifyMentions(ctx sdk.Context, post types.Post) {
+	var chainID string
+	if post.IsRemote() {
+		chainID = post.RemoteAuthor.ChainID
+	}
+
+	for _, address := range types.ExtractMentions(k.MaxMentions(ctx), post.Title, post.Content) {
+		if address == post.Creator {
+			continue
+		}
+		k.AppendNotification(ctx, types.Notification{
+			Address:       address,
+			PostID:        post.Id,
+			Author:        post.Author(),
+			ChainID:       chainID,
+			CreatedAt:     ctx.BlockTime().Unix(),
+			CreatedHeight: ctx.BlockHeight(),
+		})
+	}
+}
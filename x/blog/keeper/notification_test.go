@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNNotification(keeper *keeper.Keeper, ctx sdk.Context, address string, n int) []types.Notification {
+	items := make([]types.Notification, n)
+	for i := range items {
+		items[i].Address = address
+		items[i].Id = keeper.AppendNotification(ctx, items[i])
+	}
+	return items
+}
+
+func TestNotificationGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNNotification(keeper, ctx, "A", 3)
+	// The notifications are numbered by address
+	createNNotification(keeper, ctx, "AA", 2)
+	for _, item := range items {
+		got, found := keeper.GetNotification(ctx, item.Address, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Equal(t, uint64(3), keeper.GetNotificationCount(ctx, "A"))
+	require.Equal(t, uint64(2), keeper.GetNotificationCount(ctx, "AA"))
+	require.Equal(t, []uint64{0, 1, 2}, keeper.GetUnreadNotificationIDs(ctx, "A"))
+	require.Len(t, keeper.GetAllNotification(ctx), 5)
+
+	items[1].Read = true
+	keeper.SetNotification(ctx, items[1])
+	require.Equal(t, []uint64{0, 2}, keeper.GetUnreadNotificationIDs(ctx, "A"))
+}
+
+func TestNotifyMentionsOnCreatePost(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	author, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	resp, err := srv.CreatePost(sdk.WrapSDKContext(ctx), &types.MsgCreatePost{
+		Creator: author,
+		Title:   fmt.Sprintf("Hello @%s", alice),
+		Content: fmt.Sprintf("@%s @%s and @%s", alice, bob, author),
+	})
+	require.NoError(t, err)
+
+	notification, found := k.GetNotification(ctx, alice, 0)
+	require.True(t, found)
+	require.Equal(t, types.Notification{
+		Address:       alice,
+		Id:            0,
+		PostID:        resp.Id,
+		Author:        author,
+		CreatedAt:     1000,
+		CreatedHeight: 10,
+	}, notification)
+	require.Equal(t, uint64(1), k.GetNotificationCount(ctx, alice))
+	require.Equal(t, uint64(1), k.GetNotificationCount(ctx, bob))
+	// The author isn't notified of its own mention
+	require.Zero(t, k.GetNotificationCount(ctx, author))
+}
+
+func TestNotifyMentionsMaxMentions(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	content := fmt.Sprintf("@%s @%s", alice, bob)
+
+	params := types.DefaultParams()
+	params.MaxMentions = 1
+	k.SetParams(ctx, params)
+	_, err := srv.CreatePost(sdk.WrapSDKContext(ctx), &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Content: content})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetNotificationCount(ctx, alice))
+	require.Zero(t, k.GetNotificationCount(ctx, bob))
+
+	// No mention is notified when the cap is 0
+	params.MaxMentions = 0
+	k.SetParams(ctx, params)
+	_, err = srv.CreatePost(sdk.WrapSDKContext(ctx), &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Content: content})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetNotificationCount(ctx, alice))
+}
+
+func TestNotifyMentionsOnRecvIbcPostPacket(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	alice := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "Hello @" + alice, Creator: "mars1author"}
+
+	ack, err := k.OnRecvIbcPostPacket(ctx, packet, data)
+	require.NoError(t, err)
+	require.Equal(t, "0", ack.PostID)
+
+	notification, found := k.GetNotification(ctx, alice, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(0), notification.PostID)
+	require.Equal(t, data.Creator, notification.Author)
+	require.Equal(t, keepertest.CounterpartyChainID, notification.ChainID)
+}
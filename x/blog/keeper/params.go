@@ -7,10 +7,109 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.MaxTitleLength(ctx),
+		k.MaxContentLength(ctx),
+		k.AllowedSourceChannels(ctx),
+		k.AllowedDestinationChannels(ctx),
+		k.MaxIndexedTokens(ctx),
+		k.MaxTags(ctx),
+		k.MaxTagLength(ctx),
+		k.MaxMentions(ctx),
+		k.ModeratedChannels(ctx),
+		k.ModerationExpiryBlocks(ctx),
+		k.ReportHideThreshold(ctx),
+		k.RateLimitWindowBlocks(ctx),
+		k.MaxSentPostsPerWindow(ctx),
+		k.MaxReceivedPacketsPerWindow(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// MaxTitleLength returns the MaxTitleLength param
+func (k Keeper) MaxTitleLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTitleLength, &res)
+	return
+}
+
+// MaxContentLength returns the MaxContentLength param
+func (k Keeper) MaxContentLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxContentLength, &res)
+	return
+}
+
+// AllowedSourceChannels returns the AllowedSourceChannels param
+func (k Keeper) AllowedSourceChannels(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedSourceChannels, &res)
+	return
+}
+
+// AllowedDestinationChannels returns the AllowedDestinationChannels param
+func (k Keeper) AllowedDestinationChannels(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedDestinationChannels, &res)
+	return
+}
+
+// MaxIndexedTokens returns the MaxIndexedTokens param
+func (k Keeper) MaxIndexedTokens(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxIndexedTokens, &res)
+	return
+}
+
+// MaxTags returns the MaxTags param
+func (k Keeper) MaxTags(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTags, &res)
+	return
+}
+
+// MaxTagLength returns the MaxTagLength param
+func (k Keeper) MaxTagLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTagLength, &res)
+	return
+}
+
+// MaxMentions returns the MaxMentions param
+func (k Keeper) MaxMentions(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMentions, &res)
+	return
+}
+
+// ModeratedChannels returns the ModeratedChannels param
+func (k Keeper) ModeratedChannels(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyModeratedChannels, &res)
+	return
+}
+
+// ModerationExpiryBlocks returns the ModerationExpiryBlocks param
+func (k Keeper) ModerationExpiryBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyModerationExpiryBlocks, &res)
+	return
+}
+
+// ReportHideThreshold returns the ReportHideThreshold param
+func (k Keeper) ReportHideThreshold(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyReportHideThreshold, &res)
+	return
+}
+
+// RateLimitWindowBlocks returns the RateLimitWindowBlocks param
+func (k Keeper) RateLimitWindowBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRateLimitWindowBlocks, &res)
+	return
+}
+
+// MaxSentPostsPerWindow returns the MaxSentPostsPerWindow param
+func (k Keeper) MaxSentPostsPerWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxSentPostsPerWindow, &res)
+	return
+}
+
+// MaxReceivedPacketsPerWindow returns the MaxReceivedPacketsPerWindow param
+func (k Keeper) MaxReceivedPacketsPerWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxReceivedPacketsPerWindow, &res)
+	return
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// SetPendingPost set a specific pendingPost in the store from its index
+func (k Keeper) SetPendingPost(ctx sdk.Context, pendingPost types.PendingPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPostKeyPrefix))
+	b := k.cdc.MustMarshal(&pendingPost)
+	store.Set(types.PendingPostKey(
+		pendingPost.Port,
+		pendingPost.ChannelID,
+		pendingPost.Sequence,
+	), b)
+}
+
+// GetPendingPost returns a pendingPost from its index
+func (k Keeper) GetPendingPost(
+	ctx sdk.Context,
+	port string,
+	channelID string,
+	sequence uint64,
+) (val types.PendingPost, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPostKeyPrefix))
+
+	b := store.Get(types.PendingPostKey(
+		port,
+		channelID,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingPost removes a pendingPost from the store
+func (k Keeper) RemovePendingPost(
+	ctx sdk.Context,
+	port string,
+	channelID string,
+	sequence uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPostKeyPrefix))
+	store.Delete(types.PendingPostKey(
+		port,
+		channelID,
+		sequence,
+	))
+}
+
+// GetAllPendingPost returns all pendingPost
+func (k Keeper) GetAllPendingPost(ctx sdk.Context) (list []types.PendingPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingPostKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingPost
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNPendingPost(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PendingPost {
+	items := make([]types.PendingPost, n)
+	for i := range items {
+		items[i].Port = strconv.Itoa(i)
+		items[i].ChannelID = strconv.Itoa(i)
+		items[i].Sequence = uint64(i)
+
+		keeper.SetPendingPost(ctx, items[i])
+	}
+	return items
+}
+
+func TestPendingPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPendingPost(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPendingPost(ctx,
+			item.Port,
+			item.ChannelID,
+			item.Sequence,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestPendingPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPendingPost(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePendingPost(ctx,
+			item.Port,
+			item.ChannelID,
+			item.Sequence,
+		)
+		_, found := keeper.GetPendingPost(ctx,
+			item.Port,
+			item.ChannelID,
+			item.Sequence,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPendingPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPendingPost(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPendingPost(ctx)),
+	)
+}
@@ -46,6 +46,7 @@ func (k Keeper) AppendPost(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostKey))
 	appendedValue := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), appendedValue)
+	k.setPostIndexes(ctx, post)
 
 	// Update post count
 	k.SetPostCount(ctx, count+1)
@@ -55,9 +56,14 @@ func (k Keeper) AppendPost(
 
 // SetPost set a specific post in the store
 func (k Keeper) SetPost(ctx sdk.Context, post types.Post) {
+	if previous, found := k.GetPost(ctx, post.Id); found {
+		k.removePostIndexes(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostKey))
 	b := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), b)
+	k.setPostIndexes(ctx, post)
 }
 
 // GetPost returns a post from its id
@@ -73,10 +79,36 @@ func (k Keeper) GetPost(ctx sdk.Context, id uint64) (val types.Post, found bool)
 
 // RemovePost removes a post from the store
 func (k Keeper) RemovePost(ctx sdk.Context, id uint64) {
+	if previous, found := k.GetPost(ctx, id); found {
+		k.removePostIndexes(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostKey))
 	store.Delete(GetPostIDBytes(id))
 }
 
+// setPostIndexes adds a post to the secondary indexes
+func (k Keeper) setPostIndexes(ctx sdk.Context, post types.Post) {
+	k.setIndex(ctx, types.PostByCreatorKey, post.Creator, post.Id)
+	k.setPostSearchIndex(ctx, post)
+	k.setPostTagIndex(ctx, post)
+	if post.IsRemote() {
+		k.setIndex(ctx, types.PostBySourceChannelKey, post.RemoteAuthor.SourceChannel, post.Id)
+		k.setIndex(ctx, types.PostByRemoteAuthorKey, types.RemoteAuthorIndexValue(post.RemoteAuthor.ChainID, post.RemoteAuthor.Address), post.Id)
+	}
+}
+
+// removePostIndexes removes a post from the secondary indexes
+func (k Keeper) removePostIndexes(ctx sdk.Context, post types.Post) {
+	k.removeIndex(ctx, types.PostByCreatorKey, post.Creator, post.Id)
+	k.removePostSearchIndex(ctx, post.Id)
+	k.removePostTagIndex(ctx, post.Id)
+	if post.IsRemote() {
+		k.removeIndex(ctx, types.PostBySourceChannelKey, post.RemoteAuthor.SourceChannel, post.Id)
+		k.removeIndex(ctx, types.PostByRemoteAuthorKey, types.RemoteAuthorIndexValue(post.RemoteAuthor.ChainID, post.RemoteAuthor.Address), post.Id)
+	}
+}
+
 // GetAllPost returns all post
 func (k Keeper) GetAllPost(ctx sdk.Context) (list []types.Post) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostKey))
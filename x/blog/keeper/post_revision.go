@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// GetPostRevisionCount returns the number of revisions of a post
+func (k Keeper) GetPostRevisionCount(ctx sdk.Context, postID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PostRevisionPostKey(postID))
+
+	defer iterator.Close()
+
+	// The revisions are numbered in sequence, the last one gives the count
+	if !iterator.Valid() {
+		return 0
+	}
+	var val types.PostRevision
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val.Revision + 1
+}
+
+// AppendPostRevision appends a revision to the revisions of a post and returns its number
+func (k Keeper) AppendPostRevision(ctx sdk.Context, postRevision types.PostRevision) uint64 {
+	postRevision.Revision = k.GetPostRevisionCount(ctx, postRevision.PostID)
+	k.SetPostRevision(ctx, postRevision)
+
+	return postRevision.Revision
+}
+
+// SetPostRevision set a specific postRevision in the store from its index
+func (k Keeper) SetPostRevision(ctx sdk.Context, postRevision types.PostRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+	b := k.cdc.MustMarshal(&postRevision)
+	store.Set(types.PostRevisionKey(
+		postRevision.PostID,
+		postRevision.Revision,
+	), b)
+}
+
+// GetPostRevision returns a postRevision from its index
+func (k Keeper) GetPostRevision(
+	ctx sdk.Context,
+	postID uint64,
+	revision uint64,
+) (val types.PostRevision, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+
+	b := store.Get(types.PostRevisionKey(
+		postID,
+		revision,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetPostRevisions returns the revisions of a post, from the oldest
+func (k Keeper) GetPostRevisions(ctx sdk.Context, postID uint64) (list []types.PostRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.PostRevisionPostKey(postID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PostRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemovePostRevisions removes the revisions of a post from the store
+func (k Keeper) RemovePostRevisions(ctx sdk.Context, postID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.PostRevisionPostKey(postID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// EditPost replaces the title and content of a post and records the edit in the revisions of the post. The
+// version of the post before its first edit is recorded as revision 0. It returns the revision of the edit.
+func (k Keeper) EditPost(ctx sdk.Context, post types.Post, title, content, editor string) uint64 {
+	if k.GetPostRevisionCount(ctx, post.Id) == 0 {
+		k.AppendPostRevision(ctx, types.PostRevision{
+			PostID:       post.Id,
+			Title:        post.Title,
+			Content:      post.Content,
+			Editor:       post.Author(),
+			EditedAt:     post.CreatedAt,
+			EditedHeight: post.CreatedHeight,
+		})
+	}
+
+	post.Title = title
+	post.Content = content
+	k.SetPost(ctx, post)
+
+	return k.AppendPostRevision(ctx, types.PostRevision{
+		PostID:       post.Id,
+		Title:        title,
+		Content:      content,
+		Editor:       editor,
+		EditedAt:     ctx.BlockTime().Unix(),
+		EditedHeight: ctx.BlockHeight(),
+	})
+}
+
+// GetAllPostRevision returns all postRevision
+func (k Keeper) GetAllPostRevision(ctx sdk.Context) (list []types.PostRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostRevisionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PostRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
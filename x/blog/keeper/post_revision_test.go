@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestPostRevisionAppend(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	for i := uint64(0); i < 3; i++ {
+		require.Equal(t, i, keeper.GetPostRevisionCount(ctx, 1))
+		require.Equal(t, i, keeper.AppendPostRevision(ctx, types.PostRevision{PostID: 1}))
+	}
+	// The revisions are counted per post
+	require.Zero(t, keeper.GetPostRevisionCount(ctx, 0))
+	require.Zero(t, keeper.AppendPostRevision(ctx, types.PostRevision{PostID: 0}))
+
+	revision, found := keeper.GetPostRevision(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, types.PostRevision{PostID: 1, Revision: 2}, revision)
+	_, found = keeper.GetPostRevision(ctx, 1, 3)
+	require.False(t, found)
+	require.Len(t, keeper.GetPostRevisions(ctx, 1), 3)
+}
+
+func TestPostRevisionRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	for i := 0; i < 3; i++ {
+		keeper.AppendPostRevision(ctx, types.PostRevision{PostID: 1})
+	}
+	keeper.AppendPostRevision(ctx, types.PostRevision{PostID: 2})
+
+	keeper.RemovePostRevisions(ctx, 1)
+	require.Empty(t, keeper.GetPostRevisions(ctx, 1))
+	require.Zero(t, keeper.GetPostRevisionCount(ctx, 1))
+	require.Len(t, keeper.GetPostRevisions(ctx, 2), 1)
+}
+
+func TestEditPost(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	post := types.Post{
+		Title:         "title",
+		Content:       "content",
+		CreatedAt:     100,
+		CreatedHeight: 1,
+		RemoteAuthor:  &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-1", Address: "A"},
+	}
+	post.Id = keeper.AppendPost(ctx, post)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	require.Equal(t, uint64(1), keeper.EditPost(ctx, post, "title 1", "content 1", "A"))
+	edited, found := keeper.GetPost(ctx, post.Id)
+	require.True(t, found)
+	require.Equal(t, "title 1", edited.Title)
+	require.Equal(t, "content 1", edited.Content)
+	require.Equal(t, post.CreatedAt, edited.CreatedAt)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1010, 0))
+	require.Equal(t, uint64(2), keeper.EditPost(ctx, edited, "title 2", "content 2", "A"))
+
+	// The original post is recorded before the first edit only
+	require.Equal(t, []types.PostRevision{
+		{PostID: post.Id, Revision: 0, Title: "title", Content: "content", Editor: "A", EditedAt: 100, EditedHeight: 1},
+		{PostID: post.Id, Revision: 1, Title: "title 1", Content: "content 1", Editor: "A", EditedAt: 1000, EditedHeight: 10},
+		{PostID: post.Id, Revision: 2, Title: "title 2", Content: "content 2", Editor: "A", EditedAt: 1010, EditedHeight: 11},
+	}, keeper.GetPostRevisions(ctx, post.Id))
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"planet/x/blog/types"
+)
+
+// setPostSearchIndex adds a post to the search index with the tokens of its title and content. The number of
+// tokens is bounded by the MaxIndexedTokens param so that the gas spent indexing a post is bounded too.
+func (k Keeper) setPostSearchIndex(ctx sdk.Context, post types.Post) {
+	tokens := types.SearchTokens(k.MaxIndexedTokens(ctx), post.Title, post.Content)
+	if len(tokens) == 0 {
+		return
+	}
+	for _, token := range tokens {
+		k.setIndex(ctx, types.PostBySearchTokenKey, token, post.Id)
+	}
+
+	// The tokens are kept to remove the post from the index even if the param changes
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostSearchTokensKey))
+	store.Set(GetPostIDBytes(post.Id), types.SearchTokensValue(tokens))
+}
+
+// removePostSearchIndex removes a post from the search index
+func (k Keeper) removePostSearchIndex(ctx sdk.Context, id uint64) {
+	for _, token := range k.GetPostSearchTokens(ctx, id) {
+		k.removeIndex(ctx, types.PostBySearchTokenKey, token, id)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostSearchTokensKey))
+	store.Delete(GetPostIDBytes(id))
+}
+
+// GetPostSearchTokens returns the search tokens a post is indexed with
+func (k Keeper) GetPostSearchTokens(ctx sdk.Context, id uint64) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostSearchTokensKey))
+	return types.ParseSearchTokensValue(store.Get(GetPostIDBytes(id)))
+}
+
+// paginateSearch paginates over the ids of the posts indexed with all the tokens if matchAll is true, or with
+// any of the tokens otherwise, that onResult accepts. The ids are iterated in ascending order, the pagination
+// follows query.FilteredPaginate.
+func (k Keeper) paginateSearch(
+	ctx sdk.Context,
+	tokens []string,
+	matchAll bool,
+	pageReq *query.PageRequest,
+	onResult func(id uint64, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostBySearchTokenKey))
+	iterators := make([]sdk.Iterator, len(tokens))
+	for i, token := range tokens {
+		iterators[i] = prefix.NewStore(store, types.IndexKeyPrefix(token)).Iterator(pageReq.Key, nil)
+		defer iterators[i].Close()
+	}
+	next := nextSearchMatchAny(iterators)
+	if matchAll {
+		next = nextSearchMatchAll(store, tokens, iterators[0])
+	}
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+	for key, ok := next(); ok; key, ok = next() {
+		if len(pageReq.Key) != 0 {
+			if count == limit {
+				nextKey = key
+				break
+			}
+			hit, err := onResult(sdk.BigEndianToUint64(key), true)
+			if err != nil {
+				return nil, err
+			}
+			if hit {
+				count++
+			}
+			continue
+		}
+
+		accumulate := count >= pageReq.Offset && count < pageReq.Offset+limit
+		hit, err := onResult(sdk.BigEndianToUint64(key), accumulate)
+		if err != nil {
+			return nil, err
+		}
+		if !hit {
+			continue
+		}
+		count++
+		if count == pageReq.Offset+limit+1 {
+			nextKey = key
+			if !countTotal {
+				break
+			}
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if len(pageReq.Key) == 0 && countTotal {
+		res.Total = count
+	}
+	return res, nil
+}
+
+// nextSearchMatchAll returns the function iterating over the keys of the posts indexed with all the tokens, the
+// index of the first token is iterated and filtered with the indexes of the others
+func nextSearchMatchAll(store prefix.Store, tokens []string, iterator sdk.Iterator) func() ([]byte, bool) {
+	return func() ([]byte, bool) {
+		for ; iterator.Valid(); iterator.Next() {
+			key := append([]byte{}, iterator.Key()...)
+			matches := true
+			for _, token := range tokens[1:] {
+				if !store.Has(append(types.IndexKeyPrefix(token), key...)) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				iterator.Next()
+				return key, true
+			}
+		}
+		return nil, false
+	}
+}
+
+// nextSearchMatchAny returns the function iterating over the keys of the posts indexed with any of the tokens,
+// the indexes of the tokens are merged in ascending order
+func nextSearchMatchAny(iterators []sdk.Iterator) func() ([]byte, bool) {
+	return func() ([]byte, bool) {
+		var key []byte
+		for _, iterator := range iterators {
+			if iterator.Valid() && (key == nil || bytes.Compare(iterator.Key(), key) < 0) {
+				key = append([]byte{}, iterator.Key()...)
+			}
+		}
+		if key == nil {
+			return nil, false
+		}
+		for _, iterator := range iterators {
+			if iterator.Valid() && bytes.Equal(iterator.Key(), key) {
+				iterator.Next()
+			}
+		}
+		return key, true
+	}
+}
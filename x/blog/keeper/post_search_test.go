@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestPostSearchIndex(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+
+	id := keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "Hello Mars", Content: "hello"})
+	require.Equal(t, []string{"hello", "mars"}, keeper.GetPostSearchTokens(ctx, id))
+
+	// Editing a post reindexes it
+	keeper.EditPost(ctx, types.Post{Id: id, Creator: "A", Title: "Hello Mars"}, "Hello Venus", "", "A")
+	require.Equal(t, []string{"hello", "venus"}, keeper.GetPostSearchTokens(ctx, id))
+
+	keeper.RemovePost(ctx, id)
+	require.Empty(t, keeper.GetPostSearchTokens(ctx, id))
+}
+
+func TestPostSearchIndexMaxTokens(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	params := types.DefaultParams()
+	params.MaxIndexedTokens = 2
+	keeper.SetParams(ctx, params)
+
+	id := keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "one two", Content: "three"})
+	require.Equal(t, []string{"one", "two"}, keeper.GetPostSearchTokens(ctx, id))
+
+	// The post is removed from the index with the tokens it was indexed with
+	params.MaxIndexedTokens = 0
+	keeper.SetParams(ctx, params)
+	keeper.SetPost(ctx, types.Post{Id: id, Creator: "A", Title: "one two", Content: "three"})
+	require.Empty(t, keeper.GetPostSearchTokens(ctx, id))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// setPostTagIndex adds a post to the index of its tags and hashtags, bounded by the MaxTags and MaxTagLength params
+func (k Keeper) setPostTagIndex(ctx sdk.Context, post types.Post) {
+	tags := types.PostTags(k.MaxTags(ctx), k.MaxTagLength(ctx), post.Tags, post.Content)
+	if len(tags) == 0 {
+		return
+	}
+	for _, tag := range tags {
+		k.setIndex(ctx, types.PostByTagKey, tag, post.Id)
+		k.setTagCount(ctx, tag, k.GetTagCount(ctx, tag)+1)
+	}
+
+	// The tags are kept to remove the post from the index even if the params change
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostTagsKey))
+	store.Set(GetPostIDBytes(post.Id), types.TagsValue(tags))
+}
+
+// removePostTagIndex removes a post from the index of its tags
+func (k Keeper) removePostTagIndex(ctx sdk.Context, id uint64) {
+	for _, tag := range k.GetPostTags(ctx, id) {
+		k.removeIndex(ctx, types.PostByTagKey, tag, id)
+		if count := k.GetTagCount(ctx, tag); count > 0 {
+			k.setTagCount(ctx, tag, count-1)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostTagsKey))
+	store.Delete(GetPostIDBytes(id))
+}
+
+// GetPostTags returns the normalized tags a post is indexed with
+func (k Keeper) GetPostTags(ctx sdk.Context, id uint64) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PostTagsKey))
+	return types.ParseTagsValue(store.Get(GetPostIDBytes(id)))
+}
+
+// GetTagCount returns the number of posts indexed with a tag
+func (k Keeper) GetTagCount(ctx sdk.Context, tag string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TagCountKey))
+	bz := store.Get([]byte(tag))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setTagCount sets the number of posts indexed with a tag and moves the tag in the index of the tags by count,
+// tags without posts are removed
+func (k Keeper) setTagCount(ctx sdk.Context, tag string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TagCountKey))
+	byCountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TagByCountKeyPrefix))
+
+	if previous := k.GetTagCount(ctx, tag); previous > 0 {
+		byCountStore.Delete(types.TagByCountKey(tag, previous))
+	}
+	if count == 0 {
+		store.Delete([]byte(tag))
+		return
+	}
+	store.Set([]byte(tag), sdk.Uint64ToBigEndian(count))
+	byCountStore.Set(types.TagByCountKey(tag, count), []byte{})
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestPostTagIndex(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+
+	id := keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "title", Content: "Hello #Mars", Tags: []string{"space"}})
+	require.Equal(t, []string{"space", "mars"}, keeper.GetPostTags(ctx, id))
+	require.Equal(t, uint64(1), keeper.GetTagCount(ctx, "mars"))
+
+	// Editing the content reindexes the hashtags, the tags are kept
+	keeper.EditPost(ctx, types.Post{Id: id, Creator: "A", Title: "title", Tags: []string{"space"}}, "title", "Hello #venus", "A")
+	require.Equal(t, []string{"space", "venus"}, keeper.GetPostTags(ctx, id))
+	require.Zero(t, keeper.GetTagCount(ctx, "mars"))
+	require.Equal(t, uint64(1), keeper.GetTagCount(ctx, "venus"))
+
+	keeper.RemovePost(ctx, id)
+	require.Empty(t, keeper.GetPostTags(ctx, id))
+	require.Zero(t, keeper.GetTagCount(ctx, "space"))
+	require.Zero(t, keeper.GetTagCount(ctx, "venus"))
+}
+
+func TestPostsByTagQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	posts := []types.Post{
+		{Creator: "A", Title: "title", Tags: []string{"Mars"}},
+		{Creator: "A", Title: "title", Content: "#venus"},
+		{Creator: "A", Title: "title", Content: "#mars and #venus"},
+	}
+	for i := range posts {
+		posts[i].Id = keeper.AppendPost(ctx, posts[i])
+	}
+
+	resp, err := keeper.PostsByTag(wctx, &types.QueryPostsByTagRequest{Tag: "mars"})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Post{posts[0], posts[2]}), nullify.Fill(resp.Post))
+
+	// Tags are case insensitive and can be given as hashtags
+	resp, err = keeper.PostsByTag(wctx, &types.QueryPostsByTagRequest{Tag: "#Venus"})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(posts[1:]), nullify.Fill(resp.Post))
+
+	// Hidden posts are left out unless requested
+	posts[2].Hidden = true
+	keeper.SetPost(ctx, posts[2])
+	resp, err = keeper.PostsByTag(wctx, &types.QueryPostsByTagRequest{Tag: "mars", Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, 1, int(resp.Pagination.Total))
+	require.Equal(t, nullify.Fill(posts[:1]), nullify.Fill(resp.Post))
+	resp, err = keeper.PostsByTag(wctx, &types.QueryPostsByTagRequest{Tag: "mars", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Post{posts[0], posts[2]}), nullify.Fill(resp.Post))
+
+	_, err = keeper.PostsByTag(wctx, &types.QueryPostsByTagRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	_, err = keeper.PostsByTag(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestTopTagsQuery(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "title", Content: "#mars #venus #earth"})
+	keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "title", Content: "#venus #earth"})
+	id := keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "title", Content: "#earth #jupiter"})
+
+	resp, err := keeper.TopTags(wctx, &types.QueryTopTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{
+		{Tag: "earth", Count: 3},
+		{Tag: "venus", Count: 2},
+		{Tag: "jupiter", Count: 1},
+		{Tag: "mars", Count: 1},
+	}, resp.TagCount)
+
+	resp, err = keeper.TopTags(wctx, &types.QueryTopTagsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{{Tag: "earth", Count: 3}}, resp.TagCount)
+
+	// Tags without posts are removed
+	keeper.RemovePost(ctx, id)
+	resp, err = keeper.TopTags(wctx, &types.QueryTopTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{
+		{Tag: "earth", Count: 2},
+		{Tag: "venus", Count: 2},
+		{Tag: "mars", Count: 1},
+	}, resp.TagCount)
+
+	_, err = keeper.TopTags(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -57,3 +57,29 @@ func TestPostCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetPostCount(ctx))
 }
+
+func TestPostIndexes(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	postsByCreator := func(creator string) []types.Post {
+		resp, err := keeper.PostsByCreator(wctx, &types.QueryPostsByCreatorRequest{Creator: creator})
+		require.NoError(t, err)
+		return resp.Post
+	}
+
+	id := keeper.AppendPost(ctx, types.Post{Creator: "A", Title: "title"})
+	require.Len(t, postsByCreator("A"), 1)
+
+	// Updating the indexed value moves the post in the index
+	keeper.SetPost(ctx, types.Post{Id: id, Creator: "B", Title: "title"})
+	require.Empty(t, postsByCreator("A"))
+	require.Len(t, postsByCreator("B"), 1)
+
+	// A creator prefixing another one doesn't match its posts
+	keeper.AppendPost(ctx, types.Post{Creator: "BB", Title: "title"})
+	require.Len(t, postsByCreator("B"), 1)
+
+	keeper.RemovePost(ctx, id)
+	require.Empty(t, postsByCreator("B"))
+	require.Len(t, postsByCreator("BB"), 1)
+}
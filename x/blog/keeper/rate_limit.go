@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+// The rate limit counters decide whether a transaction fails or a packet is refused, so they are consensus state:
+// they are kept in the module store rather than the memory store, which a restarted node would have lost, or the
+// transient store, which can't hold a window longer than a block. The counters of the past windows are pruned at
+// the end of the blocks.
+
+// RateLimitWindowStart returns the height the rate limit window of the current block starts at
+func (k Keeper) RateLimitWindowStart(ctx sdk.Context) uint64 {
+	height := uint64(ctx.BlockHeight())
+	return height - height%k.RateLimitWindowBlocks(ctx)
+}
+
+// GetSenderRateLimitCount returns the number of posts sent by an account in the current rate limit window
+func (k Keeper) GetSenderRateLimitCount(ctx sdk.Context, sender string) uint64 {
+	return k.getRateLimitCount(ctx, types.RateLimitSenderKey, k.RateLimitWindowStart(ctx), sender)
+}
+
+// GetChannelRateLimitCount returns the number of packets accepted on a channel in the current rate limit window
+func (k Keeper) GetChannelRateLimitCount(ctx sdk.Context, channelID string) uint64 {
+	return k.getRateLimitCount(ctx, types.RateLimitChannelKey, k.RateLimitWindowStart(ctx), channelID)
+}
+
+// countSentPost counts a post sent by an account, it fails if the account already sent the MaxSentPostsPerWindow
+// param posts in the window
+func (k Keeper) countSentPost(ctx sdk.Context, sender string) error {
+	err := k.consumeRateLimit(ctx, types.RateLimitSenderKey, sender, k.MaxSentPostsPerWindow(ctx))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "%s cannot send more posts until block %d", sender, k.RateLimitWindowStart(ctx)+k.RateLimitWindowBlocks(ctx))
+	}
+	return nil
+}
+
+// CountReceivedPacket counts a packet received on a channel, it fails if the MaxReceivedPacketsPerWindow param
+// packets were already accepted on the channel in the window. The state of a refused packet is discarded, so only
+// the accepted packets are counted.
+func (k Keeper) CountReceivedPacket(ctx sdk.Context, channelID string) error {
+	err := k.consumeRateLimit(ctx, types.RateLimitChannelKey, channelID, k.MaxReceivedPacketsPerWindow(ctx))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot receive more packets on channel %s until block %d", channelID, k.RateLimitWindowStart(ctx)+k.RateLimitWindowBlocks(ctx))
+	}
+	return nil
+}
+
+// PruneRateLimitCounters removes the counters of the rate limit windows before the current one
+func (k Keeper) PruneRateLimitCounters(ctx sdk.Context) {
+	windowStart := types.RateLimitWindowKey(k.RateLimitWindowStart(ctx))
+	for _, keyPrefix := range []string{types.RateLimitSenderKey, types.RateLimitChannelKey} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+		iterator := store.Iterator(nil, windowStart)
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// consumeRateLimit increments the counter of an account or a channel in the current window, it fails without
+// incrementing it once the counter reaches the limit. A limit of 0 disables the counting.
+func (k Keeper) consumeRateLimit(ctx sdk.Context, keyPrefix string, value string, limit uint64) error {
+	if limit == 0 {
+		return nil
+	}
+
+	windowStart := k.RateLimitWindowStart(ctx)
+	count := k.getRateLimitCount(ctx, keyPrefix, windowStart, value)
+	if count >= limit {
+		return sdkerrors.Wrapf(types.ErrRateLimited, "limit of %d per %d blocks reached", limit, k.RateLimitWindowBlocks(ctx))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	store.Set(types.RateLimitStoreKey(windowStart, value), sdk.Uint64ToBigEndian(count+1))
+	return nil
+}
+
+// getRateLimitCount returns the counter of an account or a channel in a window
+func (k Keeper) getRateLimitCount(ctx sdk.Context, keyPrefix string, windowStart uint64, value string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	bz := store.Get(types.RateLimitStoreKey(windowStart, value))
+
+	// Count doesn't exist: nothing counted in the window
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestCountReceivedPacket(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	params := types.DefaultParams()
+	params.RateLimitWindowBlocks = 10
+	params.MaxReceivedPacketsPerWindow = 2
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(21)
+
+	require.Equal(t, uint64(20), k.RateLimitWindowStart(ctx))
+	require.NoError(t, k.CountReceivedPacket(ctx, "channel-0"))
+	require.NoError(t, k.CountReceivedPacket(ctx, "channel-0"))
+	require.ErrorIs(t, k.CountReceivedPacket(ctx, "channel-0"), types.ErrRateLimited)
+	// The refused packets aren't counted
+	require.Equal(t, uint64(2), k.GetChannelRateLimitCount(ctx, "channel-0"))
+
+	// The limit is per channel and per window
+	require.NoError(t, k.CountReceivedPacket(ctx, "channel-1"))
+	ctx = ctx.WithBlockHeight(30)
+	require.Zero(t, k.GetChannelRateLimitCount(ctx, "channel-0"))
+	require.NoError(t, k.CountReceivedPacket(ctx, "channel-0"))
+
+	// A limit of 0 disables the counting
+	params.MaxReceivedPacketsPerWindow = 0
+	k.SetParams(ctx, params)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.CountReceivedPacket(ctx, "channel-1"))
+	}
+	require.Zero(t, k.GetChannelRateLimitCount(ctx, "channel-1"))
+}
+
+func TestPruneRateLimitCounters(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	params := types.DefaultParams()
+	params.RateLimitWindowBlocks = 10
+	params.MaxReceivedPacketsPerWindow = 5
+	k.SetParams(ctx, params)
+	for _, height := range []int64{5, 15, 25} {
+		require.NoError(t, k.CountReceivedPacket(ctx.WithBlockHeight(height), "channel-0"))
+	}
+
+	k.PruneRateLimitCounters(ctx.WithBlockHeight(19))
+	require.Zero(t, k.GetChannelRateLimitCount(ctx.WithBlockHeight(5), "channel-0"))
+	// The counters of the current window and of the windows after it are kept
+	require.Equal(t, uint64(1), k.GetChannelRateLimitCount(ctx.WithBlockHeight(15), "channel-0"))
+	require.Equal(t, uint64(1), k.GetChannelRateLimitCount(ctx.WithBlockHeight(25), "channel-0"))
+}
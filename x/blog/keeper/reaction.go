@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+// SetReaction set the reaction of an account to a post in the store, the reaction counts of the post are updated
+// for the reaction replaced
+func (k Keeper) SetReaction(ctx sdk.Context, reaction types.Reaction) {
+	if previous, found := k.GetReaction(ctx, reaction.PostKind, reaction.PostID, reaction.Reactor()); found {
+		k.setReactionCount(ctx, previous.PostKind, previous.PostID, previous.Reaction, k.GetReactionCount(ctx, previous.PostKind, previous.PostID, previous.Reaction)-1)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionKeyPrefix))
+	b := k.cdc.MustMarshal(&reaction)
+	store.Set(types.ReactionKey(reaction.PostKind, reaction.PostID, reaction.Reactor()), b)
+
+	k.setReactionCount(ctx, reaction.PostKind, reaction.PostID, reaction.Reaction, k.GetReactionCount(ctx, reaction.PostKind, reaction.PostID, reaction.Reaction)+1)
+}
+
+// GetReaction returns the reaction of an account to a post
+func (k Keeper) GetReaction(ctx sdk.Context, postKind string, postID uint64, reactor string) (val types.Reaction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionKeyPrefix))
+
+	b := store.Get(types.ReactionKey(postKind, postID, reactor))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllReaction returns all reaction
+func (k Keeper) GetAllReaction(ctx sdk.Context) (list []types.Reaction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Reaction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemovePostReactions removes the reactions to a post and their counts
+func (k Keeper) RemovePostReactions(ctx sdk.Context, postKind string, postID uint64) {
+	for _, keyPrefix := range []string{types.ReactionKeyPrefix, types.ReactionCountKey} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+		iterator := sdk.KVStorePrefixIterator(store, types.ReactionPostKey(postKind, postID))
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// react sets a new reaction of an account to a post, reacting again with the same reaction is rejected
+func (k Keeper) react(ctx sdk.Context, reaction types.Reaction) error {
+	previous, found := k.GetReaction(ctx, reaction.PostKind, reaction.PostID, reaction.Reactor())
+	if found && previous.Reaction == reaction.Reaction {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "already reacted to post %d with %s", reaction.PostID, reaction.Reaction)
+	}
+
+	k.SetReaction(ctx, reaction)
+	return nil
+}
+
+// GetReactionCount returns the number of reactions of a type to a post
+func (k Keeper) GetReactionCount(ctx sdk.Context, postKind string, postID uint64, reaction string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionCountKey))
+	bz := store.Get(types.ReactionCountStoreKey(postKind, postID, reaction))
+
+	// Count doesn't exist: no reaction
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetReactionCounts returns the numbers of reactions to a post by type, in the alphabetical order of the types
+func (k Keeper) GetReactionCounts(ctx sdk.Context, postKind string, postID uint64) []types.ReactionCount {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionCountKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ReactionPostKey(postKind, postID))
+
+	defer iterator.Close()
+
+	var counts []types.ReactionCount
+	prefixLength := len(types.ReactionPostKey(postKind, postID))
+	for ; iterator.Valid(); iterator.Next() {
+		counts = append(counts, types.ReactionCount{
+			Reaction: string(iterator.Key()[prefixLength:]),
+			Count:    sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return counts
+}
+
+// setReactionCount sets the number of reactions of a type to a post, the types without reactions aren't stored
+func (k Keeper) setReactionCount(ctx sdk.Context, postKind string, postID uint64, reaction string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReactionCountKey))
+	key := types.ReactionCountStoreKey(postKind, postID, reaction)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count))
+}
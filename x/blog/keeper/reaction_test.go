@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestReactionGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := []types.Reaction{
+		{PostKind: types.CommentPostKindPost, PostID: 0, Reaction: types.ReactionLike, Creator: "A"},
+		{PostKind: types.CommentPostKindPost, PostID: 0, Reaction: types.ReactionLike, Creator: "B"},
+		{PostKind: types.CommentPostKindPost, PostID: 1, Reaction: types.ReactionSad, Creator: "A"},
+		{PostKind: types.CommentPostKindSentPost, PostID: 0, Reaction: types.ReactionLove, RemoteAuthor: &types.RemoteAuthor{ChainID: "mars", Address: "A"}},
+	}
+	for _, item := range items {
+		keeper.SetReaction(ctx, item)
+	}
+	for _, item := range items {
+		got, found := keeper.GetReaction(ctx, item.PostKind, item.PostID, item.Reactor())
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllReaction(ctx)),
+	)
+
+	require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLike, Count: 2}}, keeper.GetReactionCounts(ctx, types.CommentPostKindPost, 0))
+	require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLove, Count: 1}}, keeper.GetReactionCounts(ctx, types.CommentPostKindSentPost, 0))
+	require.Empty(t, keeper.GetReactionCounts(ctx, types.CommentPostKindSentPost, 1))
+}
+
+func TestReactionReplace(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, Reaction: types.ReactionLike, Creator: "A"})
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, Reaction: types.ReactionLike, Creator: "B"})
+
+	// An account has one reaction per post, the count of the reaction it replaces is decremented
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, Reaction: types.ReactionWow, Creator: "A"})
+	require.Equal(t, []types.ReactionCount{
+		{Reaction: types.ReactionLike, Count: 1},
+		{Reaction: types.ReactionWow, Count: 1},
+	}, keeper.GetReactionCounts(ctx, types.CommentPostKindPost, 0))
+
+	// The types without reactions are dropped
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, Reaction: types.ReactionWow, Creator: "B"})
+	require.Equal(t, []types.ReactionCount{
+		{Reaction: types.ReactionWow, Count: 2},
+	}, keeper.GetReactionCounts(ctx, types.CommentPostKindPost, 0))
+	require.Len(t, keeper.GetAllReaction(ctx), 2)
+}
+
+func TestReactionRemovePostReactions(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, PostID: 0, Reaction: types.ReactionLike, Creator: "A"})
+	keeper.SetReaction(ctx, types.Reaction{PostKind: types.CommentPostKindPost, PostID: 0, Reaction: types.ReactionSad, Creator: "B"})
+	kept := []types.Reaction{
+		{PostKind: types.CommentPostKindPost, PostID: 1, Reaction: types.ReactionLike, Creator: "A"},
+		{PostKind: types.CommentPostKindSentPost, PostID: 0, Reaction: types.ReactionLike, Creator: "A"},
+	}
+	for _, item := range kept {
+		keeper.SetReaction(ctx, item)
+	}
+
+	keeper.RemovePostReactions(ctx, types.CommentPostKindPost, 0)
+	requirePostThreadRemoved(t, keeper, ctx, types.CommentPostKindPost, 0)
+	require.ElementsMatch(t,
+		nullify.Fill(kept),
+		nullify.Fill(keeper.GetAllReaction(ctx)),
+	)
+	require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}}, keeper.GetReactionCounts(ctx, types.CommentPostKindPost, 1))
+	require.Equal(t, []types.ReactionCount{{Reaction: types.ReactionLike, Count: 1}}, keeper.GetReactionCounts(ctx, types.CommentPostKindSentPost, 0))
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+// SetReport set the report of a post by an account in the store, the report count of the post is updated for a new
+// reporter
+func (k Keeper) SetReport(ctx sdk.Context, report types.Report) {
+	if _, found := k.GetReport(ctx, report.PostID, report.Reporter); !found {
+		k.setReportCount(ctx, report.PostID, k.GetReportCount(ctx, report.PostID)+1)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportKey))
+	b := k.cdc.MustMarshal(&report)
+	store.Set(types.ReportStoreKey(report.PostID, report.Reporter), b)
+}
+
+// GetReport returns the report of a post by an account
+func (k Keeper) GetReport(ctx sdk.Context, postID uint64, reporter string) (val types.Report, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportKey))
+
+	b := store.Get(types.ReportStoreKey(postID, reporter))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllReport returns all report
+func (k Keeper) GetAllReport(ctx sdk.Context) (list []types.Report) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Report
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPostReports returns the open reports of a post
+func (k Keeper) GetPostReports(ctx sdk.Context, postID uint64) (list []types.Report) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ReportPostKey(postID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Report
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemovePostReports closes the reports of a post, the accounts can report the post again
+func (k Keeper) RemovePostReports(ctx sdk.Context, postID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.ReportPostKey(postID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setReportCount(ctx, postID, 0)
+}
+
+// GetReportCount returns the number of open reports of a post
+func (k Keeper) GetReportCount(ctx sdk.Context, postID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportCountKey))
+	bz := store.Get(types.ReportPostKey(postID))
+
+	// Count doesn't exist: no report
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setReportCount sets the number of open reports of a post, the posts without reports aren't stored
+func (k Keeper) setReportCount(ctx sdk.Context, postID uint64, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReportCountKey))
+	key := types.ReportPostKey(postID)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count))
+}
+
+// reportPost records the report of a post by an account, reporting a post again is rejected until a moderator
+// reviews it. The post is hidden once its open reports reach the ReportHideThreshold param, it returns true then.
+func (k Keeper) reportPost(ctx sdk.Context, post types.Post, report types.Report) (bool, error) {
+	if _, found := k.GetReport(ctx, post.Id, report.Reporter); found {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post %d already reported by %s", post.Id, report.Reporter)
+	}
+	k.SetReport(ctx, report)
+
+	threshold := k.ReportHideThreshold(ctx)
+	count := k.GetReportCount(ctx, post.Id)
+	if threshold == 0 || count < threshold {
+		return false, nil
+	}
+
+	reason := fmt.Sprintf("reported by %d accounts", count)
+	post.Hidden = true
+	post.HiddenBy = ""
+	post.HiddenReason = reason
+	k.SetPost(ctx, post)
+	k.logModerationAction(ctx, "", types.ModerationActionAutoHidePost, strconv.FormatUint(post.Id, 10), reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHidePost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(post.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return true, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestReportGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := []types.Report{
+		{PostID: 0, Reporter: "A", Reason: types.ReportReasonSpam},
+		{PostID: 0, Reporter: "B", Reason: types.ReportReasonAbuse},
+		{PostID: 1, Reporter: "A", Reason: types.ReportReasonOther},
+	}
+	for _, item := range items {
+		keeper.SetReport(ctx, item)
+	}
+	for _, item := range items {
+		got, found := keeper.GetReport(ctx, item.PostID, item.Reporter)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllReport(ctx)),
+	)
+	require.ElementsMatch(t,
+		nullify.Fill(items[:2]),
+		nullify.Fill(keeper.GetPostReports(ctx, 0)),
+	)
+
+	require.Equal(t, uint64(2), keeper.GetReportCount(ctx, 0))
+	require.Equal(t, uint64(1), keeper.GetReportCount(ctx, 1))
+	require.Zero(t, keeper.GetReportCount(ctx, 2))
+
+	// Replacing a report doesn't count the reporter twice
+	keeper.SetReport(ctx, types.Report{PostID: 0, Reporter: "A", Reason: types.ReportReasonIllegal})
+	require.Equal(t, uint64(2), keeper.GetReportCount(ctx, 0))
+}
+
+func TestRemovePostReports(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	keeper.SetReport(ctx, types.Report{PostID: 0, Reporter: "A", Reason: types.ReportReasonSpam})
+	keeper.SetReport(ctx, types.Report{PostID: 0, Reporter: "B", Reason: types.ReportReasonSpam})
+	keeper.SetReport(ctx, types.Report{PostID: 1, Reporter: "A", Reason: types.ReportReasonSpam})
+
+	keeper.RemovePostReports(ctx, 0)
+	require.Empty(t, keeper.GetPostReports(ctx, 0))
+	require.Zero(t, keeper.GetReportCount(ctx, 0))
+	// The reports of the other posts are kept
+	require.Len(t, keeper.GetPostReports(ctx, 1), 1)
+	require.Equal(t, uint64(1), keeper.GetReportCount(ctx, 1))
+}
@@ -46,6 +46,7 @@ func (k Keeper) AppendSentPost(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SentPostKey))
 	appendedValue := k.cdc.MustMarshal(&sentPost)
 	store.Set(GetSentPostIDBytes(sentPost.Id), appendedValue)
+	k.setSentPostIndexes(ctx, sentPost)
 
 	// Update sentPost count
 	k.SetSentPostCount(ctx, count+1)
@@ -55,9 +56,14 @@ func (k Keeper) AppendSentPost(
 
 // SetSentPost set a specific sentPost in the store
 func (k Keeper) SetSentPost(ctx sdk.Context, sentPost types.SentPost) {
+	if previous, found := k.GetSentPost(ctx, sentPost.Id); found {
+		k.removeSentPostIndexes(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SentPostKey))
 	b := k.cdc.MustMarshal(&sentPost)
 	store.Set(GetSentPostIDBytes(sentPost.Id), b)
+	k.setSentPostIndexes(ctx, sentPost)
 }
 
 // GetSentPost returns a sentPost from its id
@@ -73,10 +79,43 @@ func (k Keeper) GetSentPost(ctx sdk.Context, id uint64) (val types.SentPost, fou
 
 // RemoveSentPost removes a sentPost from the store
 func (k Keeper) RemoveSentPost(ctx sdk.Context, id uint64) {
+	if previous, found := k.GetSentPost(ctx, id); found {
+		k.removeSentPostIndexes(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SentPostKey))
 	store.Delete(GetSentPostIDBytes(id))
 }
 
+// GetSentPostByRemotePost returns the sentPost acknowledged with the post ID by the destination chain
+func (k Keeper) GetSentPostByRemotePost(ctx sdk.Context, port, channelID string, postID uint64) (val types.SentPost, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SentPostByRemotePostKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.IndexKeyPrefix(types.RemotePostIndexValue(port, channelID, postID)))
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	return k.GetSentPost(ctx, sdk.BigEndianToUint64(iterator.Key()[len(iterator.Key())-8:]))
+}
+
+// setSentPostIndexes adds a sentPost to the secondary indexes
+func (k Keeper) setSentPostIndexes(ctx sdk.Context, sentPost types.SentPost) {
+	k.setIndex(ctx, types.SentPostByCreatorKey, sentPost.Creator, sentPost.Id)
+	if sentPost.DestinationChannel != "" {
+		k.setIndex(ctx, types.SentPostByRemotePostKey, types.RemotePostIndexValue(sentPost.DestinationPort, sentPost.DestinationChannel, sentPost.PostID), sentPost.Id)
+	}
+}
+
+// removeSentPostIndexes removes a sentPost from the secondary indexes
+func (k Keeper) removeSentPostIndexes(ctx sdk.Context, sentPost types.SentPost) {
+	k.removeIndex(ctx, types.SentPostByCreatorKey, sentPost.Creator, sentPost.Id)
+	if sentPost.DestinationChannel != "" {
+		k.removeIndex(ctx, types.SentPostByRemotePostKey, types.RemotePostIndexValue(sentPost.DestinationPort, sentPost.DestinationChannel, sentPost.PostID), sentPost.Id)
+	}
+}
+
 // GetAllSentPost returns all sentPost
 func (k Keeper) GetAllSentPost(ctx sdk.Context) (list []types.SentPost) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SentPostKey))
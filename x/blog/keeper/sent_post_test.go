@@ -57,3 +57,22 @@ func TestSentPostCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetSentPostCount(ctx))
 }
+
+func TestSentPostGetByRemotePost(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(keeper, ctx, 2)
+	items[1].PostID = 7
+	items[1].DestinationPort = "blog"
+	items[1].DestinationChannel = "channel-1"
+	keeper.SetSentPost(ctx, items[1])
+
+	got, found := keeper.GetSentPostByRemotePost(ctx, "blog", "channel-1", 7)
+	require.True(t, found)
+	require.Equal(t, items[1].Id, got.Id)
+	_, found = keeper.GetSentPostByRemotePost(ctx, "blog", "channel-2", 7)
+	require.False(t, found)
+
+	keeper.RemoveSentPost(ctx, items[1].Id)
+	_, found = keeper.GetSentPostByRemotePost(ctx, "blog", "channel-1", 7)
+	require.False(t, found)
+}
@@ -46,6 +46,7 @@ func (k Keeper) AppendTimedoutPost(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimedoutPostKey))
 	appendedValue := k.cdc.MustMarshal(&timedoutPost)
 	store.Set(GetTimedoutPostIDBytes(timedoutPost.Id), appendedValue)
+	k.setIndex(ctx, types.TimedoutPostByCreatorKey, timedoutPost.Creator, timedoutPost.Id)
 
 	// Update timedoutPost count
 	k.SetTimedoutPostCount(ctx, count+1)
@@ -55,9 +56,14 @@ func (k Keeper) AppendTimedoutPost(
 
 // SetTimedoutPost set a specific timedoutPost in the store
 func (k Keeper) SetTimedoutPost(ctx sdk.Context, timedoutPost types.TimedoutPost) {
+	if previous, found := k.GetTimedoutPost(ctx, timedoutPost.Id); found {
+		k.removeIndex(ctx, types.TimedoutPostByCreatorKey, previous.Creator, previous.Id)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimedoutPostKey))
 	b := k.cdc.MustMarshal(&timedoutPost)
 	store.Set(GetTimedoutPostIDBytes(timedoutPost.Id), b)
+	k.setIndex(ctx, types.TimedoutPostByCreatorKey, timedoutPost.Creator, timedoutPost.Id)
 }
 
 // GetTimedoutPost returns a timedoutPost from its id
@@ -73,6 +79,10 @@ func (k Keeper) GetTimedoutPost(ctx sdk.Context, id uint64) (val types.TimedoutP
 
 // RemoveTimedoutPost removes a timedoutPost from the store
 func (k Keeper) RemoveTimedoutPost(ctx sdk.Context, id uint64) {
+	if previous, found := k.GetTimedoutPost(ctx, id); found {
+		k.removeIndex(ctx, types.TimedoutPostByCreatorKey, previous.Creator, previous.Id)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimedoutPostKey))
 	store.Delete(GetTimedoutPostIDBytes(id))
 }
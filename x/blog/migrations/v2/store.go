@@ -0,0 +1,340 @@
+package v2
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migrations include:
+//
+// - Stamping the posts stored before v2 with the migration block time and height as creation time
+// - Stamping the pending, sent, timed out and failed posts with the migration block time and height
+// as respectively sent, acknowledged, timed out and failed time
+// - Converting the "port-channel-address" creator of the received posts into a RemoteAuthor, completed with the
+// receiving channel and the counterparty chain ID resolved from the open channels
+// - Converting the "port-channel" chain of the receipts into a destination port and channel, and the string post ID
+// of the sent posts into a uint64
+// - Building the secondary indexes of posts by creator, source channel and remote author, of sent and timed
+// out posts by creator, and of sent posts by remote post
+// - Building the search index of posts with the default limit of indexed tokens per post
+// - Building the index of posts by hashtag and the tag counts with the default tag limits
+//
+// Records created before v2 don't know when they happened, the migration block is the best upper bound
+// available and keeps them ordered before any record created after the upgrade.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, resolveChannel ChannelResolver) error {
+	store := ctx.KVStore(storeKey)
+	blockTime, blockHeight := ctx.BlockTime().Unix(), ctx.BlockHeight()
+
+	migrateRecords(store, types.PostKey, cdc, func() codec.ProtoMarshaler { return &types.Post{} }, func(r codec.ProtoMarshaler, _ []byte) bool {
+		post := r.(*types.Post)
+		migrated := migrateRemoteAuthor(ctx, post, resolveChannel)
+		if post.CreatedHeight != 0 {
+			return migrated
+		}
+		post.CreatedAt, post.CreatedHeight = blockTime, blockHeight
+		return true
+	})
+	migrateRecords(store, types.PendingPostKeyPrefix, cdc, func() codec.ProtoMarshaler { return &types.PendingPost{} }, func(r codec.ProtoMarshaler, _ []byte) bool {
+		pendingPost := r.(*types.PendingPost)
+		if pendingPost.SentHeight != 0 {
+			return false
+		}
+		pendingPost.SentAt, pendingPost.SentHeight = blockTime, blockHeight
+		return true
+	})
+	migrateRecords(store, types.SentPostKey, cdc, func() codec.ProtoMarshaler { return &types.SentPost{} }, func(r codec.ProtoMarshaler, bz []byte) bool {
+		sentPost := r.(*types.SentPost)
+		migrated := migrateDestination(&sentPost.ChainID, &sentPost.DestinationPort, &sentPost.DestinationChannel)
+		if postID, found := legacySentPostID(bz); found {
+			sentPost.PostID = postID
+			migrated = true
+		}
+		if sentPost.AckedHeight != 0 {
+			return migrated
+		}
+		sentPost.AckedAt, sentPost.AckedHeight = blockTime, blockHeight
+		return true
+	})
+	migrateRecords(store, types.TimedoutPostKey, cdc, func() codec.ProtoMarshaler { return &types.TimedoutPost{} }, func(r codec.ProtoMarshaler, _ []byte) bool {
+		timedoutPost := r.(*types.TimedoutPost)
+		migrated := migrateDestination(&timedoutPost.ChainID, &timedoutPost.DestinationPort, &timedoutPost.DestinationChannel)
+		if timedoutPost.TimedoutHeight != 0 {
+			return migrated
+		}
+		timedoutPost.TimedoutAt, timedoutPost.TimedoutHeight = blockTime, blockHeight
+		return true
+	})
+	migrateRecords(store, types.FailedPostKey, cdc, func() codec.ProtoMarshaler { return &types.FailedPost{} }, func(r codec.ProtoMarshaler, _ []byte) bool {
+		failedPost := r.(*types.FailedPost)
+		migrated := migrateDestination(&failedPost.ChainID, &failedPost.DestinationPort, &failedPost.DestinationChannel)
+		if failedPost.FailedHeight != 0 {
+			return migrated
+		}
+		failedPost.FailedAt, failedPost.FailedHeight = blockTime, blockHeight
+		return true
+	})
+
+	buildIndex(store, types.PostKey, types.PostByCreatorKey, cdc, func() codec.ProtoMarshaler { return &types.Post{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		post := r.(*types.Post)
+		return post.Creator, post.Id
+	})
+	buildIndex(store, types.PostKey, types.PostBySourceChannelKey, cdc, func() codec.ProtoMarshaler { return &types.Post{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		post := r.(*types.Post)
+		return post.RemoteAuthor.GetSourceChannel(), post.Id
+	})
+	buildIndex(store, types.PostKey, types.PostByRemoteAuthorKey, cdc, func() codec.ProtoMarshaler { return &types.Post{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		post := r.(*types.Post)
+		if !post.IsRemote() {
+			return "", post.Id
+		}
+		return types.RemoteAuthorIndexValue(post.RemoteAuthor.ChainID, post.RemoteAuthor.Address), post.Id
+	})
+	buildIndex(store, types.SentPostKey, types.SentPostByCreatorKey, cdc, func() codec.ProtoMarshaler { return &types.SentPost{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		sentPost := r.(*types.SentPost)
+		return sentPost.Creator, sentPost.Id
+	})
+	buildIndex(store, types.SentPostKey, types.SentPostByRemotePostKey, cdc, func() codec.ProtoMarshaler { return &types.SentPost{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		sentPost := r.(*types.SentPost)
+		if sentPost.DestinationChannel == "" {
+			return "", sentPost.Id
+		}
+		return types.RemotePostIndexValue(sentPost.DestinationPort, sentPost.DestinationChannel, sentPost.PostID), sentPost.Id
+	})
+	buildIndex(store, types.TimedoutPostKey, types.TimedoutPostByCreatorKey, cdc, func() codec.ProtoMarshaler { return &types.TimedoutPost{} }, func(r codec.ProtoMarshaler) (string, uint64) {
+		timedoutPost := r.(*types.TimedoutPost)
+		return timedoutPost.Creator, timedoutPost.Id
+	})
+	buildSearchIndex(store, cdc)
+	buildTagIndex(store, cdc)
+
+	return nil
+}
+
+// legacyRemoteCreator matches the creator of the posts received before v2, built as "port-channel-address".
+// Channel identifiers generated by IBC have the "channel-N" format which delimits the port and the address.
+var legacyRemoteCreator = regexp.MustCompile(`^(.+?)-(channel-[0-9]+)-(.+)$`)
+
+// ChannelResolver returns the port and channel on this chain of the channel whose counterparty is the sending end
+// of a received post, and the chain ID of the counterparty. It returns false if no channel or several channels match.
+type ChannelResolver func(ctx sdk.Context, sourcePort, sourceChannel string) (port, channelID, chainID string, found bool)
+
+// migrateRemoteAuthor converts the legacy creator of a received post into a RemoteAuthor. Only the sending end of
+// the channel was recorded, the receiving end and the counterparty chain ID are resolved from it. A post whose
+// channel can't be resolved keeps an empty destination, which marks its channel as unknown.
+func migrateRemoteAuthor(ctx sdk.Context, post *types.Post, resolveChannel ChannelResolver) bool {
+	if post.IsRemote() {
+		return false
+	}
+	matches := legacyRemoteCreator.FindStringSubmatch(post.Creator)
+	if matches == nil {
+		return false
+	}
+	post.RemoteAuthor = &types.RemoteAuthor{
+		SourcePort:    matches[1],
+		SourceChannel: matches[2],
+		Address:       matches[3],
+	}
+	post.Creator = ""
+
+	port, channelID, chainID, found := resolveChannel(ctx, post.RemoteAuthor.SourcePort, post.RemoteAuthor.SourceChannel)
+	if !found {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Info(
+			"cannot resolve the channel of a received post",
+			"id", post.Id,
+			"sourcePort", post.RemoteAuthor.SourcePort,
+			"sourceChannel", post.RemoteAuthor.SourceChannel,
+		)
+		return true
+	}
+	post.RemoteAuthor.DestinationPort, post.RemoteAuthor.DestinationChannel, post.RemoteAuthor.ChainID = port, channelID, chainID
+	return true
+}
+
+// legacyChain matches the chain of the receipts stored before v2, built as "port-channel" from the destination
+// port and channel
+var legacyChain = regexp.MustCompile(`^(.+)-(channel-[0-9]+)$`)
+
+// migrateDestination moves the destination port and channel of a receipt stored before v2 from the chain field,
+// now holding the chain ID, to their own fields. The chain ID of these receipts is unknown.
+func migrateDestination(chainID, destinationPort, destinationChannel *string) bool {
+	if *destinationChannel != "" {
+		return false
+	}
+	matches := legacyChain.FindStringSubmatch(*chainID)
+	if matches == nil {
+		return false
+	}
+	*destinationPort, *destinationChannel, *chainID = matches[1], matches[2], ""
+	return true
+}
+
+// legacySentPostID returns the post ID of a sentPost stored before v2, when it was encoded as a string in the
+// now reserved field 2
+func legacySentPostID(bz []byte) (uint64, bool) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return 0, false
+		}
+		bz = bz[n:]
+		if num == 2 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return 0, false
+			}
+			postID, err := strconv.ParseUint(string(v), 10, 64)
+			return postID, err == nil
+		}
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return 0, false
+		}
+		bz = bz[n:]
+	}
+	return 0, false
+}
+
+// buildIndex adds every record stored under the key prefix to the secondary index, records with an empty
+// indexed value are not indexed
+func buildIndex(
+	store sdk.KVStore,
+	keyPrefix string,
+	indexKey string,
+	cdc codec.BinaryCodec,
+	newRecord func() codec.ProtoMarshaler,
+	indexedValue func(codec.ProtoMarshaler) (string, uint64),
+) {
+	recordStore := prefix.NewStore(store, types.KeyPrefix(keyPrefix))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(indexKey))
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(recordStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		record := newRecord()
+		cdc.MustUnmarshal(iterator.Value(), record)
+		if value, id := indexedValue(record); value != "" {
+			keys = append(keys, types.IndexKey(value, id))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		indexStore.Set(key, []byte{})
+	}
+}
+
+// buildSearchIndex adds every post to the search index and records the tokens it is indexed with
+func buildSearchIndex(store sdk.KVStore, cdc codec.BinaryCodec) {
+	postStore := prefix.NewStore(store, types.KeyPrefix(types.PostKey))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.PostBySearchTokenKey))
+	tokensStore := prefix.NewStore(store, types.KeyPrefix(types.PostSearchTokensKey))
+
+	var (
+		keys   [][]byte
+		tokens [][]string
+	)
+	iterator := sdk.KVStorePrefixIterator(postStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		cdc.MustUnmarshal(iterator.Value(), &post)
+		if postTokens := types.SearchTokens(types.DefaultMaxIndexedTokens, post.Title, post.Content); len(postTokens) > 0 {
+			keys = append(keys, iterator.Key())
+			tokens = append(tokens, postTokens)
+		}
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		id := sdk.BigEndianToUint64(key)
+		for _, token := range tokens[i] {
+			indexStore.Set(types.IndexKey(token, id), []byte{})
+		}
+		tokensStore.Set(key, types.SearchTokensValue(tokens[i]))
+	}
+}
+
+// buildTagIndex adds every post to the index of its tags, records the tags it is indexed with and counts the
+// posts of every tag
+func buildTagIndex(store sdk.KVStore, cdc codec.BinaryCodec) {
+	postStore := prefix.NewStore(store, types.KeyPrefix(types.PostKey))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.PostByTagKey))
+	tagsStore := prefix.NewStore(store, types.KeyPrefix(types.PostTagsKey))
+	countStore := prefix.NewStore(store, types.KeyPrefix(types.TagCountKey))
+	byCountStore := prefix.NewStore(store, types.KeyPrefix(types.TagByCountKeyPrefix))
+
+	var (
+		keys   [][]byte
+		tags   [][]string
+		counts = make(map[string]uint64)
+	)
+	iterator := sdk.KVStorePrefixIterator(postStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		cdc.MustUnmarshal(iterator.Value(), &post)
+		if postTags := types.PostTags(types.DefaultMaxTags, types.DefaultMaxTagLength, post.Tags, post.Content); len(postTags) > 0 {
+			keys = append(keys, iterator.Key())
+			tags = append(tags, postTags)
+			for _, tag := range postTags {
+				counts[tag]++
+			}
+		}
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		id := sdk.BigEndianToUint64(key)
+		for _, tag := range tags[i] {
+			indexStore.Set(types.IndexKey(tag, id), []byte{})
+		}
+		tagsStore.Set(key, types.TagsValue(tags[i]))
+	}
+	// The counts are written in the order of the tags to keep the writes deterministic
+	for _, postTags := range tags {
+		for _, tag := range postTags {
+			if count, found := counts[tag]; found {
+				countStore.Set([]byte(tag), sdk.Uint64ToBigEndian(count))
+				byCountStore.Set(types.TagByCountKey(tag, count), []byte{})
+				delete(counts, tag)
+			}
+		}
+	}
+}
+
+// migrateRecords decodes every record stored under the key prefix and writes back the ones updated by migrate,
+// the encoded record is also given to migrate to read the fields removed in v2
+func migrateRecords(
+	store sdk.KVStore,
+	keyPrefix string,
+	cdc codec.BinaryCodec,
+	newRecord func() codec.ProtoMarshaler,
+	migrate func(record codec.ProtoMarshaler, bz []byte) bool,
+) {
+	recordStore := prefix.NewStore(store, types.KeyPrefix(keyPrefix))
+
+	// Collect the updates first, the store must not be written while iterating
+	var (
+		keys    [][]byte
+		records []codec.ProtoMarshaler
+	)
+	iterator := sdk.KVStorePrefixIterator(recordStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		record := newRecord()
+		cdc.MustUnmarshal(iterator.Value(), record)
+		if migrate(record, iterator.Value()) {
+			keys = append(keys, iterator.Key())
+			records = append(records, record)
+		}
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		recordStore.Set(key, cdc.MustMarshal(records[i]))
+	}
+}
@@ -0,0 +1,258 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"planet/x/blog/keeper"
+	v2 "planet/x/blog/migrations/v2"
+	"planet/x/blog/types"
+)
+
+// resolveChannel resolves the receiving channel of the sending channels given as counterparty with their chain ID
+func resolveChannel(port, channelID, chainID string, counterparty ...string) v2.ChannelResolver {
+	return func(_ sdk.Context, sourcePort, sourceChannel string) (string, string, string, bool) {
+		for _, channel := range counterparty {
+			if sourcePort == "blog" && sourceChannel == channel {
+				return port, channelID, chainID, true
+			}
+		}
+		return "", "", "", false
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	set := func(keyPrefix string, key []byte, record codec.ProtoMarshaler) {
+		prefix.NewStore(store, types.KeyPrefix(keyPrefix)).Set(key, cdc.MustMarshal(record))
+	}
+	get := func(keyPrefix string, key []byte, record codec.ProtoMarshaler) {
+		bz := prefix.NewStore(store, types.KeyPrefix(keyPrefix)).Get(key)
+		require.NotNil(t, bz)
+		cdc.MustUnmarshal(bz, record)
+	}
+
+	// Records written before v2 have no timestamps
+	set(types.PostKey, keeper.GetPostIDBytes(0), &types.Post{Id: 0, Title: "legacy", Creator: "A"})
+	set(types.SentPostKey, keeper.GetSentPostIDBytes(0), &types.SentPost{Id: 0, Title: "legacy", Creator: "A"})
+	set(types.TimedoutPostKey, keeper.GetTimedoutPostIDBytes(0), &types.TimedoutPost{Id: 0, Title: "legacy", Creator: "A"})
+	set(types.FailedPostKey, keeper.GetFailedPostIDBytes(0), &types.FailedPost{Id: 0, Title: "legacy", Creator: "A"})
+	set(types.PendingPostKeyPrefix, types.PendingPostKey("blog", "channel-0", 1), &types.PendingPost{Port: "blog", ChannelID: "channel-0", Sequence: 1})
+	// Records already stamped must be left untouched
+	set(types.PostKey, keeper.GetPostIDBytes(1), &types.Post{Id: 1, Title: "recent", Creator: "A", CreatedAt: 10, CreatedHeight: 1})
+	set(types.PostKey, keeper.GetPostIDBytes(2), &types.Post{Id: 2, Title: "hashtags", Content: "#Mars #venus", Creator: "A", CreatedAt: 10, CreatedHeight: 1})
+	// Received posts, the channel of the second one isn't open on this chain
+	set(types.PostKey, keeper.GetPostIDBytes(3), &types.Post{Id: 3, Title: "received", Creator: "blog-channel-1-B", CreatedAt: 10, CreatedHeight: 1})
+	set(types.PostKey, keeper.GetPostIDBytes(4), &types.Post{Id: 4, Title: "received", Creator: "blog-channel-9-B", CreatedAt: 10, CreatedHeight: 1})
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(5000, 0))
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, resolveChannel("blog", "channel-0", "mars", "channel-1")))
+
+	var post types.Post
+	get(types.PostKey, keeper.GetPostIDBytes(0), &post)
+	require.Equal(t, types.Post{Id: 0, Title: "legacy", Creator: "A", CreatedAt: 5000, CreatedHeight: 100}, post)
+
+	get(types.PostKey, keeper.GetPostIDBytes(1), &post)
+	require.Equal(t, types.Post{Id: 1, Title: "recent", Creator: "A", CreatedAt: 10, CreatedHeight: 1}, post)
+
+	// The receiving channel and the chain ID of the received posts are resolved from the sending channel
+	post = types.Post{}
+	get(types.PostKey, keeper.GetPostIDBytes(3), &post)
+	require.Equal(t, &types.RemoteAuthor{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-1",
+		ChainID:            "mars",
+		Address:            "B",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-0",
+	}, post.RemoteAuthor)
+	require.True(t, post.RemoteAuthor.IsChannelKnown())
+	post = types.Post{}
+	get(types.PostKey, keeper.GetPostIDBytes(4), &post)
+	require.Equal(t, &types.RemoteAuthor{SourcePort: "blog", SourceChannel: "channel-9", Address: "B"}, post.RemoteAuthor)
+	require.False(t, post.RemoteAuthor.IsChannelKnown())
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostByRemoteAuthorKey)).Has(types.IndexKey(types.RemoteAuthorIndexValue("mars", "B"), 3)))
+
+	var sentPost types.SentPost
+	get(types.SentPostKey, keeper.GetSentPostIDBytes(0), &sentPost)
+	require.Equal(t, int64(5000), sentPost.AckedAt)
+	require.Equal(t, int64(100), sentPost.AckedHeight)
+
+	var timedoutPost types.TimedoutPost
+	get(types.TimedoutPostKey, keeper.GetTimedoutPostIDBytes(0), &timedoutPost)
+	require.Equal(t, int64(5000), timedoutPost.TimedoutAt)
+	require.Equal(t, int64(100), timedoutPost.TimedoutHeight)
+
+	var failedPost types.FailedPost
+	get(types.FailedPostKey, keeper.GetFailedPostIDBytes(0), &failedPost)
+	require.Equal(t, int64(5000), failedPost.FailedAt)
+	require.Equal(t, int64(100), failedPost.FailedHeight)
+
+	var pendingPost types.PendingPost
+	get(types.PendingPostKeyPrefix, types.PendingPostKey("blog", "channel-0", 1), &pendingPost)
+	require.Equal(t, int64(5000), pendingPost.SentAt)
+	require.Equal(t, int64(100), pendingPost.SentHeight)
+
+	// The hashtags of the content are indexed and counted
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostByTagKey)).Has(types.IndexKey("mars", 2)))
+	require.Equal(t, []string{"mars", "venus"}, types.ParseTagsValue(prefix.NewStore(store, types.KeyPrefix(types.PostTagsKey)).Get(keeper.GetPostIDBytes(2))))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), prefix.NewStore(store, types.KeyPrefix(types.TagCountKey)).Get([]byte("venus")))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.TagByCountKeyPrefix)).Has(types.TagByCountKey("venus", 1)))
+}
+
+// v1Receipts holds the receipts of the v1 fixture, whose layout can't be decoded into the v2 types
+type v1Receipts struct {
+	SentPostList []struct {
+		Id      uint64 `json:"id,string"`
+		PostID  string `json:"postID"`
+		Title   string `json:"title"`
+		Chain   string `json:"chain"`
+		Creator string `json:"creator"`
+	} `json:"sentPostList"`
+	TimedoutPostList []struct {
+		Id      uint64 `json:"id,string"`
+		Title   string `json:"title"`
+		Chain   string `json:"chain"`
+		Creator string `json:"creator"`
+	} `json:"timedoutPostList"`
+}
+
+// appendString appends a v1 string field to a record encoded by hand
+func appendString(bz []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendString(bz, value)
+}
+
+func TestMigrateV1Fixture(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// Load a store written by a v1 node
+	bz, err := os.ReadFile("testdata/v1_genesis.json")
+	require.NoError(t, err)
+	var receipts v1Receipts
+	require.NoError(t, json.Unmarshal(bz, &receipts))
+	var fixture map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+	delete(fixture, "sentPostList")
+	delete(fixture, "timedoutPostList")
+	bz, err = json.Marshal(fixture)
+	require.NoError(t, err)
+	var v1 types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &v1))
+	postStore := prefix.NewStore(store, types.KeyPrefix(types.PostKey))
+	for _, post := range v1.PostList {
+		postStore.Set(keeper.GetPostIDBytes(post.Id), cdc.MustMarshal(&post))
+	}
+	sentPostStore := prefix.NewStore(store, types.KeyPrefix(types.SentPostKey))
+	for _, sentPost := range receipts.SentPostList {
+		record := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), sentPost.Id)
+		record = appendString(record, 2, sentPost.PostID)
+		record = appendString(record, 3, sentPost.Title)
+		record = appendString(record, 4, sentPost.Chain)
+		record = appendString(record, 5, sentPost.Creator)
+		sentPostStore.Set(keeper.GetSentPostIDBytes(sentPost.Id), record)
+	}
+	timedoutPostStore := prefix.NewStore(store, types.KeyPrefix(types.TimedoutPostKey))
+	for _, timedoutPost := range receipts.TimedoutPostList {
+		record := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), timedoutPost.Id)
+		record = appendString(record, 2, timedoutPost.Title)
+		record = appendString(record, 3, timedoutPost.Chain)
+		record = appendString(record, 4, timedoutPost.Creator)
+		timedoutPostStore.Set(keeper.GetTimedoutPostIDBytes(timedoutPost.Id), record)
+	}
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(5000, 0))
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, resolveChannel("blog", "channel-4", "venus", "channel-0")))
+
+	var post types.Post
+	cdc.MustUnmarshal(postStore.Get(keeper.GetPostIDBytes(0)), &post)
+	expectedPost := v1.PostList[0]
+	expectedPost.CreatedAt, expectedPost.CreatedHeight = 5000, 100
+	require.Equal(t, expectedPost, post)
+
+	// The creator of the received post is converted into a remote author
+	post = types.Post{}
+	cdc.MustUnmarshal(postStore.Get(keeper.GetPostIDBytes(1)), &post)
+	expectedPost = v1.PostList[1]
+	expectedPost.CreatedAt, expectedPost.CreatedHeight = 5000, 100
+	expectedPost.Creator = ""
+	expectedPost.RemoteAuthor = &types.RemoteAuthor{
+		SourcePort:         "blog",
+		SourceChannel:      "channel-0",
+		ChainID:            "venus",
+		Address:            "cosmos1uxhjnj3rzp9erkw4q6dtkpm7jq0jxjccw2ml0z",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-4",
+	}
+	require.Equal(t, expectedPost, post)
+
+	// The chain of the receipts is converted into a destination and the post ID is typed
+	var sentPost types.SentPost
+	cdc.MustUnmarshal(sentPostStore.Get(keeper.GetSentPostIDBytes(0)), &sentPost)
+	require.Equal(t, types.SentPost{
+		Id:                 0,
+		PostID:             3,
+		Title:              "Hello Venus",
+		Creator:            "cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+		AckedAt:            5000,
+		AckedHeight:        100,
+	}, sentPost)
+	var timedoutPost types.TimedoutPost
+	cdc.MustUnmarshal(timedoutPostStore.Get(keeper.GetTimedoutPostIDBytes(0)), &timedoutPost)
+	require.Equal(t, types.TimedoutPost{
+		Id:                 0,
+		Title:              "Hello Jupiter",
+		Creator:            "cosmos1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k",
+		DestinationPort:    "blog",
+		DestinationChannel: "channel-1",
+		TimedoutAt:         5000,
+		TimedoutHeight:     100,
+	}, timedoutPost)
+
+	// The secondary indexes are built
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostByCreatorKey)).Has(types.IndexKey(v1.PostList[0].Creator, 0)))
+	require.False(t, prefix.NewStore(store, types.KeyPrefix(types.PostByCreatorKey)).Has(types.IndexKey(v1.PostList[1].Creator, 1)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostBySourceChannelKey)).Has(types.IndexKey("channel-0", 1)))
+	remoteAuthor := types.RemoteAuthorIndexValue("venus", "cosmos1uxhjnj3rzp9erkw4q6dtkpm7jq0jxjccw2ml0z")
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostByRemoteAuthorKey)).Has(types.IndexKey(remoteAuthor, 1)))
+	for _, sentPost := range receipts.SentPostList {
+		require.True(t, prefix.NewStore(store, types.KeyPrefix(types.SentPostByCreatorKey)).Has(types.IndexKey(sentPost.Creator, sentPost.Id)))
+	}
+	remotePost := types.RemotePostIndexValue("blog", "channel-1", 3)
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.SentPostByRemotePostKey)).Has(types.IndexKey(remotePost, 0)))
+	for _, timedoutPost := range receipts.TimedoutPostList {
+		require.True(t, prefix.NewStore(store, types.KeyPrefix(types.TimedoutPostByCreatorKey)).Has(types.IndexKey(timedoutPost.Creator, timedoutPost.Id)))
+	}
+
+	// The search index is built
+	searchStore := prefix.NewStore(store, types.KeyPrefix(types.PostBySearchTokenKey))
+	require.True(t, searchStore.Has(types.IndexKey("hello", 0)))
+	require.True(t, searchStore.Has(types.IndexKey("hello", 1)))
+	require.True(t, searchStore.Has(types.IndexKey("earth", 0)))
+	require.True(t, searchStore.Has(types.IndexKey("earth", 1)))
+	require.False(t, searchStore.Has(types.IndexKey("mars", 2)))
+	tokensStore := prefix.NewStore(store, types.KeyPrefix(types.PostSearchTokensKey))
+	require.Equal(t, []string{"hello", "mars", "post", "written", "on", "earth"}, types.ParseSearchTokensValue(tokensStore.Get(keeper.GetPostIDBytes(0))))
+}
@@ -10,6 +10,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/gogo/protobuf/proto"
 	"planet/x/blog/keeper"
 	"planet/x/blog/types"
 )
@@ -138,8 +139,6 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var ack channeltypes.Acknowledgement
-
 	// this line is used by starport scaffolding # oracle/packet/module/recv
 
 	var modulePacketData types.BlogPacketData
@@ -147,34 +146,76 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
 	}
 
+	// The packets accepted on a channel are rate limited, whatever their type
+	if err := im.keeper.CountReceivedPacket(ctx, modulePacket.DestinationChannel); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// Only the posts can be queued for moderation, the other packets received on a moderated channel are refused
+	// so that they can't bypass it
+	moderated := im.keeper.GetParams(ctx).IsChannelModerated(modulePacket.DestinationChannel)
+	if _, isPost := modulePacketData.Packet.(*types.BlogPacketData_IbcPostPacket); moderated && !isPost {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrChannelModerated, "only posts can be received on channel %s", modulePacket.DestinationChannel))
+	}
+
 	// Dispatch packet
 	switch packet := modulePacketData.Packet.(type) {
 	case *types.BlogPacketData_IbcPostPacket:
-		packetAck, err := im.keeper.OnRecvIbcPostPacket(ctx, modulePacket, *packet.IbcPostPacket)
-		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
-		} else {
-			// Encode packet acknowledgment
-			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
-			if err != nil {
-				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+		// The posts received on a moderated channel are queued, the acknowledgement is written asynchronously once
+		// a moderator approves or rejects the post, or once it expires
+		if moderated {
+			if _, err := im.keeper.QueueInboundPost(ctx, modulePacket, *packet.IbcPostPacket); err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
 			}
-			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+			return nil
 		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeIbcPostPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
+
+		packetAck, err := im.keeper.OnRecvIbcPostPacket(ctx, modulePacket, *packet.IbcPostPacket)
+		return recvAcknowledgement(ctx, types.EventTypeIbcPostPacket, &packetAck, err)
+	case *types.BlogPacketData_IbcCommentPacket:
+		packetAck, err := im.keeper.OnRecvIbcCommentPacket(ctx, modulePacket, *packet.IbcCommentPacket)
+		return recvAcknowledgement(ctx, types.EventTypeIbcCommentPacket, &packetAck, err)
+	case *types.BlogPacketData_IbcEditPostPacket:
+		packetAck, err := im.keeper.OnRecvIbcEditPostPacket(ctx, modulePacket, *packet.IbcEditPostPacket)
+		return recvAcknowledgement(ctx, types.EventTypeIbcEditPostPacket, &packetAck, err)
+	case *types.BlogPacketData_IbcDeletePostPacket:
+		packetAck, err := im.keeper.OnRecvIbcDeletePostPacket(ctx, modulePacket, *packet.IbcDeletePostPacket)
+		return recvAcknowledgement(ctx, types.EventTypeIbcDeletePostPacket, &packetAck, err)
+	case *types.BlogPacketData_IbcReactionPacket:
+		packetAck, err := im.keeper.OnRecvIbcReactionPacket(ctx, modulePacket, *packet.IbcReactionPacket)
+		return recvAcknowledgement(ctx, types.EventTypeIbcReactionPacket, &packetAck, err)
 		// this line is used by starport scaffolding # ibc/packet/module/recv
 	default:
 		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
+}
+
+// recvAcknowledgement encodes the acknowledgement of a received packet from the packet ack or the error returned by
+// its handler, and emits the event reporting its success. The acknowledgement is written synchronously during IBC
+// handler execution, except for the posts queued for moderation.
+func recvAcknowledgement(ctx sdk.Context, eventType string, packetAck proto.Message, err error) channeltypes.Acknowledgement {
+	var ack channeltypes.Acknowledgement
+	if err == nil {
+		// Encode packet acknowledgment
+		var packetAckBytes []byte
+		if packetAckBytes, err = types.ModuleCdc.MarshalJSON(packetAck); err != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		} else {
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+	}
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(err)
+	}
 
-	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+		),
+	)
 	return ack
 }
 
@@ -207,6 +248,30 @@ func (im IBCModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeIbcPostPacket
+	case *types.BlogPacketData_IbcCommentPacket:
+		err := im.keeper.OnAcknowledgementIbcCommentPacket(ctx, modulePacket, *packet.IbcCommentPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeIbcCommentPacket
+	case *types.BlogPacketData_IbcEditPostPacket:
+		err := im.keeper.OnAcknowledgementIbcEditPostPacket(ctx, modulePacket, *packet.IbcEditPostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeIbcEditPostPacket
+	case *types.BlogPacketData_IbcDeletePostPacket:
+		err := im.keeper.OnAcknowledgementIbcDeletePostPacket(ctx, modulePacket, *packet.IbcDeletePostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeIbcDeletePostPacket
+	case *types.BlogPacketData_IbcReactionPacket:
+		err := im.keeper.OnAcknowledgementIbcReactionPacket(ctx, modulePacket, *packet.IbcReactionPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeIbcReactionPacket
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -259,6 +324,26 @@ func (im IBCModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+	case *types.BlogPacketData_IbcCommentPacket:
+		err := im.keeper.OnTimeoutIbcCommentPacket(ctx, modulePacket, *packet.IbcCommentPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_IbcEditPostPacket:
+		err := im.keeper.OnTimeoutIbcEditPostPacket(ctx, modulePacket, *packet.IbcEditPostPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_IbcDeletePostPacket:
+		err := im.keeper.OnTimeoutIbcDeletePostPacket(ctx, modulePacket, *packet.IbcDeletePostPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_IbcReactionPacket:
+		err := im.keeper.OnTimeoutIbcReactionPacket(ctx, modulePacket, *packet.IbcReactionPacket)
+		if err != nil {
+			return err
+		}
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -0,0 +1,230 @@
+package blog_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+// packetData is implemented by the data of every packet type of the module
+type packetData interface {
+	GetBytes() ([]byte, error)
+}
+
+// receivedPacket returns a packet received from the counterparty of the stub channel
+func receivedPacket(t *testing.T, data packetData) channeltypes.Packet {
+	bz, err := data.GetBytes()
+	require.NoError(t, err)
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.ChannelID,
+		Data:               bz,
+	}
+}
+
+// setupReceivedPackets stores a post sent to the counterparty, and a post received from it, that the received
+// packets refer to
+func setupReceivedPackets(k *keeper.Keeper, ctx sdk.Context) {
+	k.AppendSentPost(ctx, types.SentPost{
+		PostID:             7,
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.CounterpartyChannelID,
+	})
+	k.AppendPost(ctx, types.Post{Title: "title", RemoteAuthor: &types.RemoteAuthor{
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.CounterpartyChannelID,
+		Address:            "A",
+		DestinationPort:    types.PortID,
+		DestinationChannel: keepertest.ChannelID,
+	}})
+}
+
+// ackSuccess returns the success attribute of the event emitted for a received packet
+func ackSuccess(t *testing.T, ctx sdk.Context, eventType string) string {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyAckSuccess {
+				return string(attribute.Value)
+			}
+		}
+	}
+	require.Failf(t, "event not emitted", "no %s event", eventType)
+	return ""
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		data      packetData
+		eventType string
+		err       error
+	}{
+		{
+			desc:      "Post",
+			data:      types.IbcPostPacketData{Title: "title", Content: "content", Creator: "A"},
+			eventType: types.EventTypeIbcPostPacket,
+		},
+		{
+			desc:      "PostTooLong",
+			data:      types.IbcPostPacketData{Title: "title", Content: string(make([]byte, types.DefaultMaxContentLength+1)), Creator: "A"},
+			eventType: types.EventTypeIbcPostPacket,
+			err:       types.ErrPostTooLong,
+		},
+		{
+			desc:      "Comment",
+			data:      types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"},
+			eventType: types.EventTypeIbcCommentPacket,
+		},
+		{
+			desc:      "CommentPostNotFound",
+			data:      types.IbcCommentPacketData{PostID: 8, Content: "content", Creator: "A"},
+			eventType: types.EventTypeIbcCommentPacket,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:      "EditPost",
+			data:      types.IbcEditPostPacketData{PostID: 0, Title: "edited", Creator: "A"},
+			eventType: types.EventTypeIbcEditPostPacket,
+		},
+		{
+			desc:      "EditPostOtherCreator",
+			data:      types.IbcEditPostPacketData{PostID: 0, Title: "edited", Creator: "B"},
+			eventType: types.EventTypeIbcEditPostPacket,
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:      "DeletePost",
+			data:      types.IbcDeletePostPacketData{PostID: 0, Creator: "A"},
+			eventType: types.EventTypeIbcDeletePostPacket,
+		},
+		{
+			desc:      "DeletePostNotFound",
+			data:      types.IbcDeletePostPacketData{PostID: 1, Creator: "A"},
+			eventType: types.EventTypeIbcDeletePostPacket,
+			err:       sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:      "Reaction",
+			data:      types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"},
+			eventType: types.EventTypeIbcReactionPacket,
+		},
+		{
+			desc:      "UnknownReaction",
+			data:      types.IbcReactionPacketData{PostID: 7, Reaction: "dislike", Creator: "A"},
+			eventType: types.EventTypeIbcReactionPacket,
+			err:       types.ErrInvalidReaction,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			setupReceivedPackets(k, ctx)
+			module := blog.NewIBCModule(*k)
+
+			ack := module.OnRecvPacket(ctx, receivedPacket(t, tc.data), nil)
+			require.NotNil(t, ack)
+			if tc.err != nil {
+				require.Equal(t, channeltypes.NewErrorAcknowledgement(tc.err), ack)
+				require.Equal(t, "false", ackSuccess(t, ctx, tc.eventType))
+				return
+			}
+			require.True(t, ack.Success())
+			require.Equal(t, "true", ackSuccess(t, ctx, tc.eventType))
+		})
+	}
+}
+
+func TestOnRecvPacketInvalidData(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	module := blog.NewIBCModule(*k)
+
+	packet := receivedPacket(t, types.IbcPostPacketData{Title: "title", Creator: "A"})
+	packet.Data = []byte("invalid")
+	ack := module.OnRecvPacket(ctx, packet, nil)
+	require.Equal(t, channeltypes.NewErrorAcknowledgement(sdkerrors.ErrUnknownRequest), ack)
+
+	// A packet of an unknown type is refused
+	packet.Data, _ = (&types.BlogPacketData{}).Marshal()
+	ack = module.OnRecvPacket(ctx, packet, nil)
+	require.False(t, ack.Success())
+}
+
+func TestOnRecvPacketModeratedChannel(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		data packetData
+	}{
+		{desc: "Comment", data: types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"}},
+		{desc: "EditPost", data: types.IbcEditPostPacketData{PostID: 0, Title: "edited", Creator: "A"}},
+		{desc: "DeletePost", data: types.IbcDeletePostPacketData{PostID: 0, Creator: "A"}},
+		{desc: "Reaction", data: types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			params := types.DefaultParams()
+			params.ModeratedChannels = []string{keepertest.ChannelID}
+			k.SetParams(ctx, params)
+			setupReceivedPackets(k, ctx)
+			module := blog.NewIBCModule(*k)
+
+			ack := module.OnRecvPacket(ctx, receivedPacket(t, tc.data), nil)
+			require.Equal(t, channeltypes.NewErrorAcknowledgement(types.ErrChannelModerated), ack)
+			require.Zero(t, k.GetCommentCount(ctx))
+			require.Empty(t, k.GetReactionCounts(ctx, types.CommentPostKindSentPost, 0))
+			post, found := k.GetPost(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, "title", post.Title)
+		})
+	}
+
+	t.Run("Post", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		params := types.DefaultParams()
+		params.ModeratedChannels = []string{keepertest.ChannelID}
+		k.SetParams(ctx, params)
+		module := blog.NewIBCModule(*k)
+
+		// The post is queued, its acknowledgement is written once it is moderated
+		ack := module.OnRecvPacket(ctx, receivedPacket(t, types.IbcPostPacketData{Title: "title", Creator: "A"}), nil)
+		require.Nil(t, ack)
+		require.Equal(t, uint64(1), k.GetInboundPostCount(ctx))
+		require.Zero(t, k.GetPostCount(ctx))
+	})
+}
+
+func TestOnRecvPacketRateLimited(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	params := types.DefaultParams()
+	params.MaxReceivedPacketsPerWindow = 2
+	k.SetParams(ctx, params)
+	setupReceivedPackets(k, ctx)
+	module := blog.NewIBCModule(*k)
+
+	// The packets of every type count against the limit of the channel
+	ack := module.OnRecvPacket(ctx, receivedPacket(t, types.IbcPostPacketData{Title: "title", Creator: "A"}), nil)
+	require.True(t, ack.Success())
+	ack = module.OnRecvPacket(ctx, receivedPacket(t, types.IbcCommentPacketData{PostID: 7, Content: "content", Creator: "A"}), nil)
+	require.True(t, ack.Success())
+
+	ack = module.OnRecvPacket(ctx, receivedPacket(t, types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"}), nil)
+	require.Equal(t, channeltypes.NewErrorAcknowledgement(types.ErrRateLimited), ack)
+	require.Empty(t, k.GetReactionCounts(ctx, types.CommentPostKindSentPost, 0))
+
+	// The limit applies to a window of blocks
+	ctx = ctx.WithBlockHeight(int64(params.RateLimitWindowBlocks))
+	ack = module.OnRecvPacket(ctx, receivedPacket(t, types.IbcReactionPacketData{PostID: 7, Reaction: types.ReactionLike, Creator: "A"}), nil)
+	require.True(t, ack.Success())
+}
@@ -9,6 +9,28 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendIbcPost{}, "blog/SendIbcPost", nil)
+	cdc.RegisterConcrete(&MsgCreatePost{}, "blog/CreatePost", nil)
+	cdc.RegisterConcrete(&MsgUpdatePost{}, "blog/UpdatePost", nil)
+	cdc.RegisterConcrete(&MsgDeletePost{}, "blog/DeletePost", nil)
+	cdc.RegisterConcrete(&MsgRetryIbcPost{}, "blog/RetryIbcPost", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "blog/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgBroadcastIbcPost{}, "blog/BroadcastIbcPost", nil)
+	cdc.RegisterConcrete(&MsgSendIbcComment{}, "blog/SendIbcComment", nil)
+	cdc.RegisterConcrete(&MsgSendIbcEditPost{}, "blog/SendIbcEditPost", nil)
+	cdc.RegisterConcrete(&MsgSendIbcDeletePost{}, "blog/SendIbcDeletePost", nil)
+	cdc.RegisterConcrete(&MsgMarkNotificationsRead{}, "blog/MarkNotificationsRead", nil)
+	cdc.RegisterConcrete(&MsgReactToPost{}, "blog/ReactToPost", nil)
+	cdc.RegisterConcrete(&MsgSendIbcReaction{}, "blog/SendIbcReaction", nil)
+	cdc.RegisterConcrete(&MsgApproveInboundPost{}, "blog/ApproveInboundPost", nil)
+	cdc.RegisterConcrete(&MsgRejectInboundPost{}, "blog/RejectInboundPost", nil)
+	cdc.RegisterConcrete(&MsgUpdateModerators{}, "blog/UpdateModerators", nil)
+	cdc.RegisterConcrete(&MsgHidePost{}, "blog/HidePost", nil)
+	cdc.RegisterConcrete(&MsgUnhidePost{}, "blog/UnhidePost", nil)
+	cdc.RegisterConcrete(&MsgBanAuthor{}, "blog/BanAuthor", nil)
+	cdc.RegisterConcrete(&MsgUnbanAuthor{}, "blog/UnbanAuthor", nil)
+	cdc.RegisterConcrete(&MsgBanChannel{}, "blog/BanChannel", nil)
+	cdc.RegisterConcrete(&MsgUnbanChannel{}, "blog/UnbanChannel", nil)
+	cdc.RegisterConcrete(&MsgReportPost{}, "blog/ReportPost", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -16,6 +38,52 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendIbcPost{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePost{},
+		&MsgUpdatePost{},
+		&MsgDeletePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRetryIbcPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBroadcastIbcPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendIbcComment{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendIbcEditPost{},
+		&MsgSendIbcDeletePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMarkNotificationsRead{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReactToPost{},
+		&MsgSendIbcReaction{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgApproveInboundPost{},
+		&MsgRejectInboundPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateModerators{},
+		&MsgHidePost{},
+		&MsgUnhidePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBanAuthor{},
+		&MsgUnbanAuthor{},
+		&MsgBanChannel{},
+		&MsgUnbanChannel{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReportPost{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
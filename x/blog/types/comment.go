@@ -0,0 +1,38 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// CommentPostKindPost is the kind of comments replying to a Post
+	CommentPostKindPost = "post"
+	// CommentPostKindSentPost is the kind of comments received for a post sent to another chain
+	CommentPostKindSentPost = "sentPost"
+)
+
+const (
+	// CommentStatusPending is the status of a comment sent until it is acknowledged or timed out
+	CommentStatusPending = "pending"
+	// CommentStatusDelivered is the status of a comment acknowledged by the chain of the post
+	CommentStatusDelivered = "delivered"
+	// CommentStatusFailed is the status of a comment rejected by the chain of the post
+	CommentStatusFailed = "failed"
+	// CommentStatusTimedout is the status of a comment whose packet timed out
+	CommentStatusTimedout = "timedout"
+)
+
+// IsValidCommentPostKind returns true if the post kind of a comment thread is known
+func IsValidCommentPostKind(postKind string) bool {
+	return postKind == CommentPostKindPost || postKind == CommentPostKindSentPost
+}
+
+// CommentThreadIndexValue returns the value indexing the comments of a post
+func CommentThreadIndexValue(postKind string, postID uint64) string {
+	return string(append(IndexKeyPrefix(postKind), sdk.Uint64ToBigEndian(postID)...))
+}
+
+// RemotePostIndexValue returns the value indexing a sentPost by the destination and the ID of the post on the
+// destination chain
+func RemotePostIndexValue(port string, channelID string, postID uint64) string {
+	value := append(IndexKeyPrefix(port), IndexKeyPrefix(channelID)...)
+	return string(append(value, sdk.Uint64ToBigEndian(postID)...))
+}
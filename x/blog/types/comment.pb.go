@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/comment.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Comment struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// postKind is "post" when postID is the ID of a Post, or "sentPost" when postID is the ID of a SentPost
+	// the comment was received for
+	PostKind string `protobuf:"bytes,2,opt,name=postKind,proto3" json:"postKind,omitempty"`
+	PostID   uint64 `protobuf:"varint,3,opt,name=postID,proto3" json:"postID,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// creator is the address of the local author, it is empty for comments received from another chain
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// remoteAuthor identifies the author of a comment received from another chain, it is not set for local comments
+	RemoteAuthor *RemoteAuthor `protobuf:"bytes,6,opt,name=remoteAuthor,proto3" json:"remoteAuthor,omitempty"`
+	// createdAt is the unix time in seconds of the block the comment was created or received in
+	CreatedAt     int64  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,8,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	TxHash        string `protobuf:"bytes,9,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// status is the delivery status of a comment sent to the chain of a received post, either "pending",
+	// "delivered", "failed" or "timedout"
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// port, channelID and sequence identify the packet the comment was sent in
+	Port      string `protobuf:"bytes,11,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID string `protobuf:"bytes,12,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Sequence  uint64 `protobuf:"varint,13,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// remoteCommentID is the ID of the comment on the chain of the post once delivered
+	RemoteCommentID uint64 `protobuf:"varint,14,opt,name=remoteCommentID,proto3" json:"remoteCommentID,omitempty"`
+	// error is the error acknowledgement of a failed comment
+	Error string `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c331fc64ce562fc, []int{0}
+}
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Comment) GetPostKind() string {
+	if m != nil {
+		return m.PostKind
+	}
+	return ""
+}
+
+func (m *Comment) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *Comment) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Comment) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Comment) GetRemoteAuthor() *RemoteAuthor {
+	if m != nil {
+		return m.RemoteAuthor
+	}
+	return nil
+}
+
+func (m *Comment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Comment) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Comment) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Comment) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Comment) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *Comment) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *Comment) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Comment) GetRemoteCommentID() uint64 {
+	if m != nil {
+		return m.RemoteCommentID
+	}
+	return 0
+}
+
+func (m *Comment) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Comment)(nil), "planet.blog.Comment")
+}
+
+func init() { proto.RegisterFile("planet/blog/comment.proto", fileDescriptor_6c331fc64ce562fc) }
+
+var fileDescriptor_6c331fc64ce562fc = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbd, 0x6e, 0xe2, 0x40,
+	0x14, 0x85, 0x19, 0xfe, 0x19, 0xfe, 0xa4, 0xd9, 0x15, 0xba, 0xa0, 0x95, 0x65, 0xad, 0xb6, 0x70,
+	0xb3, 0x46, 0x4a, 0xea, 0x14, 0x24, 0x14, 0xa0, 0x74, 0x2e, 0xd3, 0x19, 0x3c, 0xc2, 0x96, 0x60,
+	0xc6, 0x19, 0x5f, 0x24, 0xf2, 0x16, 0x79, 0xa6, 0x54, 0x29, 0x29, 0x53, 0x46, 0xf0, 0x22, 0x91,
+	0xef, 0x38, 0xfc, 0xa4, 0x9b, 0xef, 0x9c, 0x33, 0xa3, 0x3b, 0xf7, 0xf0, 0x61, 0xba, 0x0e, 0x95,
+	0xc4, 0xf1, 0x62, 0xad, 0x57, 0xe3, 0xa5, 0xde, 0x6c, 0xa4, 0x42, 0x3f, 0x35, 0x1a, 0xb5, 0x68,
+	0x5b, 0xcb, 0xcf, 0xad, 0xd1, 0xe0, 0x32, 0x97, 0xea, 0xac, 0x08, 0xfd, 0x7d, 0xab, 0xf0, 0xc6,
+	0x83, 0xbd, 0x26, 0x7a, 0xbc, 0x9c, 0x44, 0xc0, 0x5c, 0xe6, 0x55, 0x83, 0x72, 0x12, 0x89, 0x11,
+	0x6f, 0xe6, 0xc9, 0xc7, 0x44, 0x45, 0x50, 0x76, 0x99, 0xd7, 0x0a, 0x4e, 0x2c, 0x06, 0xbc, 0x9e,
+	0x9f, 0xe7, 0x53, 0xa8, 0x50, 0xbe, 0x20, 0x01, 0xbc, 0xb1, 0xd4, 0x0a, 0xa5, 0x42, 0xa8, 0xd2,
+	0x95, 0x6f, 0x24, 0xc7, 0xc8, 0x10, 0xb5, 0x81, 0x5a, 0xe1, 0x58, 0x14, 0x77, 0xbc, 0x63, 0xe4,
+	0x46, 0xa3, 0x9c, 0x6c, 0x31, 0xd6, 0x06, 0xea, 0x2e, 0xf3, 0xda, 0x37, 0x43, 0xff, 0x62, 0x7e,
+	0x3f, 0xb8, 0x08, 0x04, 0x57, 0x71, 0xf1, 0x87, 0xb7, 0xe8, 0x25, 0x19, 0x4d, 0x10, 0x1a, 0x2e,
+	0xf3, 0x2a, 0xc1, 0x59, 0x10, 0xff, 0x78, 0xb7, 0x80, 0x99, 0x4c, 0x56, 0x31, 0x42, 0x93, 0x12,
+	0xd7, 0x62, 0xfe, 0x1d, 0xdc, 0xcd, 0xc2, 0x2c, 0x86, 0x16, 0xcd, 0x56, 0x50, 0xae, 0x67, 0x18,
+	0xe2, 0x36, 0x03, 0x6e, 0x75, 0x4b, 0x42, 0xf0, 0x6a, 0xaa, 0x0d, 0x42, 0x9b, 0x54, 0x3a, 0xd3,
+	0x1c, 0x71, 0xa8, 0x94, 0x5c, 0xcf, 0xa7, 0xd0, 0x21, 0xe3, 0x2c, 0xe4, 0xcb, 0xcc, 0xe4, 0xf3,
+	0x56, 0xaa, 0xa5, 0x84, 0x2e, 0xad, 0xec, 0xc4, 0xc2, 0xe3, 0x7d, 0xfb, 0xa3, 0xa2, 0x89, 0xf9,
+	0x14, 0x7a, 0x14, 0xf9, 0x29, 0x8b, 0xdf, 0xbc, 0x26, 0x8d, 0xd1, 0x06, 0xfa, 0xf4, 0xbe, 0x85,
+	0xfb, 0xff, 0xef, 0x07, 0x87, 0xed, 0x0f, 0x0e, 0xfb, 0x3c, 0x38, 0xec, 0xf5, 0xe8, 0x94, 0xf6,
+	0x47, 0xa7, 0xf4, 0x71, 0x74, 0x4a, 0x4f, 0xbf, 0x8a, 0xda, 0x77, 0xb6, 0x78, 0x7c, 0x49, 0x65,
+	0xb6, 0xa8, 0x53, 0xf5, 0xb7, 0x5f, 0x03, 0x00, 0x55, 0xbf, 0xd2, 0xde, 0x3c, 0x02, 0x00, 0x00,
+}
+
+func (m *Comment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Comment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RemoteCommentID != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.RemoteCommentID))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemoteAuthor != nil {
+		{
+			size, err := m.RemoteAuthor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintComment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PostID != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PostKind) > 0 {
+		i -= len(m.PostKind)
+		copy(dAtA[i:], m.PostKind)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.PostKind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintComment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovComment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Comment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovComment(uint64(m.Id))
+	}
+	l = len(m.PostKind)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovComment(uint64(m.PostID))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	if m.RemoteAuthor != nil {
+		l = m.RemoteAuthor.Size()
+		n += 1 + l + sovComment(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovComment(uint64(m.CreatedAt))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovComment(uint64(m.CreatedHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovComment(uint64(m.Sequence))
+	}
+	if m.RemoteCommentID != 0 {
+		n += 1 + sovComment(uint64(m.RemoteCommentID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	return n
+}
+
+func sovComment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozComment(x uint64) (n int) {
+	return sovComment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Comment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAuthor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteAuthor == nil {
+				m.RemoteAuthor = &RemoteAuthor{}
+			}
+			if err := m.RemoteAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCommentID", wireType)
+			}
+			m.RemoteCommentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteCommentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipComment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthComment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupComment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthComment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthComment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowComment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupComment = fmt.Errorf("proto: unexpected end of group")
+)
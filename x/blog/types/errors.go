@@ -9,6 +9,18 @@ import (
 // x/blog module sentinel errors
 var (
 	ErrSample               = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrPostTooLong          = sdkerrors.Register(ModuleName, 1101, "post too long")
+	ErrInvalidTags          = sdkerrors.Register(ModuleName, 1102, "invalid tags")
+	ErrInvalidReaction      = sdkerrors.Register(ModuleName, 1103, "invalid reaction")
+	ErrInvalidReportReason  = sdkerrors.Register(ModuleName, 1104, "invalid report reason")
 	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
+	ErrPostAlreadyRetried   = sdkerrors.Register(ModuleName, 1502, "post already retried")
+	ErrChannelNotAllowed    = sdkerrors.Register(ModuleName, 1503, "channel not allowed")
+	ErrInboundPostRejected  = sdkerrors.Register(ModuleName, 1504, "post rejected by moderation")
+	ErrInboundPostExpired   = sdkerrors.Register(ModuleName, 1505, "post expired in moderation")
+	ErrAuthorBanned         = sdkerrors.Register(ModuleName, 1506, "author banned")
+	ErrChannelBanned        = sdkerrors.Register(ModuleName, 1507, "channel banned")
+	ErrRateLimited          = sdkerrors.Register(ModuleName, 1508, "rate limit exceeded")
+	ErrChannelModerated     = sdkerrors.Register(ModuleName, 1509, "channel moderated")
 )
@@ -0,0 +1,40 @@
+package types
+
+// Post events
+const (
+	EventTypeCreatePost  = "create_post"
+	EventTypeUpdatePost  = "update_post"
+	EventTypeDeletePost  = "delete_post"
+	EventTypeReactToPost = "react_to_post"
+
+	AttributeKeyPostID   = "post_id"
+	AttributeKeyCreator  = "creator"
+	AttributeKeyReaction = "reaction"
+)
+
+// Moderation events
+const (
+	EventTypeQueueInboundPost   = "queue_inbound_post"
+	EventTypeApproveInboundPost = "approve_inbound_post"
+	EventTypeRejectInboundPost  = "reject_inbound_post"
+	EventTypeExpireInboundPost  = "expire_inbound_post"
+	EventTypeDropInboundPost    = "drop_inbound_post"
+	EventTypeHidePost           = "hide_post"
+	EventTypeUnhidePost         = "unhide_post"
+	EventTypeAddModerator       = "add_moderator"
+	EventTypeRemoveModerator    = "remove_moderator"
+	EventTypeBanAuthor          = "ban_author"
+	EventTypeUnbanAuthor        = "unban_author"
+	EventTypeBanChannel         = "ban_channel"
+	EventTypeUnbanChannel       = "unban_channel"
+	EventTypeReportPost         = "report_post"
+
+	AttributeKeyInboundPostID = "inbound_post_id"
+	AttributeKeyModerator     = "moderator"
+	AttributeKeyReason        = "reason"
+	AttributeKeyAddress       = "address"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeyReporter      = "reporter"
+	AttributeKeyHidden        = "hidden"
+	AttributeKeyError         = "error"
+)
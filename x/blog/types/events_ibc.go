@@ -2,8 +2,12 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout       = "timeout"
-	EventTypeIbcPostPacket = "ibcPost_packet"
+	EventTypeTimeout             = "timeout"
+	EventTypeIbcPostPacket       = "ibcPost_packet"
+	EventTypeIbcCommentPacket    = "ibcComment_packet"
+	EventTypeIbcEditPostPacket   = "ibcEditPost_packet"
+	EventTypeIbcDeletePostPacket = "ibcDeletePost_packet"
+	EventTypeIbcReactionPacket   = "ibcReaction_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
@@ -0,0 +1,1060 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/failed_post.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FailedPost struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// chainID is the chain ID of the destination chain, resolved from the client of the channel
+	ChainID        string `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Creator        string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Error          string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Content        string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	RetryPort      string `protobuf:"bytes,7,opt,name=retryPort,proto3" json:"retryPort,omitempty"`
+	RetryChannelID string `protobuf:"bytes,8,opt,name=retryChannelID,proto3" json:"retryChannelID,omitempty"`
+	RetrySequence  uint64 `protobuf:"varint,9,opt,name=retrySequence,proto3" json:"retrySequence,omitempty"`
+	// sentAt is the unix time in seconds of the block the packet was sent in
+	SentAt     int64  `protobuf:"varint,10,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	SentHeight int64  `protobuf:"varint,11,opt,name=sentHeight,proto3" json:"sentHeight,omitempty"`
+	SentTxHash string `protobuf:"bytes,12,opt,name=sentTxHash,proto3" json:"sentTxHash,omitempty"`
+	// failedAt is the unix time in seconds of the block the error acknowledgement was received in
+	FailedAt     int64 `protobuf:"varint,13,opt,name=failedAt,proto3" json:"failedAt,omitempty"`
+	FailedHeight int64 `protobuf:"varint,14,opt,name=failedHeight,proto3" json:"failedHeight,omitempty"`
+	// destinationPort and destinationChannel identify the receiving end of the channel the post was sent through
+	DestinationPort    string   `protobuf:"bytes,15,opt,name=destinationPort,proto3" json:"destinationPort,omitempty"`
+	DestinationChannel string   `protobuf:"bytes,16,opt,name=destinationChannel,proto3" json:"destinationChannel,omitempty"`
+	Tags               []string `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *FailedPost) Reset()         { *m = FailedPost{} }
+func (m *FailedPost) String() string { return proto.CompactTextString(m) }
+func (*FailedPost) ProtoMessage()    {}
+func (*FailedPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2e823c46c872b01, []int{0}
+}
+func (m *FailedPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedPost.Merge(m, src)
+}
+func (m *FailedPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedPost proto.InternalMessageInfo
+
+func (m *FailedPost) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedPost) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *FailedPost) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *FailedPost) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *FailedPost) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedPost) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *FailedPost) GetRetryPort() string {
+	if m != nil {
+		return m.RetryPort
+	}
+	return ""
+}
+
+func (m *FailedPost) GetRetryChannelID() string {
+	if m != nil {
+		return m.RetryChannelID
+	}
+	return ""
+}
+
+func (m *FailedPost) GetRetrySequence() uint64 {
+	if m != nil {
+		return m.RetrySequence
+	}
+	return 0
+}
+
+func (m *FailedPost) GetSentAt() int64 {
+	if m != nil {
+		return m.SentAt
+	}
+	return 0
+}
+
+func (m *FailedPost) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *FailedPost) GetSentTxHash() string {
+	if m != nil {
+		return m.SentTxHash
+	}
+	return ""
+}
+
+func (m *FailedPost) GetFailedAt() int64 {
+	if m != nil {
+		return m.FailedAt
+	}
+	return 0
+}
+
+func (m *FailedPost) GetFailedHeight() int64 {
+	if m != nil {
+		return m.FailedHeight
+	}
+	return 0
+}
+
+func (m *FailedPost) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *FailedPost) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *FailedPost) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FailedPost)(nil), "planet.blog.FailedPost")
+}
+
+func init() { proto.RegisterFile("planet/blog/failed_post.proto", fileDescriptor_f2e823c46c872b01) }
+
+var fileDescriptor_f2e823c46c872b01 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xc4, 0x4d, 0xeb, 0x69, 0x9b, 0xc2, 0x80, 0xd0, 0x08, 0x81, 0x65, 0x55, 0x08,
+	0xf9, 0x42, 0x7a, 0xe0, 0x09, 0x0a, 0x15, 0x6a, 0x6f, 0x95, 0xe1, 0xc4, 0x05, 0x6d, 0xe2, 0x25,
+	0x5e, 0xc9, 0xda, 0x35, 0xeb, 0x41, 0x4a, 0xde, 0x80, 0x23, 0x8f, 0xc5, 0x31, 0x47, 0x8e, 0x28,
+	0x79, 0x11, 0x94, 0x59, 0x07, 0x92, 0xa8, 0xb7, 0xf9, 0xbf, 0x6f, 0x3c, 0xde, 0x5d, 0x0d, 0xbc,
+	0x6c, 0x6a, 0x65, 0x35, 0x5f, 0x4d, 0x6a, 0x37, 0xbb, 0xfa, 0xaa, 0x4c, 0xad, 0xcb, 0x2f, 0x8d,
+	0x6b, 0x79, 0xdc, 0x78, 0xc7, 0x0e, 0x4f, 0x83, 0x1e, 0x6f, 0xf4, 0xe5, 0x8f, 0x18, 0xe0, 0x83,
+	0xb4, 0xdc, 0xbb, 0x96, 0x71, 0x04, 0x7d, 0x53, 0x52, 0x94, 0x45, 0x79, 0x5c, 0xf4, 0x4d, 0x89,
+	0x4f, 0xe1, 0x88, 0x0d, 0xd7, 0x9a, 0xfa, 0x59, 0x94, 0x27, 0x45, 0x08, 0x48, 0x70, 0x3c, 0xad,
+	0x94, 0xb1, 0x77, 0x37, 0x34, 0x10, 0xbe, 0x8d, 0x62, 0xbc, 0x56, 0xec, 0x3c, 0xc5, 0x9d, 0x09,
+	0x71, 0x33, 0x49, 0x7b, 0xef, 0x3c, 0x1d, 0x85, 0x49, 0x12, 0xa4, 0xdf, 0x59, 0xd6, 0x96, 0x69,
+	0xd8, 0xf5, 0x87, 0x88, 0x2f, 0x20, 0xf1, 0x9a, 0xfd, 0xe2, 0xde, 0x79, 0xa6, 0x63, 0x71, 0xff,
+	0x01, 0xbe, 0x86, 0x91, 0x84, 0xf7, 0x95, 0xb2, 0x56, 0xd7, 0x77, 0x37, 0x74, 0x22, 0x2d, 0x07,
+	0x14, 0x5f, 0xc1, 0xb9, 0x90, 0x8f, 0xfa, 0xdb, 0x77, 0x6d, 0xa7, 0x9a, 0x12, 0xb9, 0xda, 0x3e,
+	0xc4, 0x67, 0x30, 0x6c, 0xb5, 0xe5, 0x6b, 0x26, 0xc8, 0xa2, 0x7c, 0x50, 0x74, 0x09, 0x53, 0x80,
+	0x4d, 0x75, 0xab, 0xcd, 0xac, 0x62, 0x3a, 0x15, 0xb7, 0x43, 0xb6, 0xfe, 0xd3, 0xfc, 0x56, 0xb5,
+	0x15, 0x9d, 0xc9, 0x09, 0x76, 0x08, 0x3e, 0x87, 0x93, 0xf0, 0xfc, 0xd7, 0x4c, 0xe7, 0xf2, 0xf5,
+	0xbf, 0x8c, 0x97, 0x70, 0x16, 0xea, 0x6e, 0xfa, 0x48, 0xfc, 0x1e, 0xc3, 0x1c, 0x2e, 0x4a, 0xdd,
+	0xb2, 0xb1, 0x8a, 0x8d, 0xb3, 0xf2, 0x12, 0x17, 0xf2, 0x93, 0x43, 0x8c, 0x63, 0xc0, 0x1d, 0xd4,
+	0xdd, 0x9f, 0x1e, 0x49, 0xf3, 0x03, 0x06, 0x11, 0x62, 0x56, 0xb3, 0x96, 0x1e, 0x67, 0x83, 0x3c,
+	0x29, 0xa4, 0x7e, 0xf7, 0xe6, 0xd7, 0x2a, 0x8d, 0x96, 0xab, 0x34, 0xfa, 0xb3, 0x4a, 0xa3, 0x9f,
+	0xeb, 0xb4, 0xb7, 0x5c, 0xa7, 0xbd, 0xdf, 0xeb, 0xb4, 0xf7, 0xf9, 0x49, 0xb7, 0x50, 0xf3, 0xb0,
+	0x52, 0xbc, 0x68, 0x74, 0x3b, 0x19, 0xca, 0x36, 0xbd, 0xfd, 0x3b, 0x00, 0xfd, 0x68, 0xb8, 0x77,
+	0x6e, 0x02, 0x00, 0x00,
+}
+
+func (m *FailedPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintFailedPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.FailedHeight != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.FailedHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FailedAt != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.FailedAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SentTxHash) > 0 {
+		i -= len(m.SentTxHash)
+		copy(dAtA[i:], m.SentTxHash)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.SentTxHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetrySequence != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.RetrySequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RetryChannelID) > 0 {
+		i -= len(m.RetryChannelID)
+		copy(dAtA[i:], m.RetryChannelID)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.RetryChannelID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RetryPort) > 0 {
+		i -= len(m.RetryPort)
+		copy(dAtA[i:], m.RetryPort)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.RetryPort)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFailedPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFailedPost(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFailedPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFailedPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFailedPost(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.RetryPort)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.RetryChannelID)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	if m.RetrySequence != 0 {
+		n += 1 + sovFailedPost(uint64(m.RetrySequence))
+	}
+	if m.SentAt != 0 {
+		n += 1 + sovFailedPost(uint64(m.SentAt))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovFailedPost(uint64(m.SentHeight))
+	}
+	l = len(m.SentTxHash)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	if m.FailedAt != 0 {
+		n += 1 + sovFailedPost(uint64(m.FailedAt))
+	}
+	if m.FailedHeight != 0 {
+		n += 1 + sovFailedPost(uint64(m.FailedHeight))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovFailedPost(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 2 + l + sovFailedPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovFailedPost(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFailedPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFailedPost(x uint64) (n int) {
+	return sovFailedPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFailedPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrySequence", wireType)
+			}
+			m.RetrySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetrySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			m.FailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedHeight", wireType)
+			}
+			m.FailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFailedPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFailedPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFailedPost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFailedPost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFailedPost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFailedPost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFailedPost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFailedPost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFailedPost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFailedPost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,10 +11,22 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:           PortID,
-		PostList:         []Post{},
-		SentPostList:     []SentPost{},
-		TimedoutPostList: []TimedoutPost{},
+		PortId:               PortID,
+		PostList:             []Post{},
+		SentPostList:         []SentPost{},
+		TimedoutPostList:     []TimedoutPost{},
+		PendingPostList:      []PendingPost{},
+		FailedPostList:       []FailedPost{},
+		CommentList:          []Comment{},
+		PostRevisionList:     []PostRevision{},
+		NotificationList:     []Notification{},
+		ReactionList:         []Reaction{},
+		InboundPostList:      []InboundPost{},
+		ModeratorList:        []Moderator{},
+		ModerationActionList: []ModerationAction{},
+		BannedAuthorList:     []BannedAuthor{},
+		BannedChannelList:    []BannedChannel{},
+		ReportList:           []Report{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -36,6 +48,9 @@ func (gs GenesisState) Validate() error {
 		if elem.Id >= postCount {
 			return fmt.Errorf("post id should be lower or equal than the last id")
 		}
+		if err := ValidateTags(elem.Tags); err != nil {
+			return fmt.Errorf("invalid tags for post %d: %w", elem.Id, err)
+		}
 		postIdMap[elem.Id] = true
 	}
 	// Check for duplicated ID in sentPost
@@ -62,6 +77,152 @@ func (gs GenesisState) Validate() error {
 		}
 		timedoutPostIdMap[elem.Id] = true
 	}
+	// Check for duplicated index in pendingPost
+	pendingPostIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PendingPostList {
+		index := string(PendingPostKey(elem.Port, elem.ChannelID, elem.Sequence))
+		if _, ok := pendingPostIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingPost")
+		}
+		pendingPostIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in failedPost
+	failedPostIdMap := make(map[uint64]bool)
+	failedPostCount := gs.GetFailedPostCount()
+	for _, elem := range gs.FailedPostList {
+		if _, ok := failedPostIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for failedPost")
+		}
+		if elem.Id >= failedPostCount {
+			return fmt.Errorf("failedPost id should be lower or equal than the last id")
+		}
+		failedPostIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in comment
+	commentIdMap := make(map[uint64]bool)
+	commentCount := gs.GetCommentCount()
+	for _, elem := range gs.CommentList {
+		if _, ok := commentIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for comment")
+		}
+		if elem.Id >= commentCount {
+			return fmt.Errorf("comment id should be lower or equal than the last id")
+		}
+		commentIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in postRevision
+	postRevisionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PostRevisionList {
+		index := string(PostRevisionKey(elem.PostID, elem.Revision))
+		if _, ok := postRevisionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for postRevision")
+		}
+		if _, ok := postIdMap[elem.PostID]; !ok {
+			return fmt.Errorf("postRevision post id should be the id of a post")
+		}
+		postRevisionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in notification
+	notificationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.NotificationList {
+		index := string(NotificationKey(elem.Address, elem.Id))
+		if _, ok := notificationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for notification")
+		}
+		if elem.Address == "" {
+			return fmt.Errorf("notification address should not be empty")
+		}
+		notificationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in reaction
+	reactionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ReactionList {
+		if !IsValidCommentPostKind(elem.PostKind) {
+			return fmt.Errorf("invalid reaction post kind %s", elem.PostKind)
+		}
+		if !IsValidReaction(elem.Reaction) {
+			return fmt.Errorf("unknown reaction %s", elem.Reaction)
+		}
+		if elem.Reactor() == "" {
+			return fmt.Errorf("reaction account should not be empty")
+		}
+		index := string(ReactionKey(elem.PostKind, elem.PostID, elem.Reactor()))
+		if _, ok := reactionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for reaction")
+		}
+		reactionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in inboundPost
+	inboundPostIdMap := make(map[uint64]bool)
+	inboundPostCount := gs.GetInboundPostCount()
+	for _, elem := range gs.InboundPostList {
+		if _, ok := inboundPostIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for inboundPost")
+		}
+		if elem.Id >= inboundPostCount {
+			return fmt.Errorf("inboundPost id should be lower or equal than the last id")
+		}
+		inboundPostIdMap[elem.Id] = true
+	}
+	// Check for duplicated address in moderator
+	moderatorAddressMap := make(map[string]struct{})
+	for _, elem := range gs.ModeratorList {
+		if _, ok := moderatorAddressMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for moderator")
+		}
+		moderatorAddressMap[elem.Address] = struct{}{}
+	}
+	// Check for duplicated ID in moderationAction
+	moderationActionIdMap := make(map[uint64]bool)
+	moderationActionCount := gs.GetModerationActionCount()
+	for _, elem := range gs.ModerationActionList {
+		if _, ok := moderationActionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for moderationAction")
+		}
+		if elem.Id >= moderationActionCount {
+			return fmt.Errorf("moderationAction id should be lower or equal than the last id")
+		}
+		moderationActionIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in bannedAuthor
+	bannedAuthorIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BannedAuthorList {
+		index := string(BannedAuthorStoreKey(elem.ChannelID, elem.Address))
+		if _, ok := bannedAuthorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bannedAuthor")
+		}
+		bannedAuthorIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in bannedChannel
+	bannedChannelIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BannedChannelList {
+		if _, ok := bannedChannelIndexMap[elem.ChannelID]; ok {
+			return fmt.Errorf("duplicated index for bannedChannel")
+		}
+		bannedChannelIndexMap[elem.ChannelID] = struct{}{}
+	}
+	// Check for duplicated index in report
+	reportIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ReportList {
+		index := string(ReportStoreKey(elem.PostID, elem.Reporter))
+		if _, ok := reportIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for report")
+		}
+		if _, ok := postIdMap[elem.PostID]; !ok {
+			return fmt.Errorf("report post id should be the id of a post")
+		}
+		if elem.Reporter == "" {
+			return fmt.Errorf("report reporter should not be empty")
+		}
+		if !IsValidReportReason(elem.Reason) {
+			return fmt.Errorf("unknown report reason %s", elem.Reason)
+		}
+		reportIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
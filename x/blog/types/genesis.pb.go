@@ -25,14 +25,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the blog module's genesis state.
 type GenesisState struct {
-	Params            Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId            string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	PostList          []Post         `protobuf:"bytes,3,rep,name=postList,proto3" json:"postList"`
-	PostCount         uint64         `protobuf:"varint,4,opt,name=postCount,proto3" json:"postCount,omitempty"`
-	SentPostList      []SentPost     `protobuf:"bytes,5,rep,name=sentPostList,proto3" json:"sentPostList"`
-	SentPostCount     uint64         `protobuf:"varint,6,opt,name=sentPostCount,proto3" json:"sentPostCount,omitempty"`
-	TimedoutPostList  []TimedoutPost `protobuf:"bytes,7,rep,name=timedoutPostList,proto3" json:"timedoutPostList"`
-	TimedoutPostCount uint64         `protobuf:"varint,8,opt,name=timedoutPostCount,proto3" json:"timedoutPostCount,omitempty"`
+	Params                Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                string             `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PostList              []Post             `protobuf:"bytes,3,rep,name=postList,proto3" json:"postList"`
+	PostCount             uint64             `protobuf:"varint,4,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	SentPostList          []SentPost         `protobuf:"bytes,5,rep,name=sentPostList,proto3" json:"sentPostList"`
+	SentPostCount         uint64             `protobuf:"varint,6,opt,name=sentPostCount,proto3" json:"sentPostCount,omitempty"`
+	TimedoutPostList      []TimedoutPost     `protobuf:"bytes,7,rep,name=timedoutPostList,proto3" json:"timedoutPostList"`
+	TimedoutPostCount     uint64             `protobuf:"varint,8,opt,name=timedoutPostCount,proto3" json:"timedoutPostCount,omitempty"`
+	PendingPostList       []PendingPost      `protobuf:"bytes,9,rep,name=pendingPostList,proto3" json:"pendingPostList"`
+	FailedPostList        []FailedPost       `protobuf:"bytes,10,rep,name=failedPostList,proto3" json:"failedPostList"`
+	FailedPostCount       uint64             `protobuf:"varint,11,opt,name=failedPostCount,proto3" json:"failedPostCount,omitempty"`
+	CommentList           []Comment          `protobuf:"bytes,12,rep,name=commentList,proto3" json:"commentList"`
+	CommentCount          uint64             `protobuf:"varint,13,opt,name=commentCount,proto3" json:"commentCount,omitempty"`
+	PostRevisionList      []PostRevision     `protobuf:"bytes,14,rep,name=postRevisionList,proto3" json:"postRevisionList"`
+	NotificationList      []Notification     `protobuf:"bytes,15,rep,name=notificationList,proto3" json:"notificationList"`
+	ReactionList          []Reaction         `protobuf:"bytes,16,rep,name=reactionList,proto3" json:"reactionList"`
+	InboundPostList       []InboundPost      `protobuf:"bytes,17,rep,name=inboundPostList,proto3" json:"inboundPostList"`
+	InboundPostCount      uint64             `protobuf:"varint,18,opt,name=inboundPostCount,proto3" json:"inboundPostCount,omitempty"`
+	ModeratorList         []Moderator        `protobuf:"bytes,19,rep,name=moderatorList,proto3" json:"moderatorList"`
+	ModerationActionList  []ModerationAction `protobuf:"bytes,20,rep,name=moderationActionList,proto3" json:"moderationActionList"`
+	ModerationActionCount uint64             `protobuf:"varint,21,opt,name=moderationActionCount,proto3" json:"moderationActionCount,omitempty"`
+	BannedAuthorList      []BannedAuthor     `protobuf:"bytes,22,rep,name=bannedAuthorList,proto3" json:"bannedAuthorList"`
+	BannedChannelList     []BannedChannel    `protobuf:"bytes,23,rep,name=bannedChannelList,proto3" json:"bannedChannelList"`
+	ReportList            []Report           `protobuf:"bytes,24,rep,name=reportList,proto3" json:"reportList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +140,118 @@ func (m *GenesisState) GetTimedoutPostCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPendingPostList() []PendingPost {
+	if m != nil {
+		return m.PendingPostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedPostList() []FailedPost {
+	if m != nil {
+		return m.FailedPostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedPostCount() uint64 {
+	if m != nil {
+		return m.FailedPostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommentList() []Comment {
+	if m != nil {
+		return m.CommentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommentCount() uint64 {
+	if m != nil {
+		return m.CommentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostRevisionList() []PostRevision {
+	if m != nil {
+		return m.PostRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNotificationList() []Notification {
+	if m != nil {
+		return m.NotificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReactionList() []Reaction {
+	if m != nil {
+		return m.ReactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInboundPostList() []InboundPost {
+	if m != nil {
+		return m.InboundPostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInboundPostCount() uint64 {
+	if m != nil {
+		return m.InboundPostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetModeratorList() []Moderator {
+	if m != nil {
+		return m.ModeratorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModerationActionList() []ModerationAction {
+	if m != nil {
+		return m.ModerationActionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModerationActionCount() uint64 {
+	if m != nil {
+		return m.ModerationActionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBannedAuthorList() []BannedAuthor {
+	if m != nil {
+		return m.BannedAuthorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBannedChannelList() []BannedChannel {
+	if m != nil {
+		return m.BannedChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReportList() []Report {
+	if m != nil {
+		return m.ReportList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "planet.blog.GenesisState")
 }
@@ -131,29 +259,51 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/genesis.proto", fileDescriptor_c6c02ff89d106757) }
 
 var fileDescriptor_c6c02ff89d106757 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4d, 0x4f, 0xc2, 0x30,
-	0x1c, 0xc6, 0x57, 0xc1, 0x01, 0x05, 0x13, 0x29, 0xbe, 0x0c, 0x34, 0x65, 0x31, 0x1e, 0x76, 0xd0,
-	0x11, 0xe1, 0x03, 0x98, 0xe0, 0xc1, 0x18, 0x3d, 0x10, 0xf0, 0xe4, 0x85, 0x8c, 0xd0, 0x2c, 0x4b,
-	0x60, 0x5d, 0x68, 0x49, 0xf4, 0x53, 0xe8, 0xc7, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0, 0x45, 0x4c,
-	0x5f, 0x86, 0x6d, 0xb8, 0xb5, 0xcf, 0xf3, 0xfc, 0x9f, 0x5f, 0xb7, 0x3f, 0x6c, 0x66, 0xb3, 0x28,
-	0x25, 0xbc, 0x33, 0x99, 0xd1, 0xb8, 0x13, 0x93, 0x94, 0xb0, 0x84, 0x85, 0xd9, 0x82, 0x72, 0x8a,
-	0xaa, 0xca, 0x0a, 0x85, 0xd5, 0x3a, 0x89, 0x69, 0x4c, 0xa5, 0xde, 0x11, 0x27, 0x15, 0x69, 0x79,
-	0xe6, 0x74, 0x16, 0x2d, 0xa2, 0xb9, 0x1e, 0x6e, 0x9d, 0x59, 0x0e, 0x65, 0x5c, 0xeb, 0x17, 0xa6,
-	0xce, 0x48, 0xca, 0xc7, 0x86, 0xd9, 0x36, 0x4d, 0x9e, 0xcc, 0xc9, 0x94, 0x2e, 0xcd, 0xc0, 0xd5,
-	0x67, 0x01, 0xd6, 0x1e, 0xd5, 0x23, 0x47, 0x3c, 0xe2, 0x04, 0xdd, 0x41, 0x57, 0x61, 0x3d, 0xe0,
-	0x83, 0xa0, 0xda, 0x6d, 0x84, 0xc6, 0xa3, 0xc3, 0x81, 0xb4, 0xfa, 0xc5, 0xd5, 0x4f, 0xdb, 0x19,
-	0xea, 0x20, 0x3a, 0x87, 0xa5, 0x8c, 0x2e, 0xf8, 0x38, 0x99, 0x7a, 0x07, 0x3e, 0x08, 0x2a, 0x43,
-	0x57, 0x5c, 0x9f, 0xa6, 0xa8, 0x07, 0xcb, 0x02, 0xf5, 0x92, 0x30, 0xee, 0x15, 0xfc, 0x42, 0x50,
-	0xed, 0xd6, 0xed, 0x36, 0xca, 0xb8, 0xee, 0xda, 0x05, 0xd1, 0x25, 0xac, 0x88, 0xf3, 0x03, 0x5d,
-	0xa6, 0xdc, 0x2b, 0xfa, 0x20, 0x28, 0x0e, 0xff, 0x05, 0x74, 0x0f, 0x6b, 0xe2, 0x1b, 0x07, 0x79,
-	0xed, 0xa1, 0xac, 0x3d, 0xb5, 0x6a, 0x47, 0x3a, 0xa0, 0xab, 0xad, 0x01, 0x74, 0x0d, 0x8f, 0xf2,
-	0xbb, 0x42, 0xb8, 0x12, 0x61, 0x8b, 0xe8, 0x19, 0x1e, 0xe7, 0x7f, 0x6b, 0x87, 0x2a, 0x49, 0x54,
-	0xd3, 0x42, 0xbd, 0x1a, 0x21, 0x8d, 0xdb, 0x1b, 0x44, 0x37, 0xb0, 0x6e, 0x6a, 0x0a, 0x5b, 0x96,
-	0xd8, 0x7d, 0xa3, 0x7f, 0xbb, 0xda, 0x60, 0xb0, 0xde, 0x60, 0xf0, 0xbb, 0xc1, 0xe0, 0x6b, 0x8b,
-	0x9d, 0xf5, 0x16, 0x3b, 0xdf, 0x5b, 0xec, 0xbc, 0x35, 0xf4, 0x32, 0xdf, 0xf5, 0x3a, 0x3f, 0x32,
-	0xc2, 0x26, 0xae, 0xdc, 0x63, 0xef, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x1c, 0xae, 0xc0, 0x77,
-	0x02, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x6e, 0x06, 0x2b, 0xe0, 0x96, 0x9f, 0x9a, 0x16, 0x42, 0x07, 0xa1, 0x42, 0xbb, 0xa8, 0xa6,
+	0xad, 0x68, 0xb0, 0x9b, 0x49, 0x93, 0x26, 0x8a, 0xf6, 0x83, 0xb6, 0x21, 0x14, 0x26, 0x4d, 0xda,
+	0x0d, 0x4a, 0x89, 0x29, 0x91, 0x5a, 0xbb, 0x4a, 0xdc, 0x69, 0x7b, 0x8b, 0x3d, 0xc6, 0x1e, 0x85,
+	0x4b, 0x2e, 0x77, 0x35, 0x4d, 0xf0, 0x22, 0x93, 0x8f, 0x4f, 0x12, 0x3b, 0xcd, 0xae, 0x68, 0xce,
+	0xf7, 0x67, 0xfb, 0x1c, 0x1b, 0xb2, 0x35, 0x19, 0x05, 0x9c, 0xc9, 0xfd, 0xc1, 0x48, 0x0c, 0xf7,
+	0x87, 0x8c, 0xb3, 0x24, 0x4a, 0x7a, 0x93, 0x58, 0x48, 0x41, 0x6b, 0x1a, 0xea, 0x29, 0xa8, 0xdd,
+	0x1c, 0x8a, 0xa1, 0x80, 0xfa, 0xbe, 0xfa, 0xa5, 0x29, 0x6d, 0xd7, 0x54, 0x4f, 0x82, 0x38, 0x18,
+	0xa3, 0xb8, 0xbd, 0x61, 0x21, 0x22, 0x91, 0x58, 0x7f, 0x64, 0xd6, 0x13, 0xc6, 0xe5, 0x85, 0x01,
+	0xee, 0x9a, 0xa0, 0x8c, 0xc6, 0x2c, 0x14, 0x53, 0x8b, 0xe0, 0x59, 0xae, 0x8c, 0x87, 0x11, 0x1f,
+	0x9a, 0xf8, 0x8e, 0x89, 0x5f, 0x05, 0xd1, 0x88, 0x85, 0x26, 0x6c, 0x6d, 0xf6, 0x52, 0x8c, 0xc7,
+	0x8c, 0x97, 0x46, 0x2b, 0xc9, 0x45, 0xcc, 0xbe, 0x45, 0x49, 0x24, 0x78, 0x59, 0x34, 0x17, 0x32,
+	0xba, 0x8a, 0x2e, 0x03, 0x99, 0xe3, 0x6d, 0x13, 0x8f, 0x59, 0x70, 0x29, 0xff, 0xa3, 0x8d, 0xf8,
+	0x40, 0x4c, 0xb9, 0xb5, 0xae, 0x6d, 0x13, 0x1f, 0x8b, 0x90, 0xc5, 0xa6, 0x73, 0xcb, 0x44, 0x07,
+	0x01, 0x2f, 0x3b, 0xfb, 0x98, 0x4d, 0x44, 0x8c, 0x76, 0x7b, 0xbf, 0x6a, 0xa4, 0xfe, 0x4e, 0xb7,
+	0xf2, 0x5c, 0x06, 0x92, 0xd1, 0xe7, 0xa4, 0xaa, 0x9b, 0xe3, 0x3a, 0x1d, 0xa7, 0x5b, 0x3b, 0x58,
+	0xef, 0x19, 0xad, 0xed, 0x9d, 0x01, 0xd4, 0x9f, 0xbf, 0xf9, 0xb3, 0x5b, 0xf1, 0x91, 0x48, 0x37,
+	0xc9, 0x82, 0x72, 0xbc, 0x88, 0x42, 0xf7, 0x41, 0xc7, 0xe9, 0x2e, 0xf9, 0x55, 0xf5, 0x79, 0x12,
+	0xd2, 0x43, 0xb2, 0xa8, 0x56, 0xfe, 0x31, 0x4a, 0xa4, 0x3b, 0xd7, 0x99, 0xeb, 0xd6, 0x0e, 0x1a,
+	0xb6, 0x9b, 0x48, 0x24, 0x7a, 0x65, 0x44, 0xba, 0x4d, 0x96, 0xd4, 0xef, 0x63, 0x31, 0xe5, 0xd2,
+	0x9d, 0xef, 0x38, 0xdd, 0x79, 0x3f, 0x2f, 0xd0, 0xd7, 0xa4, 0xae, 0x26, 0xe1, 0x2c, 0xb5, 0x7d,
+	0x08, 0xb6, 0x2d, 0xcb, 0xf6, 0x1c, 0x09, 0x68, 0x6d, 0x09, 0xe8, 0x63, 0xb2, 0x9c, 0x7e, 0xeb,
+	0x88, 0x2a, 0x44, 0xd8, 0x45, 0xfa, 0x81, 0xac, 0xa5, 0x33, 0x95, 0x45, 0x2d, 0x40, 0xd4, 0x96,
+	0x15, 0xf5, 0xd9, 0x20, 0x61, 0xdc, 0x8c, 0x90, 0x3e, 0x25, 0x0d, 0xb3, 0xa6, 0x63, 0x17, 0x21,
+	0x76, 0x16, 0xa0, 0xef, 0xc9, 0x2a, 0x4e, 0x6b, 0x96, 0xbc, 0x04, 0xc9, 0xae, 0x7d, 0x76, 0x39,
+	0x07, 0x83, 0x8b, 0x32, 0xfa, 0x86, 0xac, 0xe8, 0xb9, 0xce, 0x8c, 0x08, 0x18, 0x6d, 0x5a, 0x46,
+	0x6f, 0x33, 0x0a, 0xfa, 0x14, 0x44, 0xb4, 0x4b, 0x56, 0xf3, 0x8a, 0x5e, 0x7c, 0x0d, 0x16, 0x5f,
+	0x2c, 0xd3, 0x57, 0xa4, 0x86, 0x37, 0x05, 0xd2, 0xea, 0x90, 0xd6, 0xb4, 0xd2, 0x8e, 0x35, 0x8e,
+	0x51, 0x26, 0x9d, 0xee, 0x91, 0x3a, 0x7e, 0xea, 0x90, 0x65, 0x08, 0xb1, 0x6a, 0xaa, 0x2f, 0x6a,
+	0x16, 0x7c, 0xbc, 0x6f, 0x10, 0xb3, 0x52, 0xd2, 0x97, 0x33, 0x83, 0x94, 0xf6, 0xa5, 0x28, 0x54,
+	0x66, 0xe6, 0xe5, 0x04, 0xb3, 0xd5, 0x12, 0xb3, 0x53, 0x83, 0x94, 0x9a, 0x15, 0x85, 0x6a, 0x30,
+	0xd3, 0x9b, 0x0c, 0x46, 0x6b, 0x25, 0x83, 0xe9, 0x23, 0x21, 0x1d, 0x4c, 0x53, 0xa0, 0xfa, 0x8e,
+	0xd7, 0x3d, 0x6b, 0x57, 0xa3, 0xa4, 0xef, 0x27, 0x39, 0x27, 0xed, 0x7b, 0x41, 0x46, 0x9f, 0x90,
+	0x35, 0xa3, 0xa4, 0x0f, 0x93, 0xc2, 0x61, 0xce, 0xd4, 0x69, 0x9f, 0x2c, 0xe3, 0x23, 0x22, 0x62,
+	0xc8, 0x5c, 0x87, 0xcc, 0x0d, 0x2b, 0xf3, 0x53, 0xca, 0xc0, 0x44, 0x5b, 0x42, 0xbf, 0x90, 0x66,
+	0xfe, 0x10, 0x1d, 0xe5, 0x47, 0xd0, 0x04, 0xab, 0x9d, 0x32, 0xab, 0x8c, 0x88, 0x8e, 0xa5, 0x06,
+	0xf4, 0x05, 0x69, 0x15, 0xeb, 0x7a, 0x37, 0x2d, 0xd8, 0x4d, 0x39, 0xa8, 0xda, 0x3a, 0x08, 0x38,
+	0x67, 0xe1, 0xd1, 0x54, 0x5e, 0xe3, 0xae, 0x36, 0x4a, 0xda, 0xda, 0x37, 0x48, 0x69, 0x5b, 0x8b,
+	0x42, 0x7a, 0x4a, 0x1a, 0xba, 0x76, 0x7c, 0xad, 0xfe, 0x8c, 0xc0, 0x6d, 0x13, 0xdc, 0xda, 0x25,
+	0x6e, 0xc8, 0x42, 0xbb, 0x59, 0x29, 0x7d, 0x49, 0x88, 0x7e, 0x7f, 0xc1, 0xc8, 0xed, 0xcc, 0xcd,
+	0x3c, 0xb1, 0x3e, 0xc0, 0xe8, 0x60, 0x90, 0xfb, 0xcf, 0x6e, 0xee, 0x3c, 0xe7, 0xf6, 0xce, 0x73,
+	0xfe, 0xde, 0x79, 0xce, 0xcf, 0x7b, 0xaf, 0x72, 0x7b, 0xef, 0x55, 0x7e, 0xdf, 0x7b, 0x95, 0xaf,
+	0xeb, 0xf8, 0xbc, 0x7f, 0xc7, 0xff, 0x86, 0x3f, 0x26, 0x2c, 0x19, 0x54, 0xe1, 0x81, 0x3f, 0xfc,
+	0x37, 0x00, 0x90, 0x3d, 0xc2, 0xfe, 0xb6, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +326,212 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReportList) > 0 {
+		for iNdEx := len(m.ReportList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.BannedChannelList) > 0 {
+		for iNdEx := len(m.BannedChannelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BannedChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.BannedAuthorList) > 0 {
+		for iNdEx := len(m.BannedAuthorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BannedAuthorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.ModerationActionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ModerationActionCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.ModerationActionList) > 0 {
+		for iNdEx := len(m.ModerationActionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModerationActionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ModeratorList) > 0 {
+		for iNdEx := len(m.ModeratorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModeratorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.InboundPostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InboundPostCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.InboundPostList) > 0 {
+		for iNdEx := len(m.InboundPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ReactionList) > 0 {
+		for iNdEx := len(m.ReactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.NotificationList) > 0 {
+		for iNdEx := len(m.NotificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PostRevisionList) > 0 {
+		for iNdEx := len(m.PostRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.CommentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommentCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CommentList) > 0 {
+		for iNdEx := len(m.CommentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.FailedPostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FailedPostCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FailedPostList) > 0 {
+		for iNdEx := len(m.FailedPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingPostList) > 0 {
+		for iNdEx := len(m.PendingPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.TimedoutPostCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TimedoutPostCount))
 		i--
@@ -207,143 +563,625 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PostList) > 0 {
+		for iNdEx := len(m.PostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PostList) > 0 {
+		for _, e := range m.PostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PostCount))
+	}
+	if len(m.SentPostList) > 0 {
+		for _, e := range m.SentPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SentPostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SentPostCount))
+	}
+	if len(m.TimedoutPostList) > 0 {
+		for _, e := range m.TimedoutPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimedoutPostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TimedoutPostCount))
+	}
+	if len(m.PendingPostList) > 0 {
+		for _, e := range m.PendingPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedPostList) > 0 {
+		for _, e := range m.FailedPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FailedPostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.FailedPostCount))
+	}
+	if len(m.CommentList) > 0 {
+		for _, e := range m.CommentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CommentCount))
+	}
+	if len(m.PostRevisionList) > 0 {
+		for _, e := range m.PostRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NotificationList) > 0 {
+		for _, e := range m.NotificationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReactionList) > 0 {
+		for _, e := range m.ReactionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InboundPostList) > 0 {
+		for _, e := range m.InboundPostList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InboundPostCount != 0 {
+		n += 2 + sovGenesis(uint64(m.InboundPostCount))
+	}
+	if len(m.ModeratorList) > 0 {
+		for _, e := range m.ModeratorList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ModerationActionList) > 0 {
+		for _, e := range m.ModerationActionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ModerationActionCount != 0 {
+		n += 2 + sovGenesis(uint64(m.ModerationActionCount))
+	}
+	if len(m.BannedAuthorList) > 0 {
+		for _, e := range m.BannedAuthorList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BannedChannelList) > 0 {
+		for _, e := range m.BannedChannelList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReportList) > 0 {
+		for _, e := range m.ReportList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostList = append(m.PostList, Post{})
+			if err := m.PostList[len(m.PostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+			}
+			m.PostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentPostList = append(m.SentPostList, SentPost{})
+			if err := m.SentPostList[len(m.SentPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPostCount", wireType)
+			}
+			m.SentPostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentPostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedoutPostList = append(m.TimedoutPostList, TimedoutPost{})
+			if err := m.TimedoutPostList[len(m.TimedoutPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPostCount", wireType)
+			}
+			m.TimedoutPostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedoutPostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPostList = append(m.PendingPostList, PendingPost{})
+			if err := m.PendingPostList[len(m.PendingPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedPostList = append(m.FailedPostList, FailedPost{})
+			if err := m.FailedPostList[len(m.FailedPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPostCount", wireType)
+			}
+			m.FailedPostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedPostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommentList = append(m.CommentList, Comment{})
+			if err := m.CommentList[len(m.CommentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentCount", wireType)
+			}
+			m.CommentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.PostCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PostCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PostList) > 0 {
-		for iNdEx := len(m.PostList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.PostList) > 0 {
-		for _, e := range m.PostList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.PostCount != 0 {
-		n += 1 + sovGenesis(uint64(m.PostCount))
-	}
-	if len(m.SentPostList) > 0 {
-		for _, e := range m.SentPostList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.SentPostCount != 0 {
-		n += 1 + sovGenesis(uint64(m.SentPostCount))
-	}
-	if len(m.TimedoutPostList) > 0 {
-		for _, e := range m.TimedoutPostList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.TimedoutPostCount != 0 {
-		n += 1 + sovGenesis(uint64(m.TimedoutPostCount))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PostRevisionList = append(m.PostRevisionList, PostRevision{})
+			if err := m.PostRevisionList[len(m.PostRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -370,15 +1208,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotificationList = append(m.NotificationList, Notification{})
+			if err := m.NotificationList[len(m.NotificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -388,27 +1227,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ReactionList = append(m.ReactionList, Reaction{})
+			if err := m.ReactionList[len(m.ReactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPostList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -435,16 +1276,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostList = append(m.PostList, Post{})
-			if err := m.PostList[len(m.PostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InboundPostList = append(m.InboundPostList, InboundPost{})
+			if err := m.InboundPostList[len(m.InboundPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPostCount", wireType)
 			}
-			m.PostCount = 0
+			m.InboundPostCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -454,14 +1295,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PostCount |= uint64(b&0x7F) << shift
+				m.InboundPostCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPostList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratorList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -488,16 +1329,50 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentPostList = append(m.SentPostList, SentPost{})
-			if err := m.SentPostList[len(m.SentPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ModeratorList = append(m.ModeratorList, Moderator{})
+			if err := m.ModeratorList[len(m.ModeratorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationActionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModerationActionList = append(m.ModerationActionList, ModerationAction{})
+			if err := m.ModerationActionList[len(m.ModerationActionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPostCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationActionCount", wireType)
 			}
-			m.SentPostCount = 0
+			m.ModerationActionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -507,14 +1382,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentPostCount |= uint64(b&0x7F) << shift
+				m.ModerationActionCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPostList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedAuthorList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -541,16 +1416,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimedoutPostList = append(m.TimedoutPostList, TimedoutPost{})
-			if err := m.TimedoutPostList[len(m.TimedoutPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BannedAuthorList = append(m.BannedAuthorList, BannedAuthor{})
+			if err := m.BannedAuthorList[len(m.BannedAuthorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPostCount", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedChannelList", wireType)
 			}
-			m.TimedoutPostCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -560,11 +1435,60 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimedoutPostCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedChannelList = append(m.BannedChannelList, BannedChannel{})
+			if err := m.BannedChannelList[len(m.BannedChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportList = append(m.ReportList, Report{})
+			if err := m.ReportList[len(m.ReportList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
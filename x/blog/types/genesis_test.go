@@ -21,6 +21,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				PostList: []types.Post{
 					{
@@ -49,6 +50,126 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				TimedoutPostCount: 2,
+				PendingPostList: []types.PendingPost{
+					{
+						Port:      "0",
+						ChannelID: "0",
+						Sequence:  0,
+					},
+					{
+						Port:      "1",
+						ChannelID: "1",
+						Sequence:  1,
+					},
+				},
+				FailedPostList: []types.FailedPost{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				FailedPostCount: 2,
+				CommentList: []types.Comment{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				CommentCount: 2,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   0,
+						Revision: 0,
+					},
+					{
+						PostID:   0,
+						Revision: 1,
+					},
+				},
+				NotificationList: []types.Notification{
+					{
+						Address: "A",
+						Id:      0,
+					},
+					{
+						Address: "A",
+						Id:      1,
+					},
+				},
+				ReactionList: []types.Reaction{
+					{
+						PostKind: types.CommentPostKindPost,
+						PostID:   0,
+						Reaction: types.ReactionLike,
+						Creator:  "A",
+					},
+					{
+						PostKind:     types.CommentPostKindSentPost,
+						PostID:       0,
+						Reaction:     types.ReactionLike,
+						RemoteAuthor: &types.RemoteAuthor{ChainID: "mars", Address: "A"},
+					},
+				},
+				InboundPostList: []types.InboundPost{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				InboundPostCount: 2,
+				ModeratorList: []types.Moderator{
+					{
+						Address: "A",
+					},
+					{
+						Address: "B",
+					},
+				},
+				ModerationActionList: []types.ModerationAction{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				ModerationActionCount: 2,
+				BannedAuthorList: []types.BannedAuthor{
+					{
+						ChannelID: "channel-0",
+						Address:   "A",
+					},
+					{
+						ChannelID: "channel-1",
+						Address:   "A",
+					},
+				},
+				BannedChannelList: []types.BannedChannel{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-1",
+					},
+				},
+				ReportList: []types.Report{
+					{
+						PostID:   0,
+						Reporter: "A",
+						Reason:   types.ReportReasonSpam,
+					},
+					{
+						PostID:   1,
+						Reporter: "A",
+						Reason:   types.ReportReasonSpam,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -131,6 +252,353 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated pendingPost",
+			genState: &types.GenesisState{
+				PendingPostList: []types.PendingPost{
+					{
+						Port:      "0",
+						ChannelID: "0",
+						Sequence:  0,
+					},
+					{
+						Port:      "0",
+						ChannelID: "0",
+						Sequence:  0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated failedPost",
+			genState: &types.GenesisState{
+				FailedPostList: []types.FailedPost{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid failedPost count",
+			genState: &types.GenesisState{
+				FailedPostList: []types.FailedPost{
+					{
+						Id: 1,
+					},
+				},
+				FailedPostCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid post tags",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				PostList: []types.Post{
+					{
+						Id:   0,
+						Tags: []string{"mars", "Mars"},
+					},
+				},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, 1, nil, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+				PortId: types.PortID,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed channel",
+			genState: &types.GenesisState{
+				Params: types.NewParams(1, 1, []string{"channel/0"}, nil, types.DefaultMaxIndexedTokens, types.DefaultMaxTags, types.DefaultMaxTagLength, types.DefaultMaxMentions, types.DefaultModeratedChannels, types.DefaultModerationExpiryBlocks, types.DefaultReportHideThreshold, types.DefaultRateLimitWindowBlocks, types.DefaultMaxSentPostsPerWindow, types.DefaultMaxReceivedPacketsPerWindow),
+				PortId: types.PortID,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated comment",
+			genState: &types.GenesisState{
+				CommentList: []types.Comment{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid comment count",
+			genState: &types.GenesisState{
+				CommentList: []types.Comment{
+					{
+						Id: 1,
+					},
+				},
+				CommentCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated postRevision",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   0,
+						Revision: 0,
+					},
+					{
+						PostID:   0,
+						Revision: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "postRevision of unknown post",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   1,
+						Revision: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated notification",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				NotificationList: []types.Notification{
+					{
+						Address: "A",
+						Id:      0,
+					},
+					{
+						Address: "A",
+						Id:      0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				ReactionList: []types.Reaction{
+					{
+						PostKind: types.CommentPostKindPost,
+						PostID:   0,
+						Reaction: types.ReactionLike,
+						Creator:  "A",
+					},
+					{
+						PostKind: types.CommentPostKindPost,
+						PostID:   0,
+						Reaction: types.ReactionSad,
+						Creator:  "A",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown reaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				ReactionList: []types.Reaction{
+					{
+						PostKind: types.CommentPostKindPost,
+						PostID:   0,
+						Reaction: "dislike",
+						Creator:  "A",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated inboundPost",
+			genState: &types.GenesisState{
+				InboundPostList: []types.InboundPost{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				InboundPostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid inboundPost count",
+			genState: &types.GenesisState{
+				InboundPostList: []types.InboundPost{
+					{
+						Id: 1,
+					},
+				},
+				InboundPostCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated moderator",
+			genState: &types.GenesisState{
+				ModeratorList: []types.Moderator{
+					{
+						Address: "A",
+					},
+					{
+						Address: "A",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated moderationAction",
+			genState: &types.GenesisState{
+				ModerationActionList: []types.ModerationAction{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				ModerationActionCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid moderationAction count",
+			genState: &types.GenesisState{
+				ModerationActionList: []types.ModerationAction{
+					{
+						Id: 1,
+					},
+				},
+				ModerationActionCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bannedAuthor",
+			genState: &types.GenesisState{
+				BannedAuthorList: []types.BannedAuthor{
+					{
+						ChannelID: "channel-0",
+						Address:   "A",
+					},
+					{
+						ChannelID: "channel-0",
+						Address:   "A",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bannedChannel",
+			genState: &types.GenesisState{
+				BannedChannelList: []types.BannedChannel{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated report",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				ReportList: []types.Report{
+					{
+						PostID:   0,
+						Reporter: "A",
+						Reason:   types.ReportReasonSpam,
+					},
+					{
+						PostID:   0,
+						Reporter: "A",
+						Reason:   types.ReportReasonOther,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "report of unknown post",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				ReportList: []types.Report{
+					{
+						PostID:   1,
+						Reporter: "A",
+						Reason:   types.ReportReasonSpam,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown report reason",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				ReportList: []types.Report{
+					{
+						PostID:   0,
+						Reporter: "A",
+						Reason:   "boring",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/inbound_post.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InboundPost is a post received on a moderated channel, queued until a moderator approves or rejects it. The
+// acknowledgement of its packet is written then.
+type InboundPost struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// packet is the received packet, its data is the post
+	Packet types.Packet      `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	Post   IbcPostPacketData `protobuf:"bytes,3,opt,name=post,proto3" json:"post"`
+	// chainID is the chain ID of the counterparty chain, resolved from the client of the channel
+	ChainID string `protobuf:"bytes,4,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// receivedAt is the unix time in seconds of the block the post was received in
+	ReceivedAt     int64 `protobuf:"varint,5,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"`
+	ReceivedHeight int64 `protobuf:"varint,6,opt,name=receivedHeight,proto3" json:"receivedHeight,omitempty"`
+	// expiresAt is the height of the block the post is rejected in if it isn't moderated before
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *InboundPost) Reset()         { *m = InboundPost{} }
+func (m *InboundPost) String() string { return proto.CompactTextString(m) }
+func (*InboundPost) ProtoMessage()    {}
+func (*InboundPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f72f7be4ea397e10, []int{0}
+}
+func (m *InboundPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundPost.Merge(m, src)
+}
+func (m *InboundPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundPost proto.InternalMessageInfo
+
+func (m *InboundPost) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InboundPost) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *InboundPost) GetPost() IbcPostPacketData {
+	if m != nil {
+		return m.Post
+	}
+	return IbcPostPacketData{}
+}
+
+func (m *InboundPost) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *InboundPost) GetReceivedAt() int64 {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return 0
+}
+
+func (m *InboundPost) GetReceivedHeight() int64 {
+	if m != nil {
+		return m.ReceivedHeight
+	}
+	return 0
+}
+
+func (m *InboundPost) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InboundPost)(nil), "planet.blog.InboundPost")
+}
+
+func init() { proto.RegisterFile("planet/blog/inbound_post.proto", fileDescriptor_f72f7be4ea397e10) }
+
+var fileDescriptor_f72f7be4ea397e10 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x18, 0x84, 0xe3, 0x34, 0xb4, 0xaa, 0x2b, 0x75, 0x30, 0x0c, 0x56, 0x41, 0x26, 0x30, 0xa0, 0x2c,
+	0x38, 0x2a, 0x2c, 0x30, 0xb6, 0xea, 0x40, 0xb7, 0x2a, 0x23, 0x0b, 0x4a, 0x1c, 0x2b, 0xb5, 0xa8,
+	0x6c, 0x2b, 0x31, 0x55, 0x79, 0x0b, 0xc4, 0x53, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xfb, 0x22, 0x28,
+	0x76, 0x22, 0x2a, 0x36, 0xff, 0xf7, 0x7f, 0xe7, 0xd3, 0x7f, 0x90, 0xe8, 0x55, 0x2a, 0xb9, 0x89,
+	0xb3, 0x95, 0x2a, 0x62, 0x21, 0x33, 0xf5, 0x26, 0xf3, 0x17, 0xad, 0x2a, 0x43, 0x75, 0xa9, 0x8c,
+	0x42, 0x03, 0xb7, 0xa7, 0xf5, 0x7e, 0x74, 0x56, 0xa8, 0x42, 0x59, 0x3d, 0xae, 0x5f, 0x0e, 0x19,
+	0x5d, 0x89, 0x8c, 0xc5, 0x4c, 0x95, 0x3c, 0x66, 0xcb, 0x54, 0x4a, 0xbe, 0x8a, 0xd7, 0xe3, 0xf6,
+	0xd9, 0x20, 0xf8, 0x38, 0x45, 0xa7, 0xec, 0x95, 0x37, 0xff, 0x5f, 0x7f, 0xfa, 0x70, 0x30, 0x77,
+	0xb1, 0x0b, 0x55, 0x19, 0x34, 0x84, 0xbe, 0xc8, 0x31, 0x08, 0x41, 0x14, 0x24, 0xbe, 0xc8, 0xd1,
+	0x23, 0xec, 0x3a, 0x1e, 0xfb, 0x21, 0x88, 0x06, 0x77, 0xe7, 0x54, 0x64, 0x8c, 0xd6, 0x69, 0xb4,
+	0x8d, 0x58, 0x8f, 0xe9, 0xc2, 0x22, 0xd3, 0x60, 0xfb, 0x7d, 0xe9, 0x25, 0x8d, 0x01, 0x3d, 0xc0,
+	0xa0, 0x3e, 0x04, 0x77, 0xac, 0x91, 0xd0, 0xa3, 0x4b, 0xe8, 0x3c, 0x63, 0x75, 0x9c, 0xf3, 0xcd,
+	0x52, 0x93, 0x36, 0x5e, 0xeb, 0x40, 0x18, 0xf6, 0xd8, 0x32, 0x15, 0x72, 0x3e, 0xc3, 0x41, 0x08,
+	0xa2, 0x7e, 0xd2, 0x8e, 0x88, 0x40, 0x58, 0x72, 0xc6, 0xc5, 0x9a, 0xe7, 0x13, 0x83, 0x4f, 0x42,
+	0x10, 0x75, 0x92, 0x23, 0x05, 0xdd, 0xc0, 0x61, 0x3b, 0x3d, 0x71, 0x51, 0x2c, 0x0d, 0xee, 0x5a,
+	0xe6, 0x9f, 0x8a, 0x2e, 0x60, 0x9f, 0x6f, 0xb4, 0x28, 0x79, 0x35, 0x31, 0xb8, 0x67, 0x91, 0x3f,
+	0x61, 0x7a, 0xbb, 0xdd, 0x13, 0xb0, 0xdb, 0x13, 0xf0, 0xb3, 0x27, 0xe0, 0xe3, 0x40, 0xbc, 0xdd,
+	0x81, 0x78, 0x5f, 0x07, 0xe2, 0x3d, 0x9f, 0x36, 0x45, 0x6e, 0x5c, 0x95, 0xe6, 0x5d, 0xf3, 0x2a,
+	0xeb, 0xda, 0x2a, 0xef, 0x7f, 0x07, 0x00, 0x9f, 0x2b, 0x19, 0x12, 0xcc, 0x01, 0x00, 0x00,
+}
+
+func (m *InboundPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintInboundPost(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReceivedHeight != 0 {
+		i = encodeVarintInboundPost(dAtA, i, uint64(m.ReceivedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReceivedAt != 0 {
+		i = encodeVarintInboundPost(dAtA, i, uint64(m.ReceivedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintInboundPost(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInboundPost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInboundPost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintInboundPost(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInboundPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInboundPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InboundPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInboundPost(uint64(m.Id))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovInboundPost(uint64(l))
+	l = m.Post.Size()
+	n += 1 + l + sovInboundPost(uint64(l))
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovInboundPost(uint64(l))
+	}
+	if m.ReceivedAt != 0 {
+		n += 1 + sovInboundPost(uint64(m.ReceivedAt))
+	}
+	if m.ReceivedHeight != 0 {
+		n += 1 + sovInboundPost(uint64(m.ReceivedHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovInboundPost(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovInboundPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInboundPost(x uint64) (n int) {
+	return sovInboundPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InboundPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInboundPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
+			}
+			m.ReceivedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedHeight", wireType)
+			}
+			m.ReceivedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInboundPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInboundPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInboundPost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInboundPost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInboundPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInboundPost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInboundPost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInboundPost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInboundPost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInboundPost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInboundPost = fmt.Errorf("proto: unexpected end of group")
+)
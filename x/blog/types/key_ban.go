@@ -0,0 +1,13 @@
+package types
+
+// BannedAuthorStoreKey returns the store key to retrieve a BannedAuthor from the channel and the address, the authors
+// banned on a channel share the key prefix returned by BannedAuthorChannelKey
+func BannedAuthorStoreKey(channelID string, address string) []byte {
+	return append(BannedAuthorChannelKey(channelID), address...)
+}
+
+// BannedAuthorChannelKey returns the store key prefix of the authors banned on a channel, length prefixed by
+// IndexKeyPrefix
+func BannedAuthorChannelKey(channelID string) []byte {
+	return IndexKeyPrefix(channelID)
+}
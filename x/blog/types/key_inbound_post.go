@@ -0,0 +1,9 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// InboundPostExpiryKey returns the key of the entry of the expiry index of an inboundPost, the entries are ordered
+// by expiry height then id
+func InboundPostExpiryKey(expiresAt int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiresAt)), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,20 @@
+package types
+
+import "encoding/binary"
+
+// IndexKeyPrefix returns the prefix of the secondary index entries of an indexed value.
+// The value is prefixed by its length, 8 bytes big endian, so that a value that is a prefix of another one, like
+// channel-1 and channel-10, never selects the entries of the other value when its entries are iterated. The other
+// keys scoped by a variable length string are built on it.
+func IndexKeyPrefix(value string) []byte {
+	key := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(key, uint64(len(value)))
+	return append(key, value...)
+}
+
+// IndexKey returns the key of the secondary index entry of an indexed value pointing to the id of a list element
+func IndexKey(value string, id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(IndexKeyPrefix(value), idBytes...)
+}
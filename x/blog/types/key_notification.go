@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+// NotificationKey returns the store key to retrieve a Notification from the address and the id, the notifications
+// of an address share the key prefix returned by NotificationAddressKey
+func NotificationKey(
+	address string,
+	id uint64,
+) []byte {
+	key := NotificationAddressKey(address)
+
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	key = append(key, idBytes...)
+
+	return key
+}
+
+// NotificationAddressKey returns the store key prefix of the notifications of an address, length prefixed by
+// IndexKeyPrefix
+func NotificationAddressKey(address string) []byte {
+	return IndexKeyPrefix(address)
+}
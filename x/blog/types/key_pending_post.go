@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PendingPostKeyPrefix is the prefix to retrieve all PendingPost
+	PendingPostKeyPrefix = "PendingPost/value/"
+)
+
+// PendingPostKey returns the store key to retrieve a PendingPost from the index fields
+func PendingPostKey(
+	port string,
+	channelID string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	portBytes := []byte(port)
+	key = append(key, portBytes...)
+	key = append(key, []byte("/")...)
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,31 @@
+package types
+
+import "encoding/binary"
+
+// PostRevisionKey returns the store key to retrieve a PostRevision from the post ID and the revision, the
+// revisions of a post share the key prefix returned by PostRevisionPostKey
+func PostRevisionKey(
+	postID uint64,
+	revision uint64,
+) []byte {
+	key := PostRevisionPostKey(postID)
+
+	revisionBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(revisionBytes, revision)
+	key = append(key, revisionBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PostRevisionPostKey returns the store key prefix of the revisions of a post
+func PostRevisionPostKey(postID uint64) []byte {
+	var key []byte
+
+	postIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(postIDBytes, postID)
+	key = append(key, postIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,17 @@
+package types
+
+import "encoding/binary"
+
+// RateLimitStoreKey returns the store key of the counter of an account or a channel in a rate limit window, the
+// counters of a window share the key prefix returned by RateLimitWindowKey
+func RateLimitStoreKey(windowStart uint64, value string) []byte {
+	return append(RateLimitWindowKey(windowStart), value...)
+}
+
+// RateLimitWindowKey returns the store key prefix of the counters of the rate limit window starting at a height, the
+// windows are ordered by height
+func RateLimitWindowKey(windowStart uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, windowStart)
+	return key
+}
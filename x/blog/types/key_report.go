@@ -0,0 +1,16 @@
+package types
+
+import "encoding/binary"
+
+// ReportStoreKey returns the store key to retrieve the report of a post by an account, the reports of a post share
+// the key prefix returned by ReportPostKey
+func ReportStoreKey(postID uint64, reporter string) []byte {
+	return append(ReportPostKey(postID), reporter...)
+}
+
+// ReportPostKey returns the store key prefix of the reports of a post, and the store key of their number
+func ReportPostKey(postID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, postID)
+	return key
+}
@@ -32,14 +32,119 @@ func KeyPrefix(p string) []byte {
 const (
 	PostKey      = "Post/value/"
 	PostCountKey = "Post/count/"
+
+	// PostByCreatorKey indexes the post ids by creator
+	PostByCreatorKey = "Post/creator/"
+	// PostBySourceChannelKey indexes the received post ids by source channel
+	PostBySourceChannelKey = "Post/sourceChannel/"
+	// PostByRemoteAuthorKey indexes the received post ids by counterparty chain ID and author address
+	PostByRemoteAuthorKey = "Post/remoteAuthor/"
+	// PostBySearchTokenKey indexes the post ids by the search tokens of their title and content
+	PostBySearchTokenKey = "Post/searchToken/"
+	// PostSearchTokensKey stores the search tokens a post is indexed with, by post id
+	PostSearchTokensKey = "Post/searchTokens/"
+	// PostByTagKey indexes the post ids by normalized tag, hashtags of the content included
+	PostByTagKey = "Post/tag/"
+	// PostTagsKey stores the tags a post is indexed with, by post id
+	PostTagsKey = "Post/tags/"
+)
+
+const (
+	// TagCountKey stores the number of posts indexed with a tag, by tag
+	TagCountKey = "Tag/count/"
+	// TagByCountKeyPrefix indexes the tags by number of posts, see TagByCountKey in tag.go for the key layout
+	TagByCountKeyPrefix = "Tag/byCount/"
 )
 
 const (
 	SentPostKey      = "SentPost/value/"
 	SentPostCountKey = "SentPost/count/"
+
+	// SentPostByCreatorKey indexes the sentPost ids by creator
+	SentPostByCreatorKey = "SentPost/creator/"
+	// SentPostByRemotePostKey indexes the sentPost ids by destination and ID of the post on the destination chain
+	SentPostByRemotePostKey = "SentPost/remotePost/"
 )
 
 const (
 	TimedoutPostKey      = "TimedoutPost/value/"
 	TimedoutPostCountKey = "TimedoutPost/count/"
+
+	// TimedoutPostByCreatorKey indexes the timedoutPost ids by creator
+	TimedoutPostByCreatorKey = "TimedoutPost/creator/"
+)
+
+const (
+	FailedPostKey      = "FailedPost/value/"
+	FailedPostCountKey = "FailedPost/count/"
+)
+
+const (
+	CommentKey      = "Comment/value/"
+	CommentCountKey = "Comment/count/"
+
+	// CommentByThreadKey indexes the comment ids by post
+	CommentByThreadKey = "Comment/thread/"
+	// CommentByPacketKey maps the packet of a pending comment to the comment id
+	CommentByPacketKey = "Comment/packet/"
+)
+
+const (
+	// PostRevisionKeyPrefix is the prefix to retrieve all PostRevision
+	PostRevisionKeyPrefix = "PostRevision/value/"
+)
+
+const (
+	// NotificationKeyPrefix is the prefix to retrieve all Notification
+	NotificationKeyPrefix = "Notification/value/"
+	// NotificationCountKey stores the number of notifications of an address, by address
+	NotificationCountKey = "Notification/count/"
+	// NotificationUnreadKey indexes the ids of the unread notifications by address
+	NotificationUnreadKey = "Notification/unread/"
+)
+
+const (
+	// ReactionKeyPrefix is the prefix to retrieve all Reaction
+	ReactionKeyPrefix = "Reaction/value/"
+	// ReactionCountKey stores the number of reactions to a post, by post and type of reaction
+	ReactionCountKey = "Reaction/count/"
+)
+
+const (
+	InboundPostKey      = "InboundPost/value/"
+	InboundPostCountKey = "InboundPost/count/"
+
+	// InboundPostByExpiryKey indexes the inboundPost ids by expiry height
+	InboundPostByExpiryKey = "InboundPost/expiry/"
+)
+
+const (
+	// ModeratorKey stores the moderators by address
+	ModeratorKey = "Moderator/value/"
+)
+
+const (
+	ModerationActionKey      = "ModerationAction/value/"
+	ModerationActionCountKey = "ModerationAction/count/"
+)
+
+const (
+	// BannedAuthorKey stores the banned authors of other chains by channel and address
+	BannedAuthorKey = "BannedAuthor/value/"
+	// BannedChannelKey stores the banned channels by channel
+	BannedChannelKey = "BannedChannel/value/"
+)
+
+const (
+	// ReportKey stores the open reports by post and reporter
+	ReportKey = "Report/value/"
+	// ReportCountKey stores the number of open reports of a post, by post id, the reported posts are listed from it
+	ReportCountKey = "Report/count/"
+)
+
+const (
+	// RateLimitSenderKey stores the number of posts sent by an account, by rate limit window and address
+	RateLimitSenderKey = "RateLimit/sender/"
+	// RateLimitChannelKey stores the number of packets accepted on a channel, by rate limit window and channel
+	RateLimitChannelKey = "RateLimit/channel/"
 )
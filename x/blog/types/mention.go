@@ -0,0 +1,35 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mentionPattern matches the mentions of a text, a mention is an address prefixed by @ starting a word
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([a-z0-9]+1[a-z0-9]+)`)
+
+// ExtractMentions returns the distinct account addresses of this chain mentioned in texts in order of first
+// occurrence, at most maxMentions addresses are returned. Mentions of invalid addresses or of addresses of
+// other chains are skipped.
+func ExtractMentions(maxMentions uint64, texts ...string) []string {
+	var mentions []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+			if uint64(len(mentions)) >= maxMentions {
+				return mentions
+			}
+			address, err := sdk.AccAddressFromBech32(match[1])
+			if err != nil {
+				continue
+			}
+			mention := address.String()
+			if !seen[mention] {
+				seen[mention] = true
+				mentions = append(mentions, mention)
+			}
+		}
+	}
+	return mentions
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestExtractMentions(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	for _, tc := range []struct {
+		name        string
+		maxMentions uint64
+		texts       []string
+		mentions    []string
+	}{
+		{
+			name:        "no mention",
+			maxMentions: 10,
+			texts:       []string{"hello " + alice},
+		},
+		{
+			name:        "distinct mentions across texts",
+			maxMentions: 10,
+			texts:       []string{fmt.Sprintf("hello @%s", alice), fmt.Sprintf("@%s, @%s!", bob, alice)},
+			mentions:    []string{alice, bob},
+		},
+		{
+			name:        "invalid addresses",
+			maxMentions: 10,
+			texts:       []string{"@cosmos1invalid @osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq @" + alice[:len(alice)-1]},
+		},
+		{
+			name:        "not starting a word",
+			maxMentions: 10,
+			texts:       []string{fmt.Sprintf("mail@%s @@%s", alice, bob)},
+		},
+		{
+			name:        "max mentions",
+			maxMentions: 1,
+			texts:       []string{fmt.Sprintf("@%s @%s", alice, bob)},
+			mentions:    []string{alice},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.mentions, ExtractMentions(tc.maxMentions, tc.texts...))
+		})
+	}
+}
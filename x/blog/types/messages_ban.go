@@ -0,0 +1,196 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const (
+	TypeMsgBanAuthor    = "ban_author"
+	TypeMsgUnbanAuthor  = "unban_author"
+	TypeMsgBanChannel   = "ban_channel"
+	TypeMsgUnbanChannel = "unban_channel"
+)
+
+var _ sdk.Msg = &MsgBanAuthor{}
+
+func NewMsgBanAuthor(creator string, channelID string, address string, reason string) *MsgBanAuthor {
+	return &MsgBanAuthor{
+		Creator:   creator,
+		ChannelID: channelID,
+		Address:   address,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgBanAuthor) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBanAuthor) Type() string {
+	return TypeMsgBanAuthor
+}
+
+func (msg *MsgBanAuthor) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBanAuthor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBanAuthor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel (%s)", err)
+	}
+	if msg.Address == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty author address")
+	}
+	if msg.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a reason is required to ban")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnbanAuthor{}
+
+func NewMsgUnbanAuthor(creator string, channelID string, address string, reason string) *MsgUnbanAuthor {
+	return &MsgUnbanAuthor{
+		Creator:   creator,
+		ChannelID: channelID,
+		Address:   address,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgUnbanAuthor) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnbanAuthor) Type() string {
+	return TypeMsgUnbanAuthor
+}
+
+func (msg *MsgUnbanAuthor) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnbanAuthor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnbanAuthor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel (%s)", err)
+	}
+	if msg.Address == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty author address")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgBanChannel{}
+
+func NewMsgBanChannel(creator string, channelID string, reason string) *MsgBanChannel {
+	return &MsgBanChannel{
+		Creator:   creator,
+		ChannelID: channelID,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgBanChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBanChannel) Type() string {
+	return TypeMsgBanChannel
+}
+
+func (msg *MsgBanChannel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBanChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBanChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel (%s)", err)
+	}
+	if msg.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a reason is required to ban")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnbanChannel{}
+
+func NewMsgUnbanChannel(creator string, channelID string, reason string) *MsgUnbanChannel {
+	return &MsgUnbanChannel{
+		Creator:   creator,
+		ChannelID: channelID,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgUnbanChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnbanChannel) Type() string {
+	return TypeMsgUnbanChannel
+}
+
+func (msg *MsgUnbanChannel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnbanChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnbanChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgBanAuthor_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBanAuthor
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBanAuthor{
+				Creator:   "invalid_address",
+				ChannelID: "channel-0",
+				Address:   "A",
+				Reason:    "spam",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgBanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "c",
+				Address:   "A",
+				Reason:    "spam",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty author",
+			msg: MsgBanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Reason:    "spam",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no reason",
+			msg: MsgBanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Address:   "A",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgBanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Address:   "A",
+				Reason:    "spam",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnbanAuthor_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnbanAuthor
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnbanAuthor{
+				Creator:   "invalid_address",
+				ChannelID: "channel-0",
+				Address:   "A",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty author",
+			msg: MsgUnbanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgUnbanAuthor{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Address:   "A",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBanChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBanChannel
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBanChannel{
+				Creator:   "invalid_address",
+				ChannelID: "channel-0",
+				Reason:    "spam",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgBanChannel{
+				Creator: sample.AccAddress(),
+				Reason:  "spam",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no reason",
+			msg: MsgBanChannel{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgBanChannel{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Reason:    "spam",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnbanChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnbanChannel
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnbanChannel{
+				Creator:   "invalid_address",
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgUnbanChannel{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const TypeMsgBroadcastIbcPost = "broadcast_ibc_post"
+
+var _ sdk.Msg = &MsgBroadcastIbcPost{}
+
+func NewMsgBroadcastIbcPost(
+	creator string,
+	destinations []IbcPostDestination,
+	allChannels bool,
+	timeoutTimestamp uint64,
+	title string,
+	content string,
+	tags []string,
+) *MsgBroadcastIbcPost {
+	return &MsgBroadcastIbcPost{
+		Creator:          creator,
+		Destinations:     destinations,
+		AllChannels:      allChannels,
+		TimeoutTimestamp: timeoutTimestamp,
+		Title:            title,
+		Content:          content,
+		Tags:             tags,
+	}
+}
+
+func (msg *MsgBroadcastIbcPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBroadcastIbcPost) Type() string {
+	return TypeMsgBroadcastIbcPost
+}
+
+func (msg *MsgBroadcastIbcPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBroadcastIbcPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBroadcastIbcPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.AllChannels == (len(msg.Destinations) != 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either destinations or all channels must be set")
+	}
+	destinations := make(map[IbcPostDestination]struct{}, len(msg.Destinations))
+	for _, destination := range msg.Destinations {
+		if err := host.PortIdentifierValidator(destination.Port); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet port (%s)", err)
+		}
+		if err := host.ChannelIdentifierValidator(destination.ChannelID); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet channel (%s)", err)
+		}
+		if _, found := destinations[destination]; found {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated destination %s/%s", destination.Port, destination.ChannelID)
+		}
+		destinations[destination] = struct{}{}
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if msg.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post title")
+	}
+	if err := ValidatePostLengthLimits(msg.Title, msg.Content); err != nil {
+		return sdkerrors.Wrap(ErrPostTooLong, err.Error())
+	}
+	if err := ValidateTags(msg.Tags); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTags, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgBroadcastIbcPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBroadcastIbcPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBroadcastIbcPost{
+				Creator:          "invalid_address",
+				AllChannels:      true,
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no destination",
+			msg: MsgBroadcastIbcPost{
+				Creator:          sample.AccAddress(),
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "destinations and all channels",
+			msg: MsgBroadcastIbcPost{
+				Creator:          sample.AccAddress(),
+				Destinations:     []IbcPostDestination{{Port: "port", ChannelID: "channel-0"}},
+				AllChannels:      true,
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgBroadcastIbcPost{
+				Creator:          sample.AccAddress(),
+				Destinations:     []IbcPostDestination{{Port: "port", ChannelID: ""}},
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicated destination",
+			msg: MsgBroadcastIbcPost{
+				Creator: sample.AccAddress(),
+				Destinations: []IbcPostDestination{
+					{Port: "port", ChannelID: "channel-0"},
+					{Port: "port", ChannelID: "channel-0"},
+				},
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg: MsgBroadcastIbcPost{
+				Creator:     sample.AccAddress(),
+				AllChannels: true,
+				Title:       "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "post too long",
+			msg: MsgBroadcastIbcPost{
+				Creator:          sample.AccAddress(),
+				AllChannels:      true,
+				TimeoutTimestamp: 100,
+				Title:            string(make([]byte, MaxTitleLengthLimit+1)),
+			},
+			err: ErrPostTooLong,
+		}, {
+			name: "invalid tag",
+			msg: MsgBroadcastIbcPost{
+				Creator:          sample.AccAddress(),
+				AllChannels:      true,
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Tags:             []string{"mars", "venus express"},
+			},
+			err: ErrInvalidTags,
+		}, {
+			name: "valid message",
+			msg: MsgBroadcastIbcPost{
+				Creator: sample.AccAddress(),
+				Destinations: []IbcPostDestination{
+					{Port: "port", ChannelID: "channel-0"},
+					{Port: "port", ChannelID: "channel-1"},
+				},
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Tags:             []string{"mars", "venus"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
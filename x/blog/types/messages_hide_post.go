@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgHidePost   = "hide_post"
+	TypeMsgUnhidePost = "unhide_post"
+)
+
+var _ sdk.Msg = &MsgHidePost{}
+
+func NewMsgHidePost(creator string, id uint64, reason string) *MsgHidePost {
+	return &MsgHidePost{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgHidePost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgHidePost) Type() string {
+	return TypeMsgHidePost
+}
+
+func (msg *MsgHidePost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgHidePost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgHidePost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a reason is required to hide a post")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnhidePost{}
+
+func NewMsgUnhidePost(creator string, id uint64, reason string) *MsgUnhidePost {
+	return &MsgUnhidePost{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgUnhidePost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnhidePost) Type() string {
+	return TypeMsgUnhidePost
+}
+
+func (msg *MsgUnhidePost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnhidePost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnhidePost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
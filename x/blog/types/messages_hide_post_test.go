@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgHidePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgHidePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgHidePost{
+				Creator: "invalid_address",
+				Reason:  "spam",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no reason",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+				Reason:  "spam",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnhidePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnhidePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnhidePost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUnhidePost{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 const TypeMsgSendIbcPost = "send_ibc_post"
@@ -13,17 +15,21 @@ func NewMsgSendIbcPost(
 	creator string,
 	port string,
 	channelID string,
+	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	title string,
 	content string,
+	tags []string,
 ) *MsgSendIbcPost {
 	return &MsgSendIbcPost{
 		Creator:          creator,
 		Port:             port,
 		ChannelID:        channelID,
+		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
 		Title:            title,
 		Content:          content,
+		Tags:             tags,
 	}
 }
 
@@ -53,14 +59,23 @@ func (msg *MsgSendIbcPost) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	if msg.Port == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	if err := host.PortIdentifierValidator(msg.Port); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet port (%s)", err)
 	}
-	if msg.ChannelID == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet channel (%s)", err)
 	}
-	if msg.TimeoutTimestamp == 0 {
+	if msg.TimeoutTimestamp == 0 && msg.TimeoutHeight.IsZero() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
 	}
+	if msg.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post title")
+	}
+	if err := ValidatePostLengthLimits(msg.Title, msg.Content); err != nil {
+		return sdkerrors.Wrap(ErrPostTooLong, err.Error())
+	}
+	if err := ValidateTags(msg.Tags); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTags, err.Error())
+	}
 	return nil
 }
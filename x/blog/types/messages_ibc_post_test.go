@@ -1,9 +1,11 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	"github.com/stretchr/testify/require"
 	"planet/testutil/sample"
 )
@@ -50,6 +52,65 @@ func TestMsgSendIbcPost_ValidateBasic(t *testing.T) {
 				TimeoutTimestamp: 0,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel identifier",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "c/0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty title",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "title too long",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            strings.Repeat("a", int(MaxTitleLengthLimit)+1),
+			},
+			err: ErrPostTooLong,
+		}, {
+			name: "content too long",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Content:          strings.Repeat("a", int(MaxContentLengthLimit)+1),
+			},
+			err: ErrPostTooLong,
+		}, {
+			name: "non ascii title at the limit",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            strings.Repeat("é", int(MaxTitleLengthLimit)),
+			},
+		}, {
+			name: "non ascii title too long",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            strings.Repeat("é", int(MaxTitleLengthLimit)+1),
+			},
+			err: ErrPostTooLong,
 		}, {
 			name: "valid message",
 			msg: MsgSendIbcPost{
@@ -57,6 +118,58 @@ func TestMsgSendIbcPost_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				Title:            "title",
+			},
+		}, {
+			name: "invalid tag",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Tags:             []string{"mars", "venus express"},
+			},
+			err: ErrInvalidTags,
+		}, {
+			name: "duplicated tag",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Tags:             []string{"mars", "Mars"},
+			},
+			err: ErrInvalidTags,
+		}, {
+			name: "valid tags",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Tags:             []string{"mars", "venus_express"},
+			},
+		}, {
+			name: "valid timeout height",
+			msg: MsgSendIbcPost{
+				Creator:       sample.AccAddress(),
+				Port:          "port",
+				ChannelID:     "channel-0",
+				TimeoutHeight: clienttypes.NewHeight(0, 100),
+				Title:         "title",
+			},
+		}, {
+			name: "valid timeout height and timestamp",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutHeight:    clienttypes.NewHeight(0, 100),
+				TimeoutTimestamp: 100,
+				Title:            "title",
 			},
 		},
 	}
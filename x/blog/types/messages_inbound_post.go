@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgApproveInboundPost = "approve_inbound_post"
+	TypeMsgRejectInboundPost  = "reject_inbound_post"
+)
+
+var _ sdk.Msg = &MsgApproveInboundPost{}
+
+func NewMsgApproveInboundPost(creator string, id uint64) *MsgApproveInboundPost {
+	return &MsgApproveInboundPost{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgApproveInboundPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveInboundPost) Type() string {
+	return TypeMsgApproveInboundPost
+}
+
+func (msg *MsgApproveInboundPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveInboundPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveInboundPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRejectInboundPost{}
+
+func NewMsgRejectInboundPost(creator string, id uint64, reason string) *MsgRejectInboundPost {
+	return &MsgRejectInboundPost{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgRejectInboundPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRejectInboundPost) Type() string {
+	return TypeMsgRejectInboundPost
+}
+
+func (msg *MsgRejectInboundPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRejectInboundPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRejectInboundPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgApproveInboundPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgApproveInboundPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgApproveInboundPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgApproveInboundPost{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRejectInboundPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRejectInboundPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRejectInboundPost{
+				Creator: "invalid_address",
+				Reason:  "spam",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRejectInboundPost{
+				Creator: sample.AccAddress(),
+				Reason:  "spam",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgMarkNotificationsRead = "mark_notifications_read"
+
+var _ sdk.Msg = &MsgMarkNotificationsRead{}
+
+func NewMsgMarkNotificationsRead(creator string, ids []uint64) *MsgMarkNotificationsRead {
+	return &MsgMarkNotificationsRead{
+		Creator: creator,
+		Ids:     ids,
+	}
+}
+
+func (msg *MsgMarkNotificationsRead) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMarkNotificationsRead) Type() string {
+	return TypeMsgMarkNotificationsRead
+}
+
+func (msg *MsgMarkNotificationsRead) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMarkNotificationsRead) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMarkNotificationsRead) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	seen := make(map[uint64]bool)
+	for _, id := range msg.Ids {
+		if seen[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated notification id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
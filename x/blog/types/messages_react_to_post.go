@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReactToPost = "react_to_post"
+
+var _ sdk.Msg = &MsgReactToPost{}
+
+func NewMsgReactToPost(creator string, postID uint64, reaction string) *MsgReactToPost {
+	return &MsgReactToPost{
+		Creator:  creator,
+		PostID:   postID,
+		Reaction: reaction,
+	}
+}
+
+func (msg *MsgReactToPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReactToPost) Type() string {
+	return TypeMsgReactToPost
+}
+
+func (msg *MsgReactToPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReactToPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReactToPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !IsValidReaction(msg.Reaction) {
+		return sdkerrors.Wrapf(ErrInvalidReaction, "unknown reaction %s", msg.Reaction)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgReactToPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReactToPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReactToPost{
+				Creator:  "invalid_address",
+				Reaction: ReactionLike,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty reaction",
+			msg: MsgReactToPost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidReaction,
+		}, {
+			name: "unknown reaction",
+			msg: MsgReactToPost{
+				Creator:  sample.AccAddress(),
+				Reaction: "Like",
+			},
+			err: ErrInvalidReaction,
+		}, {
+			name: "valid message",
+			msg: MsgReactToPost{
+				Creator:  sample.AccAddress(),
+				PostID:   1,
+				Reaction: ReactionLove,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReportPost = "report_post"
+
+var _ sdk.Msg = &MsgReportPost{}
+
+func NewMsgReportPost(creator string, id uint64, reason string) *MsgReportPost {
+	return &MsgReportPost{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgReportPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReportPost) Type() string {
+	return TypeMsgReportPost
+}
+
+func (msg *MsgReportPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReportPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReportPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !IsValidReportReason(msg.Reason) {
+		return sdkerrors.Wrapf(ErrInvalidReportReason, "unknown report reason %s", msg.Reason)
+	}
+	return nil
+}
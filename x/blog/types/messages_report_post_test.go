@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgReportPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReportPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReportPost{
+				Creator: "invalid_address",
+				Reason:  ReportReasonSpam,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty reason",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidReportReason,
+		}, {
+			name: "unknown reason",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+				Reason:  "boring",
+			},
+			err: ErrInvalidReportReason,
+		}, {
+			name: "valid message",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+				Id:      1,
+				Reason:  ReportReasonAbuse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
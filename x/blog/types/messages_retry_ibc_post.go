@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const TypeMsgRetryIbcPost = "retry_ibc_post"
+
+const (
+	// RetryKindTimedout retries a TimedoutPost
+	RetryKindTimedout = "timedout"
+	// RetryKindFailed retries a FailedPost
+	RetryKindFailed = "failed"
+)
+
+var _ sdk.Msg = &MsgRetryIbcPost{}
+
+func NewMsgRetryIbcPost(
+	creator string,
+	kind string,
+	id uint64,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+) *MsgRetryIbcPost {
+	return &MsgRetryIbcPost{
+		Creator:          creator,
+		Kind:             kind,
+		Id:               id,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgRetryIbcPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryIbcPost) Type() string {
+	return TypeMsgRetryIbcPost
+}
+
+func (msg *MsgRetryIbcPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryIbcPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryIbcPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Kind != RetryKindTimedout && msg.Kind != RetryKindFailed {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid retry kind (%s)", msg.Kind)
+	}
+	if err := host.PortIdentifierValidator(msg.Port); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet port (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid packet channel (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgRetryIbcPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRetryIbcPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRetryIbcPost{
+				Creator:          "invalid_address",
+				Kind:             RetryKindTimedout,
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid kind",
+			msg: MsgRetryIbcPost{
+				Creator:          sample.AccAddress(),
+				Kind:             "sent",
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgRetryIbcPost{
+				Creator:          sample.AccAddress(),
+				Kind:             RetryKindFailed,
+				Port:             "port",
+				ChannelID:        "",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg: MsgRetryIbcPost{
+				Creator:   sample.AccAddress(),
+				Kind:      RetryKindFailed,
+				Port:      "port",
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgRetryIbcPost{
+				Creator:          sample.AccAddress(),
+				Kind:             RetryKindTimedout,
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
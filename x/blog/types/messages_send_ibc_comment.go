@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendIbcComment = "send_ibc_comment"
+
+var _ sdk.Msg = &MsgSendIbcComment{}
+
+func NewMsgSendIbcComment(
+	creator string,
+	postID uint64,
+	content string,
+	timeoutTimestamp uint64,
+) *MsgSendIbcComment {
+	return &MsgSendIbcComment{
+		Creator:          creator,
+		PostID:           postID,
+		Content:          content,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendIbcComment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendIbcComment) Type() string {
+	return TypeMsgSendIbcComment
+}
+
+func (msg *MsgSendIbcComment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendIbcComment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendIbcComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if msg.Content == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty comment content")
+	}
+	return nil
+}
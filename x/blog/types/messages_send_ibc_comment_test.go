@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendIbcComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendIbcComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendIbcComment{
+				Creator:          "invalid_address",
+				Content:          "content",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid timeout",
+			msg: MsgSendIbcComment{
+				Creator: sample.AccAddress(),
+				Content: "content",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty content",
+			msg: MsgSendIbcComment{
+				Creator:          sample.AccAddress(),
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSendIbcComment{
+				Creator:          sample.AccAddress(),
+				PostID:           1,
+				Content:          "content",
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendIbcDeletePost = "send_ibc_delete_post"
+
+var _ sdk.Msg = &MsgSendIbcDeletePost{}
+
+func NewMsgSendIbcDeletePost(
+	creator string,
+	id uint64,
+	timeoutTimestamp uint64,
+) *MsgSendIbcDeletePost {
+	return &MsgSendIbcDeletePost{
+		Creator:          creator,
+		Id:               id,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendIbcDeletePost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendIbcDeletePost) Type() string {
+	return TypeMsgSendIbcDeletePost
+}
+
+func (msg *MsgSendIbcDeletePost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendIbcDeletePost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendIbcDeletePost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	return nil
+}
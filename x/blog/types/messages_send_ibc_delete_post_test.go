@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendIbcDeletePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendIbcDeletePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendIbcDeletePost{
+				Creator:          "invalid_address",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid timeout",
+			msg: MsgSendIbcDeletePost{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSendIbcDeletePost{
+				Creator:          sample.AccAddress(),
+				Id:               1,
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
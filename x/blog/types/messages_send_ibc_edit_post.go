@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendIbcEditPost = "send_ibc_edit_post"
+
+var _ sdk.Msg = &MsgSendIbcEditPost{}
+
+func NewMsgSendIbcEditPost(
+	creator string,
+	id uint64,
+	title string,
+	content string,
+	timeoutTimestamp uint64,
+) *MsgSendIbcEditPost {
+	return &MsgSendIbcEditPost{
+		Creator:          creator,
+		Id:               id,
+		Title:            title,
+		Content:          content,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendIbcEditPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendIbcEditPost) Type() string {
+	return TypeMsgSendIbcEditPost
+}
+
+func (msg *MsgSendIbcEditPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendIbcEditPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendIbcEditPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if msg.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post title")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendIbcEditPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendIbcEditPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendIbcEditPost{
+				Creator:          "invalid_address",
+				Title:            "title",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid timeout",
+			msg: MsgSendIbcEditPost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty title",
+			msg: MsgSendIbcEditPost{
+				Creator:          sample.AccAddress(),
+				Content:          "content",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSendIbcEditPost{
+				Creator:          sample.AccAddress(),
+				Id:               1,
+				Title:            "title",
+				Content:          "content",
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendIbcReaction = "send_ibc_reaction"
+
+var _ sdk.Msg = &MsgSendIbcReaction{}
+
+func NewMsgSendIbcReaction(
+	creator string,
+	postID uint64,
+	reaction string,
+	timeoutTimestamp uint64,
+) *MsgSendIbcReaction {
+	return &MsgSendIbcReaction{
+		Creator:          creator,
+		PostID:           postID,
+		Reaction:         reaction,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendIbcReaction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendIbcReaction) Type() string {
+	return TypeMsgSendIbcReaction
+}
+
+func (msg *MsgSendIbcReaction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendIbcReaction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendIbcReaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	if !IsValidReaction(msg.Reaction) {
+		return sdkerrors.Wrapf(ErrInvalidReaction, "unknown reaction %s", msg.Reaction)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendIbcReaction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendIbcReaction
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendIbcReaction{
+				Creator:          "invalid_address",
+				Reaction:         ReactionLike,
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid timeout",
+			msg: MsgSendIbcReaction{
+				Creator:  sample.AccAddress(),
+				Reaction: ReactionLike,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown reaction",
+			msg: MsgSendIbcReaction{
+				Creator:          sample.AccAddress(),
+				Reaction:         "dislike",
+				TimeoutTimestamp: 100,
+			},
+			err: ErrInvalidReaction,
+		}, {
+			name: "valid message",
+			msg: MsgSendIbcReaction{
+				Creator:          sample.AccAddress(),
+				PostID:           1,
+				Reaction:         ReactionLike,
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateModerators = "update_moderators"
+
+var _ sdk.Msg = &MsgUpdateModerators{}
+
+func NewMsgUpdateModerators(authority string, add []string, remove []string) *MsgUpdateModerators {
+	return &MsgUpdateModerators{
+		Authority: authority,
+		Add:       add,
+		Remove:    remove,
+	}
+}
+
+func (msg *MsgUpdateModerators) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateModerators) Type() string {
+	return TypeMsgUpdateModerators
+}
+
+func (msg *MsgUpdateModerators) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateModerators) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateModerators) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no moderator to add or remove")
+	}
+
+	// An address can't be both added and removed, the result would depend on the order of the changes
+	seen := make(map[string]bool)
+	for _, address := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid moderator address (%s)", err)
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated moderator address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
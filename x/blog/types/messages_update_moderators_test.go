@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgUpdateModerators_ValidateBasic(t *testing.T) {
+	moderator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgUpdateModerators
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgUpdateModerators{
+				Authority: "invalid_address",
+				Add:       []string{moderator},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no change",
+			msg: MsgUpdateModerators{
+				Authority: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid moderator",
+			msg: MsgUpdateModerators{
+				Authority: sample.AccAddress(),
+				Remove:    []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "added and removed",
+			msg: MsgUpdateModerators{
+				Authority: sample.AccAddress(),
+				Add:       []string{moderator},
+				Remove:    []string{moderator},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUpdateModerators{
+				Authority: sample.AccAddress(),
+				Add:       []string{moderator},
+				Remove:    []string{sample.AccAddress()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    DefaultParams(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    NewParams(0, DefaultMaxContentLength, nil, nil, DefaultMaxIndexedTokens, DefaultMaxTags, DefaultMaxTagLength, DefaultMaxMentions, DefaultModeratedChannels, DefaultModerationExpiryBlocks, DefaultReportHideThreshold, DefaultRateLimitWindowBlocks, DefaultMaxSentPostsPerWindow, DefaultMaxReceivedPacketsPerWindow),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "content length over the limit",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    NewParams(DefaultMaxTitleLength, MaxContentLengthLimit+1, nil, nil, DefaultMaxIndexedTokens, DefaultMaxTags, DefaultMaxTagLength, DefaultMaxMentions, DefaultModeratedChannels, DefaultModerationExpiryBlocks, DefaultReportHideThreshold, DefaultRateLimitWindowBlocks, DefaultMaxSentPostsPerWindow, DefaultMaxReceivedPacketsPerWindow),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero rate limit window",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    NewParams(DefaultMaxTitleLength, DefaultMaxContentLength, nil, nil, DefaultMaxIndexedTokens, DefaultMaxTags, DefaultMaxTagLength, DefaultMaxMentions, DefaultModeratedChannels, DefaultModerationExpiryBlocks, DefaultReportHideThreshold, 0, DefaultMaxSentPostsPerWindow, DefaultMaxReceivedPacketsPerWindow),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    DefaultParams(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
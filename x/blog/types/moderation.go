@@ -0,0 +1,21 @@
+package types
+
+// The actions recorded in the moderation log
+const (
+	ModerationActionAddModerator       = "add_moderator"
+	ModerationActionRemoveModerator    = "remove_moderator"
+	ModerationActionApproveInboundPost = "approve_inbound_post"
+	ModerationActionRejectInboundPost  = "reject_inbound_post"
+	ModerationActionHidePost           = "hide_post"
+	ModerationActionUnhidePost         = "unhide_post"
+	ModerationActionAutoHidePost       = "auto_hide_post"
+	ModerationActionBanAuthor          = "ban_author"
+	ModerationActionUnbanAuthor        = "unban_author"
+	ModerationActionBanChannel         = "ban_channel"
+	ModerationActionUnbanChannel       = "unban_channel"
+)
+
+// BannedAuthorTarget returns the target of the moderation actions on a banned author
+func BannedAuthorTarget(channelID string, address string) string {
+	return channelID + "/" + address
+}
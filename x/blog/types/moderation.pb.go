@@ -0,0 +1,879 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/moderation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Moderator is an account allowed to moderate posts, the set of moderators is changed by the module authority
+type Moderator struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// addedAt is the unix time in seconds of the block the moderator was added in
+	AddedAt     int64 `protobuf:"varint,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	AddedHeight int64 `protobuf:"varint,3,opt,name=addedHeight,proto3" json:"addedHeight,omitempty"`
+}
+
+func (m *Moderator) Reset()         { *m = Moderator{} }
+func (m *Moderator) String() string { return proto.CompactTextString(m) }
+func (*Moderator) ProtoMessage()    {}
+func (*Moderator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b724721665ed6d8, []int{0}
+}
+func (m *Moderator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Moderator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Moderator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Moderator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Moderator.Merge(m, src)
+}
+func (m *Moderator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Moderator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Moderator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Moderator proto.InternalMessageInfo
+
+func (m *Moderator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Moderator) GetAddedAt() int64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
+func (m *Moderator) GetAddedHeight() int64 {
+	if m != nil {
+		return m.AddedHeight
+	}
+	return 0
+}
+
+// ModerationAction is an entry of the on-chain log of the moderation actions, the entries are numbered from 0
+type ModerationAction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// moderator is the address of the moderator, or of the module authority, who took the action, empty for the
+	// actions taken by the module
+	Moderator string `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// target identifies the object of the action: a post id, an inbound post id or a moderator address
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// createdAt is the unix time in seconds of the block the action was taken in
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CreatedHeight int64 `protobuf:"varint,7,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	// txHash is the hash of the transaction that took the action
+	TxHash string `protobuf:"bytes,8,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (m *ModerationAction) Reset()         { *m = ModerationAction{} }
+func (m *ModerationAction) String() string { return proto.CompactTextString(m) }
+func (*ModerationAction) ProtoMessage()    {}
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b724721665ed6d8, []int{1}
+}
+func (m *ModerationAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModerationAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModerationAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModerationAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerationAction.Merge(m, src)
+}
+func (m *ModerationAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModerationAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerationAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModerationAction proto.InternalMessageInfo
+
+func (m *ModerationAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ModerationAction) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *ModerationAction) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ModerationAction) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ModerationAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ModerationAction) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ModerationAction) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *ModerationAction) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Moderator)(nil), "planet.blog.Moderator")
+	proto.RegisterType((*ModerationAction)(nil), "planet.blog.ModerationAction")
+}
+
+func init() { proto.RegisterFile("planet/blog/moderation.proto", fileDescriptor_5b724721665ed6d8) }
+
+var fileDescriptor_5b724721665ed6d8 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0xeb, 0xb4, 0xa4, 0xd8, 0x15, 0x08, 0x19, 0x09, 0x79, 0xa8, 0xac, 0xa8, 0x62, 0xe8,
+	0x42, 0x3b, 0xf0, 0x04, 0x65, 0xea, 0xd2, 0xc5, 0x23, 0x9b, 0x5b, 0x5b, 0x69, 0xa4, 0x36, 0x8e,
+	0x6c, 0x0f, 0xe5, 0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0xe1, 0x41, 0x90, 0xcf, 0x0e, 0x85,
+	0xc5, 0xf2, 0xff, 0xfd, 0x67, 0xff, 0x77, 0x3a, 0x32, 0x6d, 0x0e, 0xb2, 0xd6, 0x7e, 0xb9, 0x3d,
+	0x98, 0x72, 0x79, 0x34, 0x4a, 0x5b, 0xe9, 0x2b, 0x53, 0x2f, 0x1a, 0x6b, 0xbc, 0xa1, 0x93, 0xe8,
+	0x2e, 0x82, 0x3b, 0x93, 0x04, 0x6f, 0x62, 0x81, 0xb1, 0x94, 0x91, 0xb1, 0x54, 0xca, 0x6a, 0xe7,
+	0x18, 0x2a, 0xd0, 0x1c, 0x8b, 0x5e, 0x26, 0x47, 0xab, 0x95, 0x67, 0x59, 0x81, 0xe6, 0x43, 0xd1,
+	0x4b, 0x5a, 0x90, 0x09, 0x5c, 0xd7, 0xba, 0x2a, 0xf7, 0x9e, 0x0d, 0xc1, 0xfd, 0x8b, 0x66, 0xdf,
+	0x88, 0xdc, 0x6d, 0x7e, 0x9b, 0x58, 0xed, 0xc2, 0x49, 0x6f, 0x49, 0x56, 0x29, 0x48, 0x19, 0x89,
+	0xac, 0x52, 0x74, 0x4a, 0xf0, 0xb1, 0xef, 0x03, 0x22, 0xb0, 0xb8, 0x00, 0xfa, 0x40, 0x72, 0x09,
+	0xef, 0xe0, 0x7f, 0x2c, 0x92, 0x0a, 0xdc, 0x4b, 0x5b, 0x6a, 0xcf, 0x46, 0x91, 0x47, 0x15, 0xb8,
+	0xd5, 0xd2, 0x99, 0x9a, 0x5d, 0x45, 0x1e, 0x55, 0x48, 0xd9, 0x59, 0x2d, 0x3d, 0x0c, 0x92, 0x43,
+	0xab, 0x17, 0x40, 0x1f, 0xc9, 0x4d, 0x12, 0x69, 0x98, 0x31, 0x54, 0xfc, 0x87, 0x90, 0x79, 0x5a,
+	0x4b, 0xb7, 0x67, 0xd7, 0x29, 0x13, 0xd4, 0xcb, 0xd3, 0x47, 0xcb, 0xd1, 0xb9, 0xe5, 0xe8, 0xab,
+	0xe5, 0xe8, 0xbd, 0xe3, 0x83, 0x73, 0xc7, 0x07, 0x9f, 0x1d, 0x1f, 0xbc, 0xde, 0xa7, 0x75, 0x9c,
+	0xe2, 0x42, 0xfc, 0x5b, 0xa3, 0xdd, 0x36, 0x87, 0x65, 0x3c, 0xff, 0x0c, 0x00, 0xa2, 0x80, 0x25,
+	0x36, 0xac, 0x01, 0x00, 0x00,
+}
+
+func (m *Moderator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Moderator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Moderator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedHeight != 0 {
+		i = encodeVarintModeration(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AddedAt != 0 {
+		i = encodeVarintModeration(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModerationAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModerationAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModerationAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintModeration(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintModeration(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moderator) > 0 {
+		i -= len(m.Moderator)
+		copy(dAtA[i:], m.Moderator)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.Moderator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintModeration(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModeration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModeration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Moderator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovModeration(uint64(m.AddedAt))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovModeration(uint64(m.AddedHeight))
+	}
+	return n
+}
+
+func (m *ModerationAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModeration(uint64(m.Id))
+	}
+	l = len(m.Moderator)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovModeration(uint64(m.CreatedAt))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovModeration(uint64(m.CreatedHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	return n
+}
+
+func sovModeration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModeration(x uint64) (n int) {
+	return sovModeration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Moderator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModeration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Moderator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Moderator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModeration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModerationAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModeration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModerationAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModerationAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moderator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moderator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModeration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModeration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModeration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModeration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModeration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModeration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModeration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModeration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModeration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -26,6 +26,10 @@ type BlogPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*BlogPacketData_NoData
 	//	*BlogPacketData_IbcPostPacket
+	//	*BlogPacketData_IbcCommentPacket
+	//	*BlogPacketData_IbcEditPostPacket
+	//	*BlogPacketData_IbcDeletePostPacket
+	//	*BlogPacketData_IbcReactionPacket
 	Packet isBlogPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -74,9 +78,25 @@ type BlogPacketData_NoData struct {
 type BlogPacketData_IbcPostPacket struct {
 	IbcPostPacket *IbcPostPacketData `protobuf:"bytes,2,opt,name=ibcPostPacket,proto3,oneof" json:"ibcPostPacket,omitempty"`
 }
+type BlogPacketData_IbcCommentPacket struct {
+	IbcCommentPacket *IbcCommentPacketData `protobuf:"bytes,3,opt,name=ibcCommentPacket,proto3,oneof" json:"ibcCommentPacket,omitempty"`
+}
+type BlogPacketData_IbcEditPostPacket struct {
+	IbcEditPostPacket *IbcEditPostPacketData `protobuf:"bytes,4,opt,name=ibcEditPostPacket,proto3,oneof" json:"ibcEditPostPacket,omitempty"`
+}
+type BlogPacketData_IbcDeletePostPacket struct {
+	IbcDeletePostPacket *IbcDeletePostPacketData `protobuf:"bytes,5,opt,name=ibcDeletePostPacket,proto3,oneof" json:"ibcDeletePostPacket,omitempty"`
+}
+type BlogPacketData_IbcReactionPacket struct {
+	IbcReactionPacket *IbcReactionPacketData `protobuf:"bytes,6,opt,name=ibcReactionPacket,proto3,oneof" json:"ibcReactionPacket,omitempty"`
+}
 
-func (*BlogPacketData_NoData) isBlogPacketData_Packet()        {}
-func (*BlogPacketData_IbcPostPacket) isBlogPacketData_Packet() {}
+func (*BlogPacketData_NoData) isBlogPacketData_Packet()              {}
+func (*BlogPacketData_IbcPostPacket) isBlogPacketData_Packet()       {}
+func (*BlogPacketData_IbcCommentPacket) isBlogPacketData_Packet()    {}
+func (*BlogPacketData_IbcEditPostPacket) isBlogPacketData_Packet()   {}
+func (*BlogPacketData_IbcDeletePostPacket) isBlogPacketData_Packet() {}
+func (*BlogPacketData_IbcReactionPacket) isBlogPacketData_Packet()   {}
 
 func (m *BlogPacketData) GetPacket() isBlogPacketData_Packet {
 	if m != nil {
@@ -99,11 +119,43 @@ func (m *BlogPacketData) GetIbcPostPacket() *IbcPostPacketData {
 	return nil
 }
 
+func (m *BlogPacketData) GetIbcCommentPacket() *IbcCommentPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_IbcCommentPacket); ok {
+		return x.IbcCommentPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetIbcEditPostPacket() *IbcEditPostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_IbcEditPostPacket); ok {
+		return x.IbcEditPostPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetIbcDeletePostPacket() *IbcDeletePostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_IbcDeletePostPacket); ok {
+		return x.IbcDeletePostPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetIbcReactionPacket() *IbcReactionPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_IbcReactionPacket); ok {
+		return x.IbcReactionPacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*BlogPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*BlogPacketData_NoData)(nil),
 		(*BlogPacketData_IbcPostPacket)(nil),
+		(*BlogPacketData_IbcCommentPacket)(nil),
+		(*BlogPacketData_IbcEditPostPacket)(nil),
+		(*BlogPacketData_IbcDeletePostPacket)(nil),
+		(*BlogPacketData_IbcReactionPacket)(nil),
 	}
 }
 
@@ -145,9 +197,10 @@ var xxx_messageInfo_NoData proto.InternalMessageInfo
 
 // IbcPostPacketData defines a struct for the packet payload
 type IbcPostPacketData struct {
-	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title   string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Creator string   `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tags    []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (m *IbcPostPacketData) Reset()         { *m = IbcPostPacketData{} }
@@ -204,6 +257,13 @@ func (m *IbcPostPacketData) GetCreator() string {
 	return ""
 }
 
+func (m *IbcPostPacketData) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // IbcPostPacketAck defines a struct for the packet acknowledgment
 type IbcPostPacketAck struct {
 	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
@@ -249,304 +309,2215 @@ func (m *IbcPostPacketAck) GetPostID() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*BlogPacketData)(nil), "planet.blog.BlogPacketData")
-	proto.RegisterType((*NoData)(nil), "planet.blog.NoData")
-	proto.RegisterType((*IbcPostPacketData)(nil), "planet.blog.IbcPostPacketData")
-	proto.RegisterType((*IbcPostPacketAck)(nil), "planet.blog.IbcPostPacketAck")
+// IbcCommentPacketData defines a struct for the payload of a comment replying to a post sent by the counterparty
+type IbcCommentPacketData struct {
+	// postID is the ID of the post on the sending chain, as acknowledged to the counterparty
+	PostID  uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func init() { proto.RegisterFile("planet/blog/packet.proto", fileDescriptor_d544876606a92415) }
-
-var fileDescriptor_d544876606a92415 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0x48, 0x4c, 0xce, 0x4e, 0x2d, 0xd1, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xc8, 0xe8, 0x81, 0x64, 0x94, 0x26, 0x32, 0x72, 0xf1,
-	0x39, 0xe5, 0xe4, 0xa7, 0x07, 0x80, 0x55, 0xb8, 0x24, 0x96, 0x24, 0x0a, 0xe9, 0x72, 0xb1, 0xe5,
-	0xe5, 0x83, 0x58, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xc2, 0x7a, 0x48, 0x1a, 0xf4, 0xfc,
-	0xc0, 0x52, 0x1e, 0x0c, 0x41, 0x50, 0x45, 0x42, 0x6e, 0x5c, 0xbc, 0x99, 0x49, 0xc9, 0x01, 0xf9,
-	0xc5, 0x25, 0x10, 0x33, 0x24, 0x98, 0xc0, 0xba, 0xe4, 0x50, 0x74, 0x79, 0x22, 0xab, 0x80, 0x1a,
-	0x80, 0xaa, 0xcd, 0x89, 0x83, 0x8b, 0x0d, 0xe2, 0x4c, 0x25, 0x0e, 0x2e, 0x36, 0x88, 0x2d, 0x4a,
-	0xb1, 0x5c, 0x82, 0x18, 0x3a, 0x85, 0x44, 0xb8, 0x58, 0x4b, 0x32, 0x4b, 0x72, 0x52, 0xc1, 0xce,
-	0xe3, 0x0c, 0x82, 0x70, 0x84, 0x24, 0xb8, 0xd8, 0x93, 0xf3, 0xf3, 0x4a, 0x52, 0xf3, 0x20, 0x0e,
-	0xe0, 0x0c, 0x82, 0x71, 0xc1, 0x32, 0x45, 0xa9, 0x89, 0x25, 0xf9, 0x45, 0x12, 0xcc, 0x50, 0x19,
-	0x08, 0x57, 0x49, 0x8b, 0x4b, 0x00, 0xc5, 0x78, 0xc7, 0xe4, 0x6c, 0x21, 0x31, 0x2e, 0xb6, 0x82,
-	0xfc, 0xe2, 0x12, 0x4f, 0x17, 0xa8, 0xf1, 0x50, 0x9e, 0x93, 0xee, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x09, 0x43, 0x43, 0xba, 0x02, 0x12, 0xd6, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xae, 0xb5, 0x7b, 0x09,
-	0x87, 0x01, 0x00, 0x00,
+func (m *IbcCommentPacketData) Reset()         { *m = IbcCommentPacketData{} }
+func (m *IbcCommentPacketData) String() string { return proto.CompactTextString(m) }
+func (*IbcCommentPacketData) ProtoMessage()    {}
+func (*IbcCommentPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{4}
 }
-
-func (m *BlogPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcCommentPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcCommentPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcCommentPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BlogPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcCommentPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcCommentPacketData.Merge(m, src)
+}
+func (m *IbcCommentPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcCommentPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcCommentPacketData.DiscardUnknown(m)
 }
 
-func (m *BlogPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size := m.Packet.Size()
-			i -= size
-			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+var xxx_messageInfo_IbcCommentPacketData proto.InternalMessageInfo
+
+func (m *IbcCommentPacketData) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BlogPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcCommentPacketData) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
 }
 
-func (m *BlogPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NoData != nil {
-		{
-			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *IbcCommentPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *BlogPacketData_IbcPostPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// IbcCommentPacketAck defines a struct for the comment packet acknowledgment
+type IbcCommentPacketAck struct {
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
 }
 
-func (m *BlogPacketData_IbcPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.IbcPostPacket != nil {
-		{
-			size, err := m.IbcPostPacket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
+func (m *IbcCommentPacketAck) Reset()         { *m = IbcCommentPacketAck{} }
+func (m *IbcCommentPacketAck) String() string { return proto.CompactTextString(m) }
+func (*IbcCommentPacketAck) ProtoMessage()    {}
+func (*IbcCommentPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{5}
+}
+func (m *IbcCommentPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcCommentPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcCommentPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *NoData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *IbcCommentPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcCommentPacketAck.Merge(m, src)
+}
+func (m *IbcCommentPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcCommentPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcCommentPacketAck.DiscardUnknown(m)
 }
 
-func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_IbcCommentPacketAck proto.InternalMessageInfo
+
+func (m *IbcCommentPacketAck) GetCommentID() uint64 {
+	if m != nil {
+		return m.CommentID
+	}
+	return 0
 }
 
-func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// IbcEditPostPacketData defines a struct for the payload of an edit of a post delivered to the counterparty
+type IbcEditPostPacketData struct {
+	// postID is the ID of the post on the receiving chain, as acknowledged to the sending chain
+	PostID  uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *IbcPostPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcEditPostPacketData) Reset()         { *m = IbcEditPostPacketData{} }
+func (m *IbcEditPostPacketData) String() string { return proto.CompactTextString(m) }
+func (*IbcEditPostPacketData) ProtoMessage()    {}
+func (*IbcEditPostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{6}
+}
+func (m *IbcEditPostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcEditPostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcEditPostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *IbcPostPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcEditPostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcEditPostPacketData.Merge(m, src)
+}
+func (m *IbcEditPostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcEditPostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcEditPostPacketData.DiscardUnknown(m)
 }
 
-func (m *IbcPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_IbcEditPostPacketData proto.InternalMessageInfo
+
+func (m *IbcEditPostPacketData) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *IbcEditPostPacketData) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *IbcEditPostPacketData) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IbcPostPacketAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcEditPostPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IbcPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// IbcEditPostPacketAck defines a struct for the edit packet acknowledgment
+type IbcEditPostPacketAck struct {
+	// revision is the revision of the post on the receiving chain created by the edit
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *IbcPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PostID) > 0 {
-		i -= len(m.PostID)
-		copy(dAtA[i:], m.PostID)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
-		i--
-		dAtA[i] = 0xa
+func (m *IbcEditPostPacketAck) Reset()         { *m = IbcEditPostPacketAck{} }
+func (m *IbcEditPostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*IbcEditPostPacketAck) ProtoMessage()    {}
+func (*IbcEditPostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{7}
+}
+func (m *IbcEditPostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcEditPostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcEditPostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *IbcEditPostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcEditPostPacketAck.Merge(m, src)
+}
+func (m *IbcEditPostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcEditPostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcEditPostPacketAck.DiscardUnknown(m)
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_IbcEditPostPacketAck proto.InternalMessageInfo
+
+func (m *IbcEditPostPacketAck) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *BlogPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+
+// IbcDeletePostPacketData defines a struct for the payload of the retraction of a post delivered to the counterparty
+type IbcDeletePostPacketData struct {
+	// postID is the ID of the post on the receiving chain, as acknowledged to the sending chain
+	PostID  uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *IbcDeletePostPacketData) Reset()         { *m = IbcDeletePostPacketData{} }
+func (m *IbcDeletePostPacketData) String() string { return proto.CompactTextString(m) }
+func (*IbcDeletePostPacketData) ProtoMessage()    {}
+func (*IbcDeletePostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{8}
+}
+func (m *IbcDeletePostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcDeletePostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcDeletePostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Packet != nil {
-		n += m.Packet.Size()
+}
+func (m *IbcDeletePostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcDeletePostPacketData.Merge(m, src)
+}
+func (m *IbcDeletePostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcDeletePostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcDeletePostPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcDeletePostPacketData proto.InternalMessageInfo
+
+func (m *IbcDeletePostPacketData) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	return n
+	return 0
 }
 
-func (m *BlogPacketData_NoData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IbcDeletePostPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	if m.NoData != nil {
-		l = m.NoData.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	return ""
+}
+
+// IbcDeletePostPacketAck defines a struct for the delete packet acknowledgment
+type IbcDeletePostPacketAck struct {
+}
+
+func (m *IbcDeletePostPacketAck) Reset()         { *m = IbcDeletePostPacketAck{} }
+func (m *IbcDeletePostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*IbcDeletePostPacketAck) ProtoMessage()    {}
+func (*IbcDeletePostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{9}
+}
+func (m *IbcDeletePostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcDeletePostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcDeletePostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-func (m *BlogPacketData_IbcPostPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IbcDeletePostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcDeletePostPacketAck.Merge(m, src)
+}
+func (m *IbcDeletePostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcDeletePostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcDeletePostPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcDeletePostPacketAck proto.InternalMessageInfo
+
+// IbcReactionPacketData defines a struct for the payload of a reaction to a post sent by the counterparty
+type IbcReactionPacketData struct {
+	// postID is the ID of the post on the sending chain, as acknowledged to the counterparty
+	PostID   uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Reaction string `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Creator  string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *IbcReactionPacketData) Reset()         { *m = IbcReactionPacketData{} }
+func (m *IbcReactionPacketData) String() string { return proto.CompactTextString(m) }
+func (*IbcReactionPacketData) ProtoMessage()    {}
+func (*IbcReactionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{10}
+}
+func (m *IbcReactionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcReactionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcReactionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.IbcPostPacket != nil {
-		l = m.IbcPostPacket.Size()
-		n += 1 + l + sovPacket(uint64(l))
+}
+func (m *IbcReactionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcReactionPacketData.Merge(m, src)
+}
+func (m *IbcReactionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcReactionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcReactionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcReactionPacketData proto.InternalMessageInfo
+
+func (m *IbcReactionPacketData) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	return n
+	return 0
 }
-func (m *NoData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *IbcReactionPacketData) GetReaction() string {
+	if m != nil {
+		return m.Reaction
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *IbcPostPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IbcReactionPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// IbcReactionPacketAck defines a struct for the reaction packet acknowledgment
+type IbcReactionPacketAck struct {
+}
+
+func (m *IbcReactionPacketAck) Reset()         { *m = IbcReactionPacketAck{} }
+func (m *IbcReactionPacketAck) String() string { return proto.CompactTextString(m) }
+func (*IbcReactionPacketAck) ProtoMessage()    {}
+func (*IbcReactionPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{11}
+}
+func (m *IbcReactionPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcReactionPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcReactionPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcReactionPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcReactionPacketAck.Merge(m, src)
+}
+func (m *IbcReactionPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcReactionPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcReactionPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcReactionPacketAck proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BlogPacketData)(nil), "planet.blog.BlogPacketData")
+	proto.RegisterType((*NoData)(nil), "planet.blog.NoData")
+	proto.RegisterType((*IbcPostPacketData)(nil), "planet.blog.IbcPostPacketData")
+	proto.RegisterType((*IbcPostPacketAck)(nil), "planet.blog.IbcPostPacketAck")
+	proto.RegisterType((*IbcCommentPacketData)(nil), "planet.blog.IbcCommentPacketData")
+	proto.RegisterType((*IbcCommentPacketAck)(nil), "planet.blog.IbcCommentPacketAck")
+	proto.RegisterType((*IbcEditPostPacketData)(nil), "planet.blog.IbcEditPostPacketData")
+	proto.RegisterType((*IbcEditPostPacketAck)(nil), "planet.blog.IbcEditPostPacketAck")
+	proto.RegisterType((*IbcDeletePostPacketData)(nil), "planet.blog.IbcDeletePostPacketData")
+	proto.RegisterType((*IbcDeletePostPacketAck)(nil), "planet.blog.IbcDeletePostPacketAck")
+	proto.RegisterType((*IbcReactionPacketData)(nil), "planet.blog.IbcReactionPacketData")
+	proto.RegisterType((*IbcReactionPacketAck)(nil), "planet.blog.IbcReactionPacketAck")
+}
+
+func init() { proto.RegisterFile("planet/blog/packet.proto", fileDescriptor_d544876606a92415) }
+
+var fileDescriptor_d544876606a92415 = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0x26, 0x0b, 0xed, 0x99, 0x40, 0x9b, 0x5b, 0x4a, 0x84, 0x50, 0x34, 0x2c, 0x2e,
+	0x26, 0xa4, 0x65, 0xd2, 0xf6, 0x04, 0x2d, 0x05, 0x31, 0x21, 0xc1, 0x94, 0x2b, 0xc4, 0x5d, 0x62,
+	0xac, 0xca, 0x4a, 0x16, 0x87, 0xc4, 0x42, 0xec, 0x2d, 0x78, 0x2c, 0x2e, 0x77, 0xc9, 0x25, 0x6a,
+	0x79, 0x10, 0x14, 0xdb, 0x0d, 0x71, 0xfe, 0xec, 0x62, 0x77, 0x39, 0x39, 0xdf, 0xf9, 0x7d, 0x9f,
+	0x7c, 0x2c, 0x83, 0x97, 0xa7, 0x51, 0x46, 0xc5, 0x79, 0x9c, 0xf2, 0xcd, 0x79, 0x1e, 0x91, 0x84,
+	0x8a, 0x20, 0x2f, 0xb8, 0xe0, 0xe8, 0x50, 0x75, 0x82, 0xaa, 0x83, 0xff, 0xda, 0xf0, 0x64, 0x95,
+	0xf2, 0xcd, 0xb5, 0x54, 0xac, 0x23, 0x11, 0xa1, 0x33, 0x70, 0x33, 0x5e, 0x7d, 0x79, 0xd6, 0x89,
+	0x75, 0x7a, 0x78, 0x31, 0x0b, 0x1a, 0x03, 0xc1, 0x47, 0xd9, 0x7a, 0x3f, 0x0a, 0xb5, 0x08, 0xbd,
+	0x83, 0xc7, 0x2c, 0x26, 0xd7, 0xbc, 0x14, 0x8a, 0xe1, 0x8d, 0xe5, 0x94, 0x6f, 0x4c, 0x5d, 0x35,
+	0x15, 0x1a, 0x60, 0x8e, 0xa1, 0x4f, 0x70, 0xc4, 0x62, 0xf2, 0x86, 0xdf, 0xdc, 0xd0, 0x6c, 0x8f,
+	0xb2, 0x25, 0xea, 0x65, 0x1b, 0x65, 0x88, 0x34, 0xad, 0x33, 0x8c, 0x42, 0x38, 0x66, 0x31, 0x79,
+	0xfb, 0x95, 0x89, 0x46, 0x38, 0x47, 0x12, 0x71, 0x9b, 0x68, 0xaa, 0x34, 0xb2, 0x3b, 0x8e, 0x3e,
+	0xc3, 0x8c, 0xc5, 0x64, 0x4d, 0x53, 0x2a, 0x68, 0x83, 0x7a, 0x20, 0xa9, 0xaf, 0xda, 0xd4, 0xb6,
+	0x4e, 0x73, 0xfb, 0x10, 0x3a, 0x6d, 0x48, 0x23, 0x22, 0x18, 0xcf, 0x34, 0xd7, 0xed, 0x4f, 0x6b,
+	0xaa, 0x1a, 0x69, 0xcd, 0xc6, 0x6a, 0x02, 0xae, 0xda, 0x3c, 0x9e, 0x80, 0xab, 0x16, 0x87, 0xbf,
+	0xc1, 0x71, 0x67, 0x19, 0x68, 0x0e, 0x07, 0x82, 0x89, 0x94, 0xca, 0x8d, 0x4f, 0x43, 0x55, 0x20,
+	0x0f, 0x1e, 0x11, 0x9e, 0x09, 0x9a, 0xa9, 0x9d, 0x4e, 0xc3, 0x7d, 0x29, 0x3b, 0x05, 0x8d, 0x04,
+	0x2f, 0x3c, 0x5b, 0x77, 0x54, 0x89, 0x10, 0x38, 0x22, 0xda, 0x94, 0x9e, 0x73, 0x62, 0x9f, 0x4e,
+	0x43, 0xf9, 0x8d, 0x5f, 0xc3, 0x91, 0x61, 0xb9, 0x24, 0x09, 0x5a, 0x80, 0x9b, 0xf3, 0x52, 0x5c,
+	0xad, 0xb5, 0xa5, 0xae, 0x70, 0x0c, 0xf3, 0xbe, 0x05, 0xb7, 0xf4, 0xce, 0x5e, 0xff, 0x90, 0x8c,
+	0xf8, 0x12, 0x66, 0x6d, 0x8f, 0x2a, 0xd2, 0x0b, 0x98, 0x12, 0xf5, 0xaf, 0x76, 0xf9, 0xff, 0x03,
+	0xdf, 0xc2, 0xd3, 0xde, 0x7b, 0x32, 0x98, 0xac, 0x3e, 0xd3, 0xf1, 0xc0, 0x99, 0xda, 0x83, 0x79,
+	0x1d, 0x33, 0xef, 0x05, 0xcc, 0x3b, 0xd6, 0x55, 0xe0, 0xe7, 0x30, 0x29, 0xe8, 0x77, 0x56, 0x32,
+	0x9e, 0x69, 0xef, 0xba, 0xc6, 0x1f, 0xe0, 0xd9, 0xc0, 0x05, 0xbc, 0xf7, 0x28, 0x75, 0x80, 0xb1,
+	0x19, 0xc0, 0x83, 0x45, 0x0f, 0x6c, 0x49, 0x12, 0x4c, 0xe5, 0xa9, 0x74, 0xef, 0xe3, 0xa0, 0x89,
+	0xcc, 0xac, 0xd4, 0xda, 0xa5, 0xae, 0xef, 0xd9, 0xd8, 0x02, 0xe6, 0x1d, 0x9b, 0x25, 0x49, 0x56,
+	0x67, 0xbf, 0xb6, 0xbe, 0x75, 0xb7, 0xf5, 0xad, 0x3f, 0x5b, 0xdf, 0xfa, 0xb9, 0xf3, 0x47, 0x77,
+	0x3b, 0x7f, 0xf4, 0x7b, 0xe7, 0x8f, 0xbe, 0xcc, 0xf4, 0xf3, 0xf7, 0x43, 0x3d, 0x80, 0xe2, 0x36,
+	0xa7, 0x65, 0xec, 0xca, 0x07, 0xf0, 0xf2, 0xdf, 0x00, 0xfd, 0x62, 0xcc, 0xde, 0x1c, 0x05, 0x00,
+	0x00,
+}
+
+func (m *BlogPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlogPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BlogPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcPostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcPostPacket != nil {
+		{
+			size, err := m.IbcPostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcCommentPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcCommentPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcCommentPacket != nil {
+		{
+			size, err := m.IbcCommentPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcEditPostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcEditPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcEditPostPacket != nil {
+		{
+			size, err := m.IbcEditPostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcDeletePostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcDeletePostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcDeletePostPacket != nil {
+		{
+			size, err := m.IbcDeletePostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcReactionPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcReactionPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcReactionPacket != nil {
+		{
+			size, err := m.IbcReactionPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcPostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcPostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcPostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcCommentPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcCommentPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcCommentPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcCommentPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcCommentPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcCommentPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommentID != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.CommentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcEditPostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcEditPostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcEditPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcEditPostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcEditPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcEditPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcDeletePostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcDeletePostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcDeletePostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcDeletePostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcDeletePostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcDeletePostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcReactionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcReactionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcReactionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reaction) > 0 {
+		i -= len(m.Reaction)
+		copy(dAtA[i:], m.Reaction)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Reaction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcReactionPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcReactionPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcReactionPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlogPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *BlogPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcPostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcPostPacket != nil {
+		l = m.IbcPostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcCommentPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcCommentPacket != nil {
+		l = m.IbcCommentPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcEditPostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcEditPostPacket != nil {
+		l = m.IbcEditPostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcDeletePostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcDeletePostPacket != nil {
+		l = m.IbcDeletePostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcReactionPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcReactionPacket != nil {
+		l = m.IbcReactionPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IbcPostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IbcPostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcCommentPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovPacket(uint64(m.PostID))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcCommentPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentID != 0 {
+		n += 1 + sovPacket(uint64(m.CommentID))
+	}
+	return n
+}
+
+func (m *IbcEditPostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovPacket(uint64(m.PostID))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcEditPostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovPacket(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *IbcDeletePostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovPacket(uint64(m.PostID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcDeletePostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IbcReactionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovPacket(uint64(m.PostID))
+	}
+	l = len(m.Reaction)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcReactionPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlogPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlogPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcPostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcPostPacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcCommentPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcCommentPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcCommentPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEditPostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcEditPostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcEditPostPacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDeletePostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcDeletePostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcDeletePostPacket{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcReactionPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcReactionPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcReactionPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcPostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcPostPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcCommentPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcCommentPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcCommentPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcCommentPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcCommentPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcCommentPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentID", wireType)
+			}
+			m.CommentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcEditPostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcEditPostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcEditPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *IbcPostPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PostID)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
+func (m *IbcEditPostPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -569,17 +2540,17 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlogPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcEditPostPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlogPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcEditPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -589,32 +2560,85 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Revision |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPacket
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPacket
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NoData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcDeletePostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcDeletePostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcDeletePostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Packet = &BlogPacketData_NoData{v}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcPostPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -624,26 +2648,23 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IbcPostPacketData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Packet = &BlogPacketData_IbcPostPacket{v}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -666,7 +2687,7 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoData) Unmarshal(dAtA []byte) error {
+func (m *IbcDeletePostPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -689,10 +2710,10 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcDeletePostPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcDeletePostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -716,7 +2737,7 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
+func (m *IbcReactionPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,17 +2760,17 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IbcPostPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcReactionPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IbcPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcReactionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
 			}
-			var stringLen uint64
+			m.PostID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -759,27 +2780,14 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PostID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -807,7 +2815,7 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.Reaction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -862,7 +2870,7 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
+func (m *IbcReactionPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -885,44 +2893,12 @@ func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IbcPostPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcReactionPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IbcPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcReactionPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPacket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p IbcCommentPacketData) ValidateBasic() error {
+	if p.Content == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty comment content")
+	}
+	if p.Creator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty comment creator")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p IbcCommentPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_IbcCommentPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p IbcDeletePostPacketData) ValidateBasic() error {
+	if p.Creator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post creator")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p IbcDeletePostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_IbcDeletePostPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p IbcEditPostPacketData) ValidateBasic() error {
+	if p.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post title")
+	}
+	if p.Creator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post creator")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p IbcEditPostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_IbcEditPostPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -1,10 +1,20 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // ValidateBasic is used for validating the packet
 func (p IbcPostPacketData) ValidateBasic() error {
-
-	// TODO: Validate the packet data
-
+	if p.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post title")
+	}
+	if p.Creator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty post creator")
+	}
+	if err := ValidateTags(p.Tags); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTags, err.Error())
+	}
 	return nil
 }
 
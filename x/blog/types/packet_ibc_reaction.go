@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p IbcReactionPacketData) ValidateBasic() error {
+	if !IsValidReaction(p.Reaction) {
+		return sdkerrors.Wrapf(ErrInvalidReaction, "unknown reaction %s", p.Reaction)
+	}
+	if p.Creator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty reaction creator")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p IbcReactionPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_IbcReactionPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -1,34 +1,248 @@
 package types
 
 import (
+	"fmt"
+	"unicode/utf8"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// MaxTitleLengthLimit and MaxContentLengthLimit bound the length limits of the params, the size of a post is
+// checked against them before the params are known
+const (
+	MaxTitleLengthLimit   uint64 = 1024
+	MaxContentLengthLimit uint64 = 100000
+)
+
+var (
+	KeyMaxTitleLength = []byte("MaxTitleLength")
+	// DefaultMaxTitleLength is the default maximum length of a post title
+	DefaultMaxTitleLength uint64 = 256
+)
+
+var (
+	KeyMaxContentLength = []byte("MaxContentLength")
+	// DefaultMaxContentLength is the default maximum length of a post content
+	DefaultMaxContentLength uint64 = 10000
+)
+
+var (
+	KeyAllowedSourceChannels = []byte("AllowedSourceChannels")
+	// DefaultAllowedSourceChannels allows posts to be received from any channel
+	DefaultAllowedSourceChannels []string
+)
+
+var (
+	KeyAllowedDestinationChannels = []byte("AllowedDestinationChannels")
+	// DefaultAllowedDestinationChannels allows posts to be sent to any channel
+	DefaultAllowedDestinationChannels []string
+)
+
+var (
+	KeyMaxIndexedTokens = []byte("MaxIndexedTokens")
+	// DefaultMaxIndexedTokens is the default maximum number of distinct words of a post in the search index
+	DefaultMaxIndexedTokens uint64 = 100
+)
+
+var (
+	KeyMaxTags = []byte("MaxTags")
+	// DefaultMaxTags is the default maximum number of tags of a post
+	DefaultMaxTags uint64 = 10
+)
+
+var (
+	KeyMaxTagLength = []byte("MaxTagLength")
+	// DefaultMaxTagLength is the default maximum length of a tag
+	DefaultMaxTagLength uint64 = 32
+)
+
+var (
+	KeyMaxMentions = []byte("MaxMentions")
+	// DefaultMaxMentions is the default maximum number of addresses notified of a post
+	DefaultMaxMentions uint64 = 10
+)
+
+var (
+	KeyModeratedChannels = []byte("ModeratedChannels")
+	// DefaultModeratedChannels publishes the posts received on any channel immediately, moderation is disabled until
+	// governance lists channels
+	DefaultModeratedChannels []string
+)
+
+var (
+	KeyModerationExpiryBlocks = []byte("ModerationExpiryBlocks")
+	// DefaultModerationExpiryBlocks is the default number of blocks a received post stays queued, about a week with
+	// 6 seconds blocks
+	DefaultModerationExpiryBlocks uint64 = 100800
+)
+
+var (
+	KeyReportHideThreshold = []byte("ReportHideThreshold")
+	// DefaultReportHideThreshold is the default number of accounts reporting a post that hides it
+	DefaultReportHideThreshold uint64 = 5
+)
+
+var (
+	KeyRateLimitWindowBlocks = []byte("RateLimitWindowBlocks")
+	// DefaultRateLimitWindowBlocks is the default number of blocks of a rate limit window, about 10 minutes with 6
+	// seconds blocks
+	DefaultRateLimitWindowBlocks uint64 = 100
+)
+
+var (
+	KeyMaxSentPostsPerWindow = []byte("MaxSentPostsPerWindow")
+	// DefaultMaxSentPostsPerWindow is the default maximum number of posts an account sends in a window
+	DefaultMaxSentPostsPerWindow uint64 = 20
+)
+
+var (
+	KeyMaxReceivedPacketsPerWindow = []byte("MaxReceivedPacketsPerWindow")
+	// DefaultMaxReceivedPacketsPerWindow is the default maximum number of packets accepted on a channel in a window
+	DefaultMaxReceivedPacketsPerWindow uint64 = 1000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxTitleLength uint64,
+	maxContentLength uint64,
+	allowedSourceChannels []string,
+	allowedDestinationChannels []string,
+	maxIndexedTokens uint64,
+	maxTags uint64,
+	maxTagLength uint64,
+	maxMentions uint64,
+	moderatedChannels []string,
+	moderationExpiryBlocks uint64,
+	reportHideThreshold uint64,
+	rateLimitWindowBlocks uint64,
+	maxSentPostsPerWindow uint64,
+	maxReceivedPacketsPerWindow uint64,
+) Params {
+	return Params{
+		MaxTitleLength:              maxTitleLength,
+		MaxContentLength:            maxContentLength,
+		AllowedSourceChannels:       allowedSourceChannels,
+		AllowedDestinationChannels:  allowedDestinationChannels,
+		MaxIndexedTokens:            maxIndexedTokens,
+		MaxTags:                     maxTags,
+		MaxTagLength:                maxTagLength,
+		MaxMentions:                 maxMentions,
+		ModeratedChannels:           moderatedChannels,
+		ModerationExpiryBlocks:      moderationExpiryBlocks,
+		ReportHideThreshold:         reportHideThreshold,
+		RateLimitWindowBlocks:       rateLimitWindowBlocks,
+		MaxSentPostsPerWindow:       maxSentPostsPerWindow,
+		MaxReceivedPacketsPerWindow: maxReceivedPacketsPerWindow,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxTitleLength,
+		DefaultMaxContentLength,
+		DefaultAllowedSourceChannels,
+		DefaultAllowedDestinationChannels,
+		DefaultMaxIndexedTokens,
+		DefaultMaxTags,
+		DefaultMaxTagLength,
+		DefaultMaxMentions,
+		DefaultModeratedChannels,
+		DefaultModerationExpiryBlocks,
+		DefaultReportHideThreshold,
+		DefaultRateLimitWindowBlocks,
+		DefaultMaxSentPostsPerWindow,
+		DefaultMaxReceivedPacketsPerWindow,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxTitleLength, &p.MaxTitleLength, validateMaxTitleLength),
+		paramtypes.NewParamSetPair(KeyMaxContentLength, &p.MaxContentLength, validateMaxContentLength),
+		paramtypes.NewParamSetPair(KeyAllowedSourceChannels, &p.AllowedSourceChannels, validateAllowedSourceChannels),
+		paramtypes.NewParamSetPair(KeyAllowedDestinationChannels, &p.AllowedDestinationChannels, validateAllowedDestinationChannels),
+		paramtypes.NewParamSetPair(KeyMaxIndexedTokens, &p.MaxIndexedTokens, validateMaxIndexedTokens),
+		paramtypes.NewParamSetPair(KeyMaxTags, &p.MaxTags, validateMaxTags),
+		paramtypes.NewParamSetPair(KeyMaxTagLength, &p.MaxTagLength, validateMaxTagLength),
+		paramtypes.NewParamSetPair(KeyMaxMentions, &p.MaxMentions, validateMaxMentions),
+		paramtypes.NewParamSetPair(KeyModeratedChannels, &p.ModeratedChannels, validateModeratedChannels),
+		paramtypes.NewParamSetPair(KeyModerationExpiryBlocks, &p.ModerationExpiryBlocks, validateModerationExpiryBlocks),
+		paramtypes.NewParamSetPair(KeyReportHideThreshold, &p.ReportHideThreshold, validateReportHideThreshold),
+		paramtypes.NewParamSetPair(KeyRateLimitWindowBlocks, &p.RateLimitWindowBlocks, validateRateLimitWindowBlocks),
+		paramtypes.NewParamSetPair(KeyMaxSentPostsPerWindow, &p.MaxSentPostsPerWindow, validateMaxSentPostsPerWindow),
+		paramtypes.NewParamSetPair(KeyMaxReceivedPacketsPerWindow, &p.MaxReceivedPacketsPerWindow, validateMaxReceivedPacketsPerWindow),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMaxTitleLength(p.MaxTitleLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxContentLength(p.MaxContentLength); err != nil {
+		return err
+	}
+
+	if err := validateAllowedSourceChannels(p.AllowedSourceChannels); err != nil {
+		return err
+	}
+
+	if err := validateAllowedDestinationChannels(p.AllowedDestinationChannels); err != nil {
+		return err
+	}
+
+	if err := validateMaxIndexedTokens(p.MaxIndexedTokens); err != nil {
+		return err
+	}
+
+	if err := validateMaxTags(p.MaxTags); err != nil {
+		return err
+	}
+
+	if err := validateMaxTagLength(p.MaxTagLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxMentions(p.MaxMentions); err != nil {
+		return err
+	}
+
+	if err := validateModeratedChannels(p.ModeratedChannels); err != nil {
+		return err
+	}
+
+	if err := validateModerationExpiryBlocks(p.ModerationExpiryBlocks); err != nil {
+		return err
+	}
+
+	if err := validateReportHideThreshold(p.ReportHideThreshold); err != nil {
+		return err
+	}
+
+	if err := validateRateLimitWindowBlocks(p.RateLimitWindowBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxSentPostsPerWindow(p.MaxSentPostsPerWindow); err != nil {
+		return err
+	}
+
+	if err := validateMaxReceivedPacketsPerWindow(p.MaxReceivedPacketsPerWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +251,241 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// IsSourceChannelAllowed returns true if posts can be received from the channel
+func (p Params) IsSourceChannelAllowed(channelID string) bool {
+	return isChannelAllowed(p.AllowedSourceChannels, channelID)
+}
+
+// IsDestinationChannelAllowed returns true if posts can be sent to the channel
+func (p Params) IsDestinationChannelAllowed(channelID string) bool {
+	return isChannelAllowed(p.AllowedDestinationChannels, channelID)
+}
+
+// IsChannelModerated returns true if the posts received on the channel are queued for moderation
+func (p Params) IsChannelModerated(channelID string) bool {
+	for _, moderated := range p.ModeratedChannels {
+		if moderated == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePostLength checks the title and content against the length limits, lengths are counted in characters
+// like the tag lengths
+func (p Params) ValidatePostLength(title, content string) error {
+	if length := utf8.RuneCountInString(title); uint64(length) > p.MaxTitleLength {
+		return fmt.Errorf("title length %d exceeds the maximum of %d", length, p.MaxTitleLength)
+	}
+	if length := utf8.RuneCountInString(content); uint64(length) > p.MaxContentLength {
+		return fmt.Errorf("content length %d exceeds the maximum of %d", length, p.MaxContentLength)
+	}
+	return nil
+}
+
+// ValidatePostLengthLimits checks the title and content against the hard limits of the length params
+func ValidatePostLengthLimits(title, content string) error {
+	return Params{MaxTitleLength: MaxTitleLengthLimit, MaxContentLength: MaxContentLengthLimit}.ValidatePostLength(title, content)
+}
+
+// ValidatePostTags checks the number and length of the tags against the tag limits
+func (p Params) ValidatePostTags(tags []string) error {
+	if uint64(len(tags)) > p.MaxTags {
+		return fmt.Errorf("%d tags exceed the maximum of %d", len(tags), p.MaxTags)
+	}
+	for _, tag := range tags {
+		if length := utf8.RuneCountInString(tag); uint64(length) > p.MaxTagLength {
+			return fmt.Errorf("tag %s length %d exceeds the maximum of %d", tag, length, p.MaxTagLength)
+		}
+	}
+	return nil
+}
+
+// isChannelAllowed returns true if the allowlist is empty or contains the channel
+func isChannelAllowed(allowlist []string, channelID string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+	for _, allowed := range allowlist {
+		if allowed == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMaxTitleLength validates the MaxTitleLength param
+func validateMaxTitleLength(v interface{}) error {
+	maxTitleLength, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxTitleLength == 0 {
+		return fmt.Errorf("max title length must be positive")
+	}
+	if maxTitleLength > MaxTitleLengthLimit {
+		return fmt.Errorf("max title length %d exceeds the limit of %d", maxTitleLength, MaxTitleLengthLimit)
+	}
+
+	return nil
+}
+
+// validateMaxContentLength validates the MaxContentLength param
+func validateMaxContentLength(v interface{}) error {
+	maxContentLength, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxContentLength == 0 {
+		return fmt.Errorf("max content length must be positive")
+	}
+	if maxContentLength > MaxContentLengthLimit {
+		return fmt.Errorf("max content length %d exceeds the limit of %d", maxContentLength, MaxContentLengthLimit)
+	}
+
+	return nil
+}
+
+// validateAllowedSourceChannels validates the AllowedSourceChannels param
+func validateAllowedSourceChannels(v interface{}) error {
+	allowedSourceChannels, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateChannelList(allowedSourceChannels)
+}
+
+// validateAllowedDestinationChannels validates the AllowedDestinationChannels param
+func validateAllowedDestinationChannels(v interface{}) error {
+	allowedDestinationChannels, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateChannelList(allowedDestinationChannels)
+}
+
+// validateMaxIndexedTokens validates the MaxIndexedTokens param
+func validateMaxIndexedTokens(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTags validates the MaxTags param
+func validateMaxTags(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTagLength validates the MaxTagLength param
+func validateMaxTagLength(v interface{}) error {
+	maxTagLength, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxTagLength == 0 {
+		return fmt.Errorf("max tag length must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxMentions validates the MaxMentions param
+func validateMaxMentions(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateModeratedChannels validates the ModeratedChannels param
+func validateModeratedChannels(v interface{}) error {
+	moderatedChannels, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateChannelList(moderatedChannels)
+}
+
+// validateModerationExpiryBlocks validates the ModerationExpiryBlocks param
+func validateModerationExpiryBlocks(v interface{}) error {
+	moderationExpiryBlocks, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if moderationExpiryBlocks == 0 {
+		return fmt.Errorf("moderation expiry blocks must be positive")
+	}
+
+	return nil
+}
+
+// validateReportHideThreshold validates the ReportHideThreshold param
+func validateReportHideThreshold(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRateLimitWindowBlocks validates the RateLimitWindowBlocks param
+func validateRateLimitWindowBlocks(v interface{}) error {
+	rateLimitWindowBlocks, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if rateLimitWindowBlocks == 0 {
+		return fmt.Errorf("rate limit window blocks must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxSentPostsPerWindow validates the MaxSentPostsPerWindow param
+func validateMaxSentPostsPerWindow(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxReceivedPacketsPerWindow validates the MaxReceivedPacketsPerWindow param
+func validateMaxReceivedPacketsPerWindow(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateChannelList checks the channel identifiers of a list are valid and unique
+func validateChannelList(channels []string) error {
+	seen := make(map[string]bool)
+	for _, channelID := range channels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return err
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicated channel %s", channelID)
+		}
+		seen[channelID] = true
+	}
+	return nil
+}
@@ -25,6 +25,42 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// maxTitleLength bounds the length of a post title, in characters (unicode code points)
+	MaxTitleLength uint64 `protobuf:"varint,1,opt,name=maxTitleLength,proto3" json:"maxTitleLength,omitempty" yaml:"max_title_length"`
+	// maxContentLength bounds the length of a post content, in characters (unicode code points)
+	MaxContentLength uint64 `protobuf:"varint,2,opt,name=maxContentLength,proto3" json:"maxContentLength,omitempty" yaml:"max_content_length"`
+	// allowedSourceChannels restricts the channels posts can be received from, empty allows all
+	AllowedSourceChannels []string `protobuf:"bytes,3,rep,name=allowedSourceChannels,proto3" json:"allowedSourceChannels,omitempty" yaml:"allowed_source_channels"`
+	// allowedDestinationChannels restricts the channels posts can be sent to, empty allows all
+	AllowedDestinationChannels []string `protobuf:"bytes,4,rep,name=allowedDestinationChannels,proto3" json:"allowedDestinationChannels,omitempty" yaml:"allowed_destination_channels"`
+	// maxIndexedTokens bounds the number of distinct words of a post added to the search index, and so the gas
+	// spent indexing a post, 0 disables the indexing of new posts
+	MaxIndexedTokens uint64 `protobuf:"varint,5,opt,name=maxIndexedTokens,proto3" json:"maxIndexedTokens,omitempty" yaml:"max_indexed_tokens"`
+	// maxTags bounds the number of tags of a post and the number of tags a post is indexed with, hashtags included
+	MaxTags uint64 `protobuf:"varint,6,opt,name=maxTags,proto3" json:"maxTags,omitempty" yaml:"max_tags"`
+	// maxTagLength bounds the length of a tag in characters, longer hashtags are not indexed
+	MaxTagLength uint64 `protobuf:"varint,7,opt,name=maxTagLength,proto3" json:"maxTagLength,omitempty" yaml:"max_tag_length"`
+	// maxMentions bounds the number of addresses notified of a post, and so the gas spent notifying them, 0 disables
+	// the notifications
+	MaxMentions uint64 `protobuf:"varint,8,opt,name=maxMentions,proto3" json:"maxMentions,omitempty" yaml:"max_mentions"`
+	// moderatedChannels are the channels on which received posts are queued until a moderator approves or rejects
+	// them, the acknowledgement of the packet is written then. The comments, reactions, edits and deletions received
+	// on these channels can't be moderated and are refused with an error acknowledgement
+	ModeratedChannels []string `protobuf:"bytes,9,rep,name=moderatedChannels,proto3" json:"moderatedChannels,omitempty" yaml:"moderated_channels"`
+	// moderationExpiryBlocks is the number of blocks a received post stays queued, it is rejected once expired
+	ModerationExpiryBlocks uint64 `protobuf:"varint,10,opt,name=moderationExpiryBlocks,proto3" json:"moderationExpiryBlocks,omitempty" yaml:"moderation_expiry_blocks"`
+	// reportHideThreshold is the number of accounts reporting a post that hides it until a moderator reviews it, 0
+	// disables the hiding
+	ReportHideThreshold uint64 `protobuf:"varint,11,opt,name=reportHideThreshold,proto3" json:"reportHideThreshold,omitempty" yaml:"report_hide_threshold"`
+	// rateLimitWindowBlocks is the number of blocks of the windows the sent posts and the received packets are
+	// counted in, the windows start at the multiples of the number
+	RateLimitWindowBlocks uint64 `protobuf:"varint,12,opt,name=rateLimitWindowBlocks,proto3" json:"rateLimitWindowBlocks,omitempty" yaml:"rate_limit_window_blocks"`
+	// maxSentPostsPerWindow bounds the number of posts an account sends to other chains in a window, retries and
+	// every destination of a broadcast included, 0 disables the limit
+	MaxSentPostsPerWindow uint64 `protobuf:"varint,13,opt,name=maxSentPostsPerWindow,proto3" json:"maxSentPostsPerWindow,omitempty" yaml:"max_sent_posts_per_window"`
+	// maxReceivedPacketsPerWindow bounds the number of packets accepted on a channel in a window, the packets over the
+	// limit are acknowledged with an error, 0 disables the limit
+	MaxReceivedPacketsPerWindow uint64 `protobuf:"varint,14,opt,name=maxReceivedPacketsPerWindow,proto3" json:"maxReceivedPacketsPerWindow,omitempty" yaml:"max_received_packets_per_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +95,104 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxTitleLength() uint64 {
+	if m != nil {
+		return m.MaxTitleLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxContentLength() uint64 {
+	if m != nil {
+		return m.MaxContentLength
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedSourceChannels() []string {
+	if m != nil {
+		return m.AllowedSourceChannels
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedDestinationChannels() []string {
+	if m != nil {
+		return m.AllowedDestinationChannels
+	}
+	return nil
+}
+
+func (m *Params) GetMaxIndexedTokens() uint64 {
+	if m != nil {
+		return m.MaxIndexedTokens
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTags() uint64 {
+	if m != nil {
+		return m.MaxTags
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTagLength() uint64 {
+	if m != nil {
+		return m.MaxTagLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMentions() uint64 {
+	if m != nil {
+		return m.MaxMentions
+	}
+	return 0
+}
+
+func (m *Params) GetModeratedChannels() []string {
+	if m != nil {
+		return m.ModeratedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetModerationExpiryBlocks() uint64 {
+	if m != nil {
+		return m.ModerationExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetReportHideThreshold() uint64 {
+	if m != nil {
+		return m.ReportHideThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetRateLimitWindowBlocks() uint64 {
+	if m != nil {
+		return m.RateLimitWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSentPostsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxSentPostsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReceivedPacketsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxReceivedPacketsPerWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "planet.blog.Params")
 }
@@ -66,15 +200,46 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/params.proto", fileDescriptor_616183f35c929fe8) }
 
 var fileDescriptor_616183f35c929fe8 = []byte{
-	// 128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xc8, 0xe8, 0x81, 0x64, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xe2, 0xfa, 0x20, 0x16, 0x44, 0x89, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58, 0x8b, 0x15,
-	0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xba, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x25, 0x0c, 0xb5, 0xa6, 0x02, 0x62, 0x51, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x14, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xf6, 0x9f, 0x9a, 0x84, 0x00, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0x77, 0x7f, 0xf0, 0xe3, 0xcf, 0x80, 0xa8, 0xb3, 0xae, 0x14, 0xd0, 0xce, 0xa6, 0x18,
+	0x25, 0x26, 0xc0, 0xc1, 0x93, 0x24, 0x5e, 0x16, 0x4d, 0x24, 0x62, 0xb2, 0x29, 0x24, 0x2a, 0x1e,
+	0x9a, 0x61, 0xfb, 0xa4, 0x3b, 0x61, 0x3a, 0xd3, 0x74, 0x46, 0x29, 0xef, 0xc2, 0xa3, 0x47, 0x5f,
+	0x8e, 0x47, 0x8e, 0x9e, 0x1a, 0x03, 0xef, 0xa0, 0x27, 0x8f, 0xa6, 0xd3, 0x61, 0xb7, 0x0b, 0x2b,
+	0xb7, 0x66, 0x9e, 0xcf, 0xf7, 0xf3, 0xcc, 0x3c, 0x93, 0x0e, 0x72, 0x12, 0x4e, 0x05, 0xe8, 0xed,
+	0x63, 0x2e, 0xa3, 0xed, 0x84, 0xa6, 0x34, 0x56, 0x5b, 0x49, 0x2a, 0xb5, 0xc4, 0x0b, 0x55, 0x65,
+	0xab, 0xac, 0xac, 0x3e, 0x88, 0x64, 0x24, 0xcd, 0xfa, 0x76, 0xf9, 0x55, 0x21, 0xde, 0x9f, 0x39,
+	0x34, 0xd3, 0x33, 0x19, 0xbc, 0x8b, 0x96, 0x62, 0x9a, 0x1d, 0x32, 0xcd, 0x61, 0x1f, 0x44, 0xa4,
+	0x07, 0x4e, 0xb3, 0xd3, 0xdc, 0x98, 0xee, 0xae, 0x15, 0x39, 0x59, 0x3e, 0xa3, 0x31, 0xdf, 0xf1,
+	0x62, 0x9a, 0x05, 0xba, 0x04, 0x02, 0x6e, 0x08, 0xcf, 0xbf, 0x16, 0xc1, 0x7b, 0xe8, 0x5e, 0x4c,
+	0xb3, 0x5d, 0x29, 0x34, 0x08, 0x6d, 0x35, 0xff, 0x19, 0xcd, 0xe3, 0x22, 0x27, 0x2b, 0x23, 0x4d,
+	0xbf, 0x42, 0x86, 0xa2, 0x1b, 0x31, 0xfc, 0x11, 0xb5, 0x29, 0xe7, 0xf2, 0x14, 0xc2, 0x03, 0xf9,
+	0x25, 0xed, 0xc3, 0xee, 0x80, 0x0a, 0x01, 0x5c, 0x39, 0x53, 0x9d, 0xa9, 0x8d, 0xf9, 0xae, 0x57,
+	0xe4, 0xc4, 0xad, 0x7c, 0x16, 0x0b, 0x94, 0xe1, 0x82, 0xbe, 0x05, 0x3d, 0x7f, 0xb2, 0x00, 0x47,
+	0x68, 0xd5, 0x16, 0x5e, 0x83, 0xd2, 0x4c, 0x50, 0xcd, 0xa4, 0x18, 0xea, 0xa7, 0x8d, 0xfe, 0x59,
+	0x91, 0x93, 0xf5, 0x71, 0x7d, 0x38, 0x82, 0x6b, 0x3d, 0x6e, 0x51, 0xd9, 0x69, 0xec, 0x89, 0x10,
+	0x32, 0x08, 0x0f, 0xe5, 0x09, 0x08, 0xe5, 0xfc, 0x3f, 0x69, 0x1a, 0xac, 0x42, 0x02, 0x6d, 0x18,
+	0xcf, 0xbf, 0x11, 0xc3, 0x9b, 0x68, 0xb6, 0x1c, 0x35, 0x8d, 0x94, 0x33, 0x63, 0x0c, 0xad, 0x22,
+	0x27, 0x77, 0x6b, 0xd7, 0x42, 0x23, 0xe5, 0xf9, 0x57, 0x0c, 0x7e, 0x85, 0x16, 0xab, 0x4f, 0x7b,
+	0x07, 0xb3, 0x26, 0xb3, 0x52, 0xe4, 0xa4, 0x3d, 0x96, 0x19, 0xce, 0x7f, 0x0c, 0xc7, 0x2f, 0xd1,
+	0x42, 0x4c, 0xb3, 0xf7, 0x20, 0xca, 0xe3, 0x28, 0x67, 0xce, 0xa4, 0x97, 0x8b, 0x9c, 0xb4, 0x46,
+	0xe9, 0xd8, 0x56, 0x3d, 0xbf, 0xce, 0xe2, 0x77, 0xe8, 0x7e, 0x2c, 0x43, 0x48, 0xa9, 0x86, 0x70,
+	0x38, 0xd3, 0x79, 0x33, 0xd3, 0xfa, 0xa1, 0xaf, 0x90, 0xda, 0x24, 0x6f, 0xe6, 0xf0, 0x67, 0xf4,
+	0xd0, 0x2e, 0x32, 0x29, 0xde, 0x64, 0x09, 0x4b, 0xcf, 0xba, 0x5c, 0xf6, 0x4f, 0x94, 0x83, 0xcc,
+	0x96, 0xd6, 0x8b, 0x9c, 0x90, 0x31, 0x63, 0x79, 0x39, 0x60, 0xc0, 0xe0, 0xd8, 0x90, 0x9e, 0xff,
+	0x0f, 0x05, 0xf6, 0x51, 0x2b, 0x85, 0x44, 0xa6, 0xfa, 0x2d, 0x0b, 0xe1, 0x70, 0x90, 0x82, 0x1a,
+	0x48, 0x1e, 0x3a, 0x0b, 0xc6, 0xdc, 0x29, 0x72, 0xf2, 0xa8, 0x32, 0x57, 0x50, 0x30, 0x60, 0x21,
+	0x04, 0xfa, 0x0a, 0xf3, 0xfc, 0x49, 0x61, 0xfc, 0x09, 0xb5, 0xcb, 0x13, 0xec, 0xb3, 0x98, 0xe9,
+	0x0f, 0x4c, 0x84, 0xf2, 0xd4, 0xee, 0x77, 0xf1, 0xfa, 0x7e, 0x4b, 0x2c, 0xe0, 0x25, 0x17, 0x9c,
+	0x1a, 0x70, 0xb8, 0xdf, 0xc9, 0x06, 0x7c, 0x84, 0xda, 0x31, 0xcd, 0x0e, 0x40, 0xe8, 0x9e, 0x54,
+	0x5a, 0xf5, 0x20, 0xad, 0xca, 0xce, 0x1d, 0xa3, 0x7e, 0x52, 0xe4, 0xa4, 0x33, 0xba, 0x1d, 0x55,
+	0xfe, 0x5c, 0x49, 0x09, 0x06, 0x09, 0xa4, 0xb6, 0x85, 0xe7, 0x4f, 0x56, 0x60, 0x8e, 0xd6, 0x62,
+	0x9a, 0xf9, 0xd0, 0x07, 0xf6, 0x15, 0xc2, 0x1e, 0xed, 0x9f, 0x40, 0xbd, 0xc3, 0x92, 0xe9, 0xf0,
+	0xbc, 0xc8, 0xc9, 0xd3, 0x51, 0x87, 0xd4, 0xd2, 0x41, 0x52, 0xe1, 0x63, 0x7d, 0x6e, 0xd3, 0xed,
+	0x4c, 0x7f, 0xff, 0x41, 0x1a, 0xdd, 0xcd, 0x9f, 0x17, 0x6e, 0xf3, 0xfc, 0xc2, 0x6d, 0xfe, 0xbe,
+	0x70, 0x9b, 0xdf, 0x2e, 0xdd, 0xc6, 0xf9, 0xa5, 0xdb, 0xf8, 0x75, 0xe9, 0x36, 0x8e, 0x5a, 0xf6,
+	0x45, 0xcb, 0xaa, 0x37, 0x4d, 0x9f, 0x25, 0xa0, 0x8e, 0x67, 0xcc, 0x83, 0xf5, 0xe2, 0xef, 0x00,
+	0x7e, 0x88, 0x76, 0x92, 0xef, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -97,6 +262,88 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxReceivedPacketsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReceivedPacketsPerWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxSentPostsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSentPostsPerWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RateLimitWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RateLimitWindowBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ReportHideThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportHideThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ModerationExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ModerationExpiryBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ModeratedChannels) > 0 {
+		for iNdEx := len(m.ModeratedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModeratedChannels[iNdEx])
+			copy(dAtA[i:], m.ModeratedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ModeratedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxMentions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMentions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTagLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTagLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTags != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTags))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxIndexedTokens != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIndexedTokens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedDestinationChannels) > 0 {
+		for iNdEx := len(m.AllowedDestinationChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinationChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinationChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDestinationChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedSourceChannels) > 0 {
+		for iNdEx := len(m.AllowedSourceChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSourceChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedSourceChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedSourceChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxContentLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxContentLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTitleLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTitleLength))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -117,6 +364,57 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxTitleLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTitleLength))
+	}
+	if m.MaxContentLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxContentLength))
+	}
+	if len(m.AllowedSourceChannels) > 0 {
+		for _, s := range m.AllowedSourceChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinationChannels) > 0 {
+		for _, s := range m.AllowedDestinationChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxIndexedTokens != 0 {
+		n += 1 + sovParams(uint64(m.MaxIndexedTokens))
+	}
+	if m.MaxTags != 0 {
+		n += 1 + sovParams(uint64(m.MaxTags))
+	}
+	if m.MaxTagLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTagLength))
+	}
+	if m.MaxMentions != 0 {
+		n += 1 + sovParams(uint64(m.MaxMentions))
+	}
+	if len(m.ModeratedChannels) > 0 {
+		for _, s := range m.ModeratedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ModerationExpiryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ModerationExpiryBlocks))
+	}
+	if m.ReportHideThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ReportHideThreshold))
+	}
+	if m.RateLimitWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RateLimitWindowBlocks))
+	}
+	if m.MaxSentPostsPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxSentPostsPerWindow))
+	}
+	if m.MaxReceivedPacketsPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxReceivedPacketsPerWindow))
+	}
 	return n
 }
 
@@ -155,6 +453,311 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleLength", wireType)
+			}
+			m.MaxTitleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContentLength", wireType)
+			}
+			m.MaxContentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContentLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSourceChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSourceChannels = append(m.AllowedSourceChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinationChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinationChannels = append(m.AllowedDestinationChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIndexedTokens", wireType)
+			}
+			m.MaxIndexedTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIndexedTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTags", wireType)
+			}
+			m.MaxTags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTags |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTagLength", wireType)
+			}
+			m.MaxTagLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTagLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMentions", wireType)
+			}
+			m.MaxMentions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMentions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeratedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModeratedChannels = append(m.ModeratedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationExpiryBlocks", wireType)
+			}
+			m.ModerationExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModerationExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHideThreshold", wireType)
+			}
+			m.ReportHideThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportHideThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindowBlocks", wireType)
+			}
+			m.RateLimitWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSentPostsPerWindow", wireType)
+			}
+			m.MaxSentPostsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSentPostsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReceivedPacketsPerWindow", wireType)
+			}
+			m.MaxReceivedPacketsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReceivedPacketsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
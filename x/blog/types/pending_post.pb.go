@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/pending_post.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PendingPost struct {
+	Port      string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Creator   string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sentAt is the unix time in seconds of the block the packet was sent in
+	SentAt     int64    `protobuf:"varint,7,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	SentHeight int64    `protobuf:"varint,8,opt,name=sentHeight,proto3" json:"sentHeight,omitempty"`
+	SentTxHash string   `protobuf:"bytes,9,opt,name=sentTxHash,proto3" json:"sentTxHash,omitempty"`
+	Tags       []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *PendingPost) Reset()         { *m = PendingPost{} }
+func (m *PendingPost) String() string { return proto.CompactTextString(m) }
+func (*PendingPost) ProtoMessage()    {}
+func (*PendingPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3ab74d2ee877d1e, []int{0}
+}
+func (m *PendingPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPost.Merge(m, src)
+}
+func (m *PendingPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPost proto.InternalMessageInfo
+
+func (m *PendingPost) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PendingPost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PendingPost) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingPost) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PendingPost) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *PendingPost) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PendingPost) GetSentAt() int64 {
+	if m != nil {
+		return m.SentAt
+	}
+	return 0
+}
+
+func (m *PendingPost) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *PendingPost) GetSentTxHash() string {
+	if m != nil {
+		return m.SentTxHash
+	}
+	return ""
+}
+
+func (m *PendingPost) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PendingPost)(nil), "planet.blog.PendingPost")
+}
+
+func init() { proto.RegisterFile("planet/blog/pending_post.proto", fileDescriptor_f3ab74d2ee877d1e) }
+
+var fileDescriptor_f3ab74d2ee877d1e = []byte{
+	// 271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4e, 0x83, 0x40,
+	0x14, 0x45, 0x99, 0x42, 0x69, 0x99, 0xee, 0x46, 0x63, 0x5e, 0x8c, 0x99, 0x10, 0x57, 0x6c, 0x6c,
+	0x17, 0x7e, 0x81, 0xc6, 0x45, 0xdd, 0x35, 0xc4, 0x95, 0x1b, 0x43, 0xf1, 0x05, 0x48, 0xc8, 0xcc,
+	0xc8, 0x3c, 0x93, 0xfa, 0x17, 0xfa, 0x57, 0x2e, 0xbb, 0x74, 0x69, 0xe0, 0x47, 0x0c, 0x03, 0xb5,
+	0xdd, 0xdd, 0x73, 0x0f, 0x4c, 0xf2, 0x2e, 0x97, 0xa6, 0xce, 0x14, 0xd2, 0x6a, 0x5b, 0xeb, 0x62,
+	0x65, 0x50, 0xbd, 0x56, 0xaa, 0x78, 0x31, 0xda, 0xd2, 0xd2, 0x34, 0x9a, 0xb4, 0x58, 0x0c, 0x7e,
+	0xd9, 0xfb, 0xeb, 0xaf, 0x09, 0x5f, 0x6c, 0x86, 0x6f, 0x36, 0xda, 0x92, 0x10, 0x3c, 0x30, 0xba,
+	0x21, 0x60, 0x31, 0x4b, 0xa2, 0xd4, 0x65, 0x71, 0xc5, 0xa3, 0xbc, 0xcc, 0x94, 0xc2, 0xfa, 0xf1,
+	0x01, 0x26, 0x4e, 0x1c, 0x0b, 0x71, 0xc9, 0xe7, 0x16, 0xdf, 0xde, 0x51, 0xe5, 0x08, 0x7e, 0xcc,
+	0x92, 0x20, 0xfd, 0x67, 0x71, 0xce, 0xa7, 0x54, 0x51, 0x8d, 0x10, 0xb8, 0xbf, 0x06, 0x10, 0xc0,
+	0x67, 0xb9, 0x56, 0x84, 0x8a, 0x60, 0xea, 0xfa, 0x03, 0x3a, 0xd3, 0x60, 0x46, 0xba, 0x81, 0x70,
+	0x34, 0x03, 0x8a, 0x0b, 0x1e, 0x5a, 0x54, 0x74, 0x47, 0x30, 0x8b, 0x59, 0xe2, 0xa7, 0x23, 0x09,
+	0xc9, 0x79, 0x9f, 0xd6, 0x58, 0x15, 0x25, 0xc1, 0xdc, 0xb9, 0x93, 0xe6, 0xe0, 0x9f, 0x76, 0xeb,
+	0xcc, 0x96, 0x10, 0xb9, 0x47, 0x4f, 0x9a, 0xfe, 0x5e, 0xca, 0x0a, 0x0b, 0x3c, 0xf6, 0xfb, 0x7b,
+	0xfb, 0x7c, 0x7f, 0xf3, 0xdd, 0x4a, 0xb6, 0x6f, 0x25, 0xfb, 0x6d, 0x25, 0xfb, 0xec, 0xa4, 0xb7,
+	0xef, 0xa4, 0xf7, 0xd3, 0x49, 0xef, 0xf9, 0x6c, 0x9c, 0x76, 0x37, 0x8c, 0x4b, 0x1f, 0x06, 0xed,
+	0x36, 0x74, 0xb3, 0xde, 0xfe, 0x0d, 0x00, 0x76, 0x7f, 0x86, 0xaf, 0x78, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPendingPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SentTxHash) > 0 {
+		i -= len(m.SentTxHash)
+		copy(dAtA[i:], m.SentTxHash)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.SentTxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintPendingPost(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintPendingPost(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPendingPost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintPendingPost(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPendingPost(uint64(m.Sequence))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	if m.SentAt != 0 {
+		n += 1 + sovPendingPost(uint64(m.SentAt))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovPendingPost(uint64(m.SentHeight))
+	}
+	l = len(m.SentTxHash)
+	if l > 0 {
+		n += 1 + l + sovPendingPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPendingPost(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPendingPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingPost(x uint64) (n int) {
+	return sovPendingPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingPost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingPost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingPost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingPost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingPost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingPost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingPost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingPost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package types
+
+const (
+	// PostOriginLocal filters the posts created on this chain
+	PostOriginLocal = "local"
+	// PostOriginRemote filters the posts received from another chain
+	PostOriginRemote = "remote"
+)
+
+// IsRemote returns true if the post was received from another chain
+func (p Post) IsRemote() bool {
+	return p.RemoteAuthor != nil
+}
+
+// IsChannelKnown returns false for the authors of the posts received before v2 whose receiving channel couldn't be
+// resolved by the migration. Nothing can be sent back through an unknown channel and the posts can't be changed.
+func (a RemoteAuthor) IsChannelKnown() bool {
+	return a.DestinationChannel != ""
+}
+
+// Author returns the address of the author of the post, on the chain of the post for posts received from
+// another chain
+func (p Post) Author() string {
+	if p.IsRemote() {
+		return p.RemoteAuthor.Address
+	}
+	return p.Creator
+}
+
+// MatchOrigin returns true if the post matches the origin filter, an empty origin matches all posts
+func (p Post) MatchOrigin(origin string) bool {
+	switch origin {
+	case PostOriginLocal:
+		return !p.IsRemote()
+	case PostOriginRemote:
+		return p.IsRemote()
+	default:
+		return true
+	}
+}
+
+// RemoteAuthorIndexValue returns the value indexing the posts of a remote author, the chain ID is length prefixed
+// by IndexKeyPrefix so that the value can't be shared by another chain ID and address pair
+func RemoteAuthorIndexValue(chainID string, address string) string {
+	return string(IndexKeyPrefix(chainID)) + address
+}
@@ -26,7 +26,23 @@ type Post struct {
 	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// creator is the address of the local author, it is empty for posts received from another chain
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// createdAt is the unix time in seconds of the block the post was created or received in
+	CreatedAt     int64 `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CreatedHeight int64 `protobuf:"varint,6,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	// txHash is the hash of the transaction that created or received the post
+	TxHash string `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// remoteAuthor identifies the author of a post received from another chain, it is not set for local posts
+	RemoteAuthor *RemoteAuthor `protobuf:"bytes,8,opt,name=remoteAuthor,proto3" json:"remoteAuthor,omitempty"`
+	// tags are the tags given by the author, the hashtags of the content are indexed along with them
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// hidden is set by a moderator or once the post is reported by enough accounts, a hidden post is kept but the
+	// queries leave it out unless asked for
+	Hidden bool `protobuf:"varint,10,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// hiddenBy is the address of the moderator who hid the post, empty if the post was hidden by its reports
+	HiddenBy     string `protobuf:"bytes,11,opt,name=hiddenBy,proto3" json:"hiddenBy,omitempty"`
+	HiddenReason string `protobuf:"bytes,12,opt,name=hiddenReason,proto3" json:"hiddenReason,omitempty"`
 }
 
 func (m *Post) Reset()         { *m = Post{} }
@@ -90,25 +106,187 @@ func (m *Post) GetCreator() string {
 	return ""
 }
 
+func (m *Post) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Post) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Post) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Post) GetRemoteAuthor() *RemoteAuthor {
+	if m != nil {
+		return m.RemoteAuthor
+	}
+	return nil
+}
+
+func (m *Post) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Post) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *Post) GetHiddenBy() string {
+	if m != nil {
+		return m.HiddenBy
+	}
+	return ""
+}
+
+func (m *Post) GetHiddenReason() string {
+	if m != nil {
+		return m.HiddenReason
+	}
+	return ""
+}
+
+// RemoteAuthor identifies the author of a post received over IBC
+type RemoteAuthor struct {
+	// sourcePort and sourceChannel identify the sending end of the channel the post came through
+	SourcePort    string `protobuf:"bytes,1,opt,name=sourcePort,proto3" json:"sourcePort,omitempty"`
+	SourceChannel string `protobuf:"bytes,2,opt,name=sourceChannel,proto3" json:"sourceChannel,omitempty"`
+	// chainID is the chain ID of the counterparty chain, resolved from the client of the channel
+	ChainID string `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// address is the address of the author on the counterparty chain
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// destinationPort and destinationChannel identify the receiving end of the channel on this chain, replies are
+	// sent back through it. They are empty for the posts received before v2 whose channel couldn't be resolved by the
+	// migration, these posts can't be replied to, edited or deleted
+	DestinationPort    string `protobuf:"bytes,5,opt,name=destinationPort,proto3" json:"destinationPort,omitempty"`
+	DestinationChannel string `protobuf:"bytes,6,opt,name=destinationChannel,proto3" json:"destinationChannel,omitempty"`
+}
+
+func (m *RemoteAuthor) Reset()         { *m = RemoteAuthor{} }
+func (m *RemoteAuthor) String() string { return proto.CompactTextString(m) }
+func (*RemoteAuthor) ProtoMessage()    {}
+func (*RemoteAuthor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be5d14fb1ad7fad3, []int{1}
+}
+func (m *RemoteAuthor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteAuthor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteAuthor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteAuthor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteAuthor.Merge(m, src)
+}
+func (m *RemoteAuthor) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteAuthor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteAuthor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteAuthor proto.InternalMessageInfo
+
+func (m *RemoteAuthor) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *RemoteAuthor) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *RemoteAuthor) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *RemoteAuthor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RemoteAuthor) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *RemoteAuthor) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Post)(nil), "planet.blog.Post")
+	proto.RegisterType((*RemoteAuthor)(nil), "planet.blog.RemoteAuthor")
 }
 
 func init() { proto.RegisterFile("planet/blog/post.proto", fileDescriptor_be5d14fb1ad7fad3) }
 
 var fileDescriptor_be5d14fb1ad7fad3 = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0xc8, 0x2f, 0x2e, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x88, 0xeb, 0x81, 0xc4, 0x95, 0x12, 0xb8, 0x58, 0x02, 0xf2, 0x8b,
-	0x4b, 0x84, 0xf8, 0xb8, 0x98, 0x32, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32,
-	0x53, 0x84, 0x44, 0xb8, 0x58, 0x4b, 0x32, 0x4b, 0x72, 0x52, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38,
-	0x83, 0x20, 0x1c, 0x21, 0x09, 0x2e, 0xf6, 0xe4, 0xfc, 0xbc, 0x92, 0xd4, 0xbc, 0x12, 0x09, 0x66,
-	0xb0, 0x38, 0x8c, 0x0b, 0x96, 0x29, 0x4a, 0x4d, 0x2c, 0xc9, 0x2f, 0x92, 0x60, 0x81, 0xca, 0x40,
-	0xb8, 0x4e, 0xba, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x0c, 0x75,
-	0x60, 0x05, 0xc4, 0x89, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x47, 0x1a, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x2f, 0xff, 0x27, 0x13, 0xbe, 0x00, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0x80, 0xd7, 0xfb, 0xd7, 0xcd, 0xec, 0x02, 0xd2, 0x80, 0x2a, 0x83, 0x50, 0x14, 0xad, 0x38,
+	0xe4, 0x42, 0x2a, 0xc1, 0x99, 0x43, 0x0b, 0x87, 0x72, 0xab, 0x7c, 0xe4, 0xe6, 0xd6, 0xd6, 0xc6,
+	0xd2, 0x62, 0x47, 0xf6, 0x54, 0x6a, 0xdf, 0x82, 0xc7, 0xe2, 0xd8, 0x23, 0x47, 0x94, 0x3d, 0xf0,
+	0x1a, 0x28, 0x76, 0xb6, 0x24, 0xa8, 0xb7, 0xf9, 0xbe, 0x19, 0xc5, 0x93, 0x99, 0x81, 0xd3, 0x66,
+	0x2f, 0xad, 0xa6, 0xb3, 0xeb, 0xbd, 0xdb, 0x9d, 0x35, 0x2e, 0x50, 0xd5, 0x78, 0x47, 0x0e, 0xd7,
+	0xc9, 0x57, 0x9d, 0xdf, 0xfe, 0x99, 0xc2, 0xfc, 0xca, 0x05, 0xc2, 0xe7, 0x30, 0x35, 0x8a, 0xb3,
+	0x82, 0x95, 0x73, 0x31, 0x35, 0x0a, 0x5f, 0xc1, 0x82, 0x0c, 0xed, 0x35, 0x9f, 0x16, 0xac, 0xcc,
+	0x44, 0x02, 0xe4, 0x70, 0x72, 0xe3, 0x2c, 0x69, 0x4b, 0x7c, 0x16, 0xfd, 0x11, 0x63, 0xc6, 0x6b,
+	0x49, 0xce, 0xf3, 0x79, 0x9f, 0x49, 0x88, 0x6f, 0x21, 0x8b, 0xa1, 0x56, 0xe7, 0xc4, 0x17, 0x05,
+	0x2b, 0x67, 0xe2, 0x9f, 0xc0, 0x77, 0xf0, 0xac, 0x87, 0x4b, 0x6d, 0x76, 0x35, 0xf1, 0x65, 0xac,
+	0x18, 0x4b, 0x3c, 0x85, 0x25, 0xdd, 0x5d, 0xca, 0x50, 0xf3, 0x93, 0xf8, 0xf1, 0x9e, 0xf0, 0x13,
+	0x6c, 0xbc, 0xfe, 0xee, 0x48, 0x9f, 0xdf, 0x52, 0xed, 0x3c, 0x5f, 0x15, 0xac, 0x5c, 0x7f, 0x78,
+	0x5d, 0x0d, 0x7e, 0xb1, 0x12, 0x83, 0x02, 0x31, 0x2a, 0x47, 0x84, 0x39, 0xc9, 0x5d, 0xe0, 0x59,
+	0x31, 0x2b, 0x33, 0x11, 0xe3, 0xee, 0xa9, 0xda, 0x28, 0xa5, 0x2d, 0x87, 0x82, 0x95, 0x2b, 0xd1,
+	0x13, 0xbe, 0x81, 0x55, 0x8a, 0x2e, 0xee, 0xf9, 0x3a, 0x36, 0xf1, 0xc8, 0xb8, 0x85, 0x4d, 0x8a,
+	0x85, 0x96, 0xc1, 0x59, 0xbe, 0x89, 0xf9, 0x91, 0xdb, 0xb6, 0x0c, 0x36, 0xc3, 0x56, 0x30, 0x07,
+	0x08, 0xee, 0xd6, 0xdf, 0xe8, 0x2b, 0xe7, 0x29, 0x4e, 0x3e, 0x13, 0x03, 0xd3, 0x4d, 0x26, 0xd1,
+	0xe7, 0x5a, 0x5a, 0xab, 0xf7, 0xfd, 0x26, 0xc6, 0x32, 0xce, 0xbd, 0x96, 0xc6, 0x7e, 0xfd, 0xf2,
+	0xb8, 0x91, 0x84, 0x5d, 0x46, 0x2a, 0xe5, 0x75, 0x08, 0xc7, 0x8d, 0xf4, 0x88, 0x25, 0xbc, 0x50,
+	0x3a, 0x90, 0xb1, 0x92, 0x8c, 0xb3, 0xf1, 0xf9, 0x45, 0xac, 0xf8, 0x5f, 0x63, 0x05, 0x38, 0x50,
+	0xc7, 0x46, 0x96, 0xb1, 0xf8, 0x89, 0xcc, 0xc5, 0xfb, 0x9f, 0x6d, 0xce, 0x1e, 0xda, 0x9c, 0xfd,
+	0x6e, 0x73, 0xf6, 0xe3, 0x90, 0x4f, 0x1e, 0x0e, 0xf9, 0xe4, 0xd7, 0x21, 0x9f, 0x7c, 0x7b, 0xd9,
+	0x5f, 0xe3, 0x5d, 0xba, 0x47, 0xba, 0x6f, 0x74, 0xb8, 0x5e, 0xc6, 0x8b, 0xfc, 0xf8, 0x77, 0x00,
+	0xff, 0xa0, 0xda, 0xe0, 0xab, 0x02, 0x00, 0x00,
 }
 
 func (m *Post) Marshal() (dAtA []byte, err error) {
@@ -131,6 +309,68 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HiddenReason) > 0 {
+		i -= len(m.HiddenReason)
+		copy(dAtA[i:], m.HiddenReason)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.HiddenReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.HiddenBy) > 0 {
+		i -= len(m.HiddenBy)
+		copy(dAtA[i:], m.HiddenBy)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.HiddenBy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RemoteAuthor != nil {
+		{
+			size, err := m.RemoteAuthor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPost(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -160,6 +400,71 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoteAuthor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteAuthor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteAuthor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPost(v)
 	base := offset
@@ -192,6 +497,70 @@ func (m *Post) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPost(uint64(l))
 	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPost(uint64(m.CreatedAt))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovPost(uint64(m.CreatedHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.RemoteAuthor != nil {
+		l = m.RemoteAuthor.Size()
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.Hidden {
+		n += 2
+	}
+	l = len(m.HiddenBy)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.HiddenReason)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteAuthor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
 	return n
 }
 
@@ -345,6 +714,470 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAuthor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteAuthor == nil {
+				m.RemoteAuthor = &RemoteAuthor{}
+			}
+			if err := m.RemoteAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteAuthor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteAuthor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteAuthor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
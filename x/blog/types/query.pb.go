@@ -115,6 +115,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 type QueryGetPostRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// includeHidden returns the post even if it was hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,2,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
 func (m *QueryGetPostRequest) Reset()         { *m = QueryGetPostRequest{} }
@@ -157,8 +159,17 @@ func (m *QueryGetPostRequest) GetId() uint64 {
 	return 0
 }
 
+func (m *QueryGetPostRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
+}
+
 type QueryGetPostResponse struct {
 	Post Post `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post"`
+	// reactions are the numbers of reactions to the post by type
+	Reactions []ReactionCount `protobuf:"bytes,2,rep,name=reactions,proto3" json:"reactions"`
 }
 
 func (m *QueryGetPostResponse) Reset()         { *m = QueryGetPostResponse{} }
@@ -201,8 +212,19 @@ func (m *QueryGetPostResponse) GetPost() Post {
 	return Post{}
 }
 
+func (m *QueryGetPostResponse) GetReactions() []ReactionCount {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type QueryAllPostRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// origin filters the posts on their origin, either "local" or "remote", all the posts are returned if empty
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,3,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
 func (m *QueryAllPostRequest) Reset()         { *m = QueryAllPostRequest{} }
@@ -245,6 +267,20 @@ func (m *QueryAllPostRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllPostRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *QueryAllPostRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
+}
+
 type QueryAllPostResponse struct {
 	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -297,22 +333,25 @@ func (m *QueryAllPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryGetSentPostRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryPostsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,3,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
-func (m *QueryGetSentPostRequest) Reset()         { *m = QueryGetSentPostRequest{} }
-func (m *QueryGetSentPostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetSentPostRequest) ProtoMessage()    {}
-func (*QueryGetSentPostRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPostsByCreatorRequest) Reset()         { *m = QueryPostsByCreatorRequest{} }
+func (m *QueryPostsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByCreatorRequest) ProtoMessage()    {}
+func (*QueryPostsByCreatorRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{6}
 }
-func (m *QueryGetSentPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsByCreatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetSentPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsByCreatorRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -322,41 +361,56 @@ func (m *QueryGetSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetSentPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetSentPostRequest.Merge(m, src)
+func (m *QueryPostsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByCreatorRequest.Merge(m, src)
 }
-func (m *QueryGetSentPostRequest) XXX_Size() int {
+func (m *QueryPostsByCreatorRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetSentPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetSentPostRequest.DiscardUnknown(m)
+func (m *QueryPostsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByCreatorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetSentPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsByCreatorRequest proto.InternalMessageInfo
 
-func (m *QueryGetSentPostRequest) GetId() uint64 {
+func (m *QueryPostsByCreatorRequest) GetCreator() string {
 	if m != nil {
-		return m.Id
+		return m.Creator
 	}
-	return 0
+	return ""
 }
 
-type QueryGetSentPostResponse struct {
-	SentPost SentPost `protobuf:"bytes,1,opt,name=SentPost,proto3" json:"SentPost"`
+func (m *QueryPostsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetSentPostResponse) Reset()         { *m = QueryGetSentPostResponse{} }
-func (m *QueryGetSentPostResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetSentPostResponse) ProtoMessage()    {}
-func (*QueryGetSentPostResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPostsByCreatorRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
+}
+
+type QueryPostsByCreatorResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostsByCreatorResponse) Reset()         { *m = QueryPostsByCreatorResponse{} }
+func (m *QueryPostsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByCreatorResponse) ProtoMessage()    {}
+func (*QueryPostsByCreatorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{7}
 }
-func (m *QueryGetSentPostResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsByCreatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetSentPostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsByCreatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -366,41 +420,52 @@ func (m *QueryGetSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryGetSentPostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetSentPostResponse.Merge(m, src)
+func (m *QueryPostsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByCreatorResponse.Merge(m, src)
 }
-func (m *QueryGetSentPostResponse) XXX_Size() int {
+func (m *QueryPostsByCreatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetSentPostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetSentPostResponse.DiscardUnknown(m)
+func (m *QueryPostsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByCreatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetSentPostResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsByCreatorResponse proto.InternalMessageInfo
 
-func (m *QueryGetSentPostResponse) GetSentPost() SentPost {
+func (m *QueryPostsByCreatorResponse) GetPost() []Post {
 	if m != nil {
-		return m.SentPost
+		return m.Post
 	}
-	return SentPost{}
+	return nil
 }
 
-type QueryAllSentPostRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPostsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllSentPostRequest) Reset()         { *m = QueryAllSentPostRequest{} }
-func (m *QueryAllSentPostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllSentPostRequest) ProtoMessage()    {}
-func (*QueryAllSentPostRequest) Descriptor() ([]byte, []int) {
+type QueryPostsByRemoteAuthorRequest struct {
+	ChainID    string             `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Address    string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,4,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) Reset()         { *m = QueryPostsByRemoteAuthorRequest{} }
+func (m *QueryPostsByRemoteAuthorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByRemoteAuthorRequest) ProtoMessage()    {}
+func (*QueryPostsByRemoteAuthorRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{8}
 }
-func (m *QueryAllSentPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsByRemoteAuthorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsByRemoteAuthorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllSentPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsByRemoteAuthorRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -410,42 +475,63 @@ func (m *QueryAllSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllSentPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllSentPostRequest.Merge(m, src)
+func (m *QueryPostsByRemoteAuthorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByRemoteAuthorRequest.Merge(m, src)
 }
-func (m *QueryAllSentPostRequest) XXX_Size() int {
+func (m *QueryPostsByRemoteAuthorRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllSentPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllSentPostRequest.DiscardUnknown(m)
+func (m *QueryPostsByRemoteAuthorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByRemoteAuthorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllSentPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsByRemoteAuthorRequest proto.InternalMessageInfo
 
-func (m *QueryAllSentPostRequest) GetPagination() *query.PageRequest {
+func (m *QueryPostsByRemoteAuthorRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllSentPostResponse struct {
-	SentPost   []SentPost          `protobuf:"bytes,1,rep,name=SentPost,proto3" json:"SentPost"`
+func (m *QueryPostsByRemoteAuthorRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
+}
+
+type QueryPostsByRemoteAuthorResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSentPostResponse) Reset()         { *m = QueryAllSentPostResponse{} }
-func (m *QueryAllSentPostResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllSentPostResponse) ProtoMessage()    {}
-func (*QueryAllSentPostResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPostsByRemoteAuthorResponse) Reset()         { *m = QueryPostsByRemoteAuthorResponse{} }
+func (m *QueryPostsByRemoteAuthorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByRemoteAuthorResponse) ProtoMessage()    {}
+func (*QueryPostsByRemoteAuthorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{9}
 }
-func (m *QueryAllSentPostResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsByRemoteAuthorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsByRemoteAuthorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllSentPostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsByRemoteAuthorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -455,48 +541,51 @@ func (m *QueryAllSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAllSentPostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllSentPostResponse.Merge(m, src)
+func (m *QueryPostsByRemoteAuthorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByRemoteAuthorResponse.Merge(m, src)
 }
-func (m *QueryAllSentPostResponse) XXX_Size() int {
+func (m *QueryPostsByRemoteAuthorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllSentPostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllSentPostResponse.DiscardUnknown(m)
+func (m *QueryPostsByRemoteAuthorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByRemoteAuthorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllSentPostResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsByRemoteAuthorResponse proto.InternalMessageInfo
 
-func (m *QueryAllSentPostResponse) GetSentPost() []SentPost {
+func (m *QueryPostsByRemoteAuthorResponse) GetPost() []Post {
 	if m != nil {
-		return m.SentPost
+		return m.Post
 	}
 	return nil
 }
 
-func (m *QueryAllSentPostResponse) GetPagination() *query.PageResponse {
+func (m *QueryPostsByRemoteAuthorResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetTimedoutPostRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryPostsBySourceChannelRequest struct {
+	ChannelID  string             `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,3,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
-func (m *QueryGetTimedoutPostRequest) Reset()         { *m = QueryGetTimedoutPostRequest{} }
-func (m *QueryGetTimedoutPostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTimedoutPostRequest) ProtoMessage()    {}
-func (*QueryGetTimedoutPostRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPostsBySourceChannelRequest) Reset()         { *m = QueryPostsBySourceChannelRequest{} }
+func (m *QueryPostsBySourceChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsBySourceChannelRequest) ProtoMessage()    {}
+func (*QueryPostsBySourceChannelRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{10}
 }
-func (m *QueryGetTimedoutPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsBySourceChannelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsBySourceChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTimedoutPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsBySourceChannelRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -506,41 +595,56 @@ func (m *QueryGetTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTimedoutPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTimedoutPostRequest.Merge(m, src)
+func (m *QueryPostsBySourceChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsBySourceChannelRequest.Merge(m, src)
 }
-func (m *QueryGetTimedoutPostRequest) XXX_Size() int {
+func (m *QueryPostsBySourceChannelRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTimedoutPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTimedoutPostRequest.DiscardUnknown(m)
+func (m *QueryPostsBySourceChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsBySourceChannelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTimedoutPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsBySourceChannelRequest proto.InternalMessageInfo
 
-func (m *QueryGetTimedoutPostRequest) GetId() uint64 {
+func (m *QueryPostsBySourceChannelRequest) GetChannelID() string {
 	if m != nil {
-		return m.Id
+		return m.ChannelID
 	}
-	return 0
+	return ""
 }
 
-type QueryGetTimedoutPostResponse struct {
-	TimedoutPost TimedoutPost `protobuf:"bytes,1,opt,name=TimedoutPost,proto3" json:"TimedoutPost"`
+func (m *QueryPostsBySourceChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetTimedoutPostResponse) Reset()         { *m = QueryGetTimedoutPostResponse{} }
-func (m *QueryGetTimedoutPostResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTimedoutPostResponse) ProtoMessage()    {}
-func (*QueryGetTimedoutPostResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPostsBySourceChannelRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
+}
+
+type QueryPostsBySourceChannelResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostsBySourceChannelResponse) Reset()         { *m = QueryPostsBySourceChannelResponse{} }
+func (m *QueryPostsBySourceChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsBySourceChannelResponse) ProtoMessage()    {}
+func (*QueryPostsBySourceChannelResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{11}
 }
-func (m *QueryGetTimedoutPostResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostsBySourceChannelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostsBySourceChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTimedoutPostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostsBySourceChannelResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -550,41 +654,49 @@ func (m *QueryGetTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTimedoutPostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTimedoutPostResponse.Merge(m, src)
+func (m *QueryPostsBySourceChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsBySourceChannelResponse.Merge(m, src)
 }
-func (m *QueryGetTimedoutPostResponse) XXX_Size() int {
+func (m *QueryPostsBySourceChannelResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTimedoutPostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTimedoutPostResponse.DiscardUnknown(m)
+func (m *QueryPostsBySourceChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsBySourceChannelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTimedoutPostResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostsBySourceChannelResponse proto.InternalMessageInfo
 
-func (m *QueryGetTimedoutPostResponse) GetTimedoutPost() TimedoutPost {
+func (m *QueryPostsBySourceChannelResponse) GetPost() []Post {
 	if m != nil {
-		return m.TimedoutPost
+		return m.Post
 	}
-	return TimedoutPost{}
+	return nil
 }
 
-type QueryAllTimedoutPostRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPostsBySourceChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllTimedoutPostRequest) Reset()         { *m = QueryAllTimedoutPostRequest{} }
-func (m *QueryAllTimedoutPostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllTimedoutPostRequest) ProtoMessage()    {}
-func (*QueryAllTimedoutPostRequest) Descriptor() ([]byte, []int) {
+type QueryPostRevisionsRequest struct {
+	PostID     uint64             `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostRevisionsRequest) Reset()         { *m = QueryPostRevisionsRequest{} }
+func (m *QueryPostRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsRequest) ProtoMessage()    {}
+func (*QueryPostRevisionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{12}
 }
-func (m *QueryAllTimedoutPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostRevisionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllTimedoutPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostRevisionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -594,42 +706,49 @@ func (m *QueryAllTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllTimedoutPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllTimedoutPostRequest.Merge(m, src)
+func (m *QueryPostRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsRequest.Merge(m, src)
 }
-func (m *QueryAllTimedoutPostRequest) XXX_Size() int {
+func (m *QueryPostRevisionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllTimedoutPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllTimedoutPostRequest.DiscardUnknown(m)
+func (m *QueryPostRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllTimedoutPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostRevisionsRequest proto.InternalMessageInfo
 
-func (m *QueryAllTimedoutPostRequest) GetPagination() *query.PageRequest {
+func (m *QueryPostRevisionsRequest) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *QueryPostRevisionsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllTimedoutPostResponse struct {
-	TimedoutPost []TimedoutPost      `protobuf:"bytes,1,rep,name=TimedoutPost,proto3" json:"TimedoutPost"`
+type QueryPostRevisionsResponse struct {
+	PostRevision []PostRevision      `protobuf:"bytes,1,rep,name=PostRevision,proto3" json:"PostRevision"`
 	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllTimedoutPostResponse) Reset()         { *m = QueryAllTimedoutPostResponse{} }
-func (m *QueryAllTimedoutPostResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllTimedoutPostResponse) ProtoMessage()    {}
-func (*QueryAllTimedoutPostResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPostRevisionsResponse) Reset()         { *m = QueryPostRevisionsResponse{} }
+func (m *QueryPostRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsResponse) ProtoMessage()    {}
+func (*QueryPostRevisionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1671705bf75f3f4, []int{13}
 }
-func (m *QueryAllTimedoutPostResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPostRevisionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPostRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllTimedoutPostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPostRevisionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -639,1131 +758,14435 @@ func (m *QueryAllTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllTimedoutPostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllTimedoutPostResponse.Merge(m, src)
+func (m *QueryPostRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsResponse.Merge(m, src)
 }
-func (m *QueryAllTimedoutPostResponse) XXX_Size() int {
+func (m *QueryPostRevisionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllTimedoutPostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllTimedoutPostResponse.DiscardUnknown(m)
+func (m *QueryPostRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllTimedoutPostResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPostRevisionsResponse proto.InternalMessageInfo
 
-func (m *QueryAllTimedoutPostResponse) GetTimedoutPost() []TimedoutPost {
+func (m *QueryPostRevisionsResponse) GetPostRevision() []PostRevision {
 	if m != nil {
-		return m.TimedoutPost
+		return m.PostRevision
 	}
 	return nil
 }
 
-func (m *QueryAllTimedoutPostResponse) GetPagination() *query.PageResponse {
+func (m *QueryPostRevisionsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "planet.blog.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "planet.blog.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPostRequest)(nil), "planet.blog.QueryGetPostRequest")
-	proto.RegisterType((*QueryGetPostResponse)(nil), "planet.blog.QueryGetPostResponse")
-	proto.RegisterType((*QueryAllPostRequest)(nil), "planet.blog.QueryAllPostRequest")
-	proto.RegisterType((*QueryAllPostResponse)(nil), "planet.blog.QueryAllPostResponse")
-	proto.RegisterType((*QueryGetSentPostRequest)(nil), "planet.blog.QueryGetSentPostRequest")
-	proto.RegisterType((*QueryGetSentPostResponse)(nil), "planet.blog.QueryGetSentPostResponse")
-	proto.RegisterType((*QueryAllSentPostRequest)(nil), "planet.blog.QueryAllSentPostRequest")
-	proto.RegisterType((*QueryAllSentPostResponse)(nil), "planet.blog.QueryAllSentPostResponse")
-	proto.RegisterType((*QueryGetTimedoutPostRequest)(nil), "planet.blog.QueryGetTimedoutPostRequest")
-	proto.RegisterType((*QueryGetTimedoutPostResponse)(nil), "planet.blog.QueryGetTimedoutPostResponse")
-	proto.RegisterType((*QueryAllTimedoutPostRequest)(nil), "planet.blog.QueryAllTimedoutPostRequest")
-	proto.RegisterType((*QueryAllTimedoutPostResponse)(nil), "planet.blog.QueryAllTimedoutPostResponse")
+type QueryPostAtRevisionRequest struct {
+	PostID   uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func init() { proto.RegisterFile("planet/blog/query.proto", fileDescriptor_b1671705bf75f3f4) }
-
-var fileDescriptor_b1671705bf75f3f4 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0xbb, 0x05, 0xab, 0x19, 0x8c, 0x86, 0x29, 0x3f, 0xca, 0x16, 0xb7, 0x30, 0x82, 0x80,
-	0x86, 0x9d, 0x80, 0x07, 0xcf, 0x85, 0x44, 0x3c, 0x62, 0xf1, 0x64, 0x62, 0xcc, 0x96, 0x4e, 0xd6,
-	0x8d, 0xcb, 0xce, 0xc2, 0x0c, 0x46, 0xfc, 0x71, 0xf1, 0x68, 0x38, 0x98, 0x78, 0xf5, 0x4f, 0xf0,
-	0x0f, 0xe1, 0x48, 0xe2, 0xc5, 0x93, 0x1a, 0xf0, 0x0f, 0x31, 0x3b, 0xf3, 0x0a, 0x3b, 0xed, 0x94,
-	0xd6, 0xa4, 0x37, 0x98, 0xf7, 0x9d, 0xf7, 0xfd, 0xbc, 0xf7, 0x98, 0xb7, 0xa0, 0xe9, 0x34, 0x0e,
-	0x12, 0x26, 0x69, 0x33, 0xe6, 0x21, 0xdd, 0x3f, 0x64, 0x07, 0x47, 0x7e, 0x7a, 0xc0, 0x25, 0xc7,
-	0x63, 0x3a, 0xe0, 0x67, 0x01, 0x77, 0x22, 0xe4, 0x21, 0x57, 0xe7, 0x34, 0xfb, 0x49, 0x4b, 0xdc,
-	0xd9, 0x90, 0xf3, 0x30, 0x66, 0x34, 0x48, 0x23, 0x1a, 0x24, 0x09, 0x97, 0x81, 0x8c, 0x78, 0x22,
-	0x20, 0x7a, 0x7f, 0x97, 0x8b, 0x3d, 0x2e, 0x68, 0x33, 0x10, 0x4c, 0x67, 0xa6, 0x6f, 0xd6, 0x9a,
-	0x4c, 0x06, 0x6b, 0x34, 0x0d, 0xc2, 0x28, 0x51, 0x62, 0xd0, 0x56, 0xf2, 0x14, 0x69, 0x70, 0x10,
-	0xec, 0xb5, 0xb3, 0x4c, 0x19, 0x11, 0x2e, 0x24, 0x9c, 0x57, 0xf3, 0xe7, 0x82, 0x25, 0xf2, 0x65,
-	0x2e, 0x58, 0xcb, 0x07, 0x65, 0xb4, 0xc7, 0x5a, 0xfc, 0x30, 0x2f, 0x20, 0x13, 0x08, 0x3f, 0xcd,
-	0x88, 0xb6, 0x95, 0x55, 0x83, 0xed, 0x1f, 0x32, 0x21, 0xc9, 0x13, 0x54, 0x36, 0x4e, 0x45, 0xca,
-	0x13, 0xc1, 0xf0, 0x1a, 0x2a, 0x69, 0xa4, 0x8a, 0x33, 0xe7, 0x2c, 0x8f, 0xad, 0x97, 0xfd, 0x5c,
-	0x6b, 0x7c, 0x2d, 0xde, 0x18, 0x3d, 0xf9, 0x55, 0x2b, 0x34, 0x40, 0x48, 0x16, 0x21, 0xd3, 0x16,
-	0x93, 0xdb, 0x5c, 0x48, 0x30, 0xc0, 0xb7, 0x50, 0x31, 0x6a, 0xa9, 0x2c, 0xa3, 0x8d, 0x62, 0xd4,
-	0x22, 0x9b, 0x68, 0xc2, 0x94, 0x81, 0xe3, 0x03, 0x34, 0x9a, 0xfd, 0x0e, 0x7e, 0xe3, 0xa6, 0x1f,
-	0x17, 0x12, 0xdc, 0x94, 0x88, 0xbc, 0x00, 0xaf, 0x7a, 0x1c, 0xe7, 0xbd, 0x1e, 0x23, 0x74, 0xd9,
-	0x66, 0xc8, 0x74, 0xcf, 0xd7, 0x33, 0xf1, 0xb3, 0x99, 0xf8, 0x7a, 0xda, 0x30, 0x13, 0x7f, 0x3b,
-	0x08, 0x19, 0xdc, 0x6d, 0xe4, 0x6e, 0x92, 0x63, 0x07, 0x20, 0x2f, 0xf2, 0x77, 0x41, 0x8e, 0xf4,
-	0x85, 0xc4, 0x5b, 0x06, 0x4d, 0x51, 0xd1, 0x2c, 0xf5, 0xa5, 0xd1, 0x4e, 0x06, 0xce, 0x0a, 0x9a,
-	0x6e, 0xb7, 0x6c, 0x87, 0x25, 0x57, 0x76, 0x77, 0x07, 0x55, 0xba, 0xa5, 0x00, 0xff, 0x08, 0xdd,
-	0x68, 0x9f, 0x41, 0x6f, 0x26, 0x8d, 0x02, 0xda, 0x41, 0x28, 0xe2, 0x42, 0x4c, 0x02, 0xf0, 0xaf,
-	0xc7, 0x71, 0xa7, 0xff, 0xb0, 0x3a, 0xfe, 0xcd, 0x01, 0x70, 0xc3, 0xc3, 0x0a, 0x3e, 0x32, 0x30,
-	0xf8, 0xf0, 0x26, 0xb0, 0x8a, 0xaa, 0xed, 0xb6, 0x3e, 0x83, 0xa7, 0x75, 0xd5, 0x14, 0x76, 0xd1,
-	0xac, 0x5d, 0x0e, 0x05, 0x6d, 0xa2, 0x9b, 0xf9, 0x73, 0xe8, 0xdb, 0x8c, 0x51, 0x54, 0x5e, 0x00,
-	0x85, 0x19, 0x97, 0x08, 0x03, 0xa6, 0x7a, 0x1c, 0xdb, 0x98, 0x86, 0x35, 0x99, 0xef, 0x0e, 0x14,
-	0xd3, 0xe5, 0xd3, 0xb3, 0x98, 0x91, 0xff, 0x2e, 0x66, 0x68, 0x93, 0x5a, 0xff, 0x5d, 0x42, 0xd7,
-	0x14, 0x2e, 0x7e, 0x85, 0x4a, 0x7a, 0x4f, 0xe1, 0x9a, 0xc1, 0xd2, 0xbd, 0x04, 0xdd, 0xb9, 0xde,
-	0x02, 0x6d, 0x41, 0xaa, 0x9f, 0x7e, 0xfc, 0xfd, 0x5a, 0x9c, 0xc4, 0x65, 0xda, 0xbd, 0xb5, 0xf1,
-	0x6b, 0xbd, 0x15, 0xb0, 0x25, 0x8d, 0xb9, 0x0c, 0xdd, 0xf9, 0x2b, 0x14, 0xe0, 0xe4, 0x29, 0xa7,
-	0x0a, 0x9e, 0xa2, 0x9d, 0x5f, 0x01, 0xfa, 0x3e, 0x6a, 0x7d, 0xc4, 0x11, 0xba, 0x9e, 0xe9, 0xeb,
-	0x71, 0x6c, 0xf3, 0x33, 0x17, 0xa2, 0xcd, 0xaf, 0x63, 0xa5, 0x91, 0x19, 0xe5, 0x57, 0xc6, 0xe3,
-	0x5d, 0x7e, 0xf8, 0xc3, 0xe5, 0xbb, 0xc3, 0x0b, 0x56, 0xf2, 0x8e, 0x75, 0xe0, 0x2e, 0xf6, 0x51,
-	0x81, 0xe7, 0x5d, 0xe5, 0x79, 0x07, 0x57, 0xa9, 0xf5, 0x8b, 0xa6, 0x0b, 0x7d, 0x87, 0xc6, 0xda,
-	0x17, 0xb3, 0x62, 0x17, 0xac, 0xa5, 0x0c, 0x00, 0x60, 0xd9, 0x28, 0x3d, 0x9a, 0x7c, 0x01, 0x80,
-	0x8f, 0x1d, 0xf3, 0x8f, 0x1a, 0x2f, 0x5b, 0x0b, 0xb3, 0xbc, 0x3b, 0x77, 0x65, 0x00, 0x25, 0x50,
-	0x2c, 0x29, 0x8a, 0x79, 0x5c, 0xa3, 0x3d, 0xbf, 0xdd, 0xba, 0x15, 0x9f, 0x1d, 0x74, 0x3b, 0x9f,
-	0x21, 0xeb, 0xc7, 0xb2, 0xb5, 0xd2, 0x01, 0x89, 0x7a, 0xbc, 0x65, 0x42, 0x14, 0xd1, 0x2c, 0x76,
-	0x7b, 0x13, 0x6d, 0xac, 0x9e, 0x9c, 0x79, 0xce, 0xe9, 0x99, 0xe7, 0xfc, 0x39, 0xf3, 0x9c, 0x2f,
-	0xe7, 0x5e, 0xe1, 0xf4, 0xdc, 0x2b, 0xfc, 0x3c, 0xf7, 0x0a, 0xcf, 0xcb, 0x70, 0xe9, 0x2d, 0x5c,
-	0x3b, 0x4a, 0x99, 0x68, 0x96, 0xd4, 0x7f, 0x1f, 0x0f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0xa6, 0xd6, 0x10, 0x75, 0x09, 0x00, 0x00,
+func (m *QueryPostAtRevisionRequest) Reset()         { *m = QueryPostAtRevisionRequest{} }
+func (m *QueryPostAtRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostAtRevisionRequest) ProtoMessage()    {}
+func (*QueryPostAtRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{14}
+}
+func (m *QueryPostAtRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostAtRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostAtRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostAtRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostAtRevisionRequest.Merge(m, src)
+}
+func (m *QueryPostAtRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostAtRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostAtRevisionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryPostAtRevisionRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Post by id.
-	Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error)
-	// Queries a list of Post items.
-	PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error)
-	// Queries a SentPost by id.
-	SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error)
-	// Queries a list of SentPost items.
-	SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error)
-	// Queries a TimedoutPost by id.
-	TimedoutPost(ctx context.Context, in *QueryGetTimedoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimedoutPostResponse, error)
-	// Queries a list of TimedoutPost items.
-	TimedoutPostAll(ctx context.Context, in *QueryAllTimedoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimedoutPostResponse, error)
+func (m *QueryPostAtRevisionRequest) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPostAtRevisionRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryPostAtRevisionResponse struct {
+	PostRevision PostRevision `protobuf:"bytes,1,opt,name=PostRevision,proto3" json:"PostRevision"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPostAtRevisionResponse) Reset()         { *m = QueryPostAtRevisionResponse{} }
+func (m *QueryPostAtRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostAtRevisionResponse) ProtoMessage()    {}
+func (*QueryPostAtRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{15}
+}
+func (m *QueryPostAtRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostAtRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostAtRevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPostAtRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostAtRevisionResponse.Merge(m, src)
+}
+func (m *QueryPostAtRevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostAtRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostAtRevisionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error) {
-	out := new(QueryGetPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/Post", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPostAtRevisionResponse proto.InternalMessageInfo
+
+func (m *QueryPostAtRevisionResponse) GetPostRevision() PostRevision {
+	if m != nil {
+		return m.PostRevision
 	}
-	return out, nil
+	return PostRevision{}
 }
 
-func (c *queryClient) PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error) {
-	out := new(QueryAllPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySearchPostsRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// operator is either "and" to match the posts with all the words of the query, or "or" to match the posts
+	// with any of them. It defaults to "and".
+	Operator   string             `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,4,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
-func (c *queryClient) SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error) {
-	out := new(QueryGetSentPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySearchPostsRequest) Reset()         { *m = QuerySearchPostsRequest{} }
+func (m *QuerySearchPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchPostsRequest) ProtoMessage()    {}
+func (*QuerySearchPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{16}
+}
+func (m *QuerySearchPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySearchPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchPostsRequest.Merge(m, src)
+}
+func (m *QuerySearchPostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchPostsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error) {
-	out := new(QueryAllSentPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySearchPostsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchPostsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TimedoutPost(ctx context.Context, in *QueryGetTimedoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimedoutPostResponse, error) {
-	out := new(QueryGetTimedoutPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimedoutPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySearchPostsRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TimedoutPostAll(ctx context.Context, in *QueryAllTimedoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimedoutPostResponse, error) {
-	out := new(QueryAllTimedoutPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimedoutPostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySearchPostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Post by id.
-	Post(context.Context, *QueryGetPostRequest) (*QueryGetPostResponse, error)
-	// Queries a list of Post items.
-	PostAll(context.Context, *QueryAllPostRequest) (*QueryAllPostResponse, error)
-	// Queries a SentPost by id.
-	SentPost(context.Context, *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error)
-	// Queries a list of SentPost items.
-	SentPostAll(context.Context, *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error)
-	// Queries a TimedoutPost by id.
-	TimedoutPost(context.Context, *QueryGetTimedoutPostRequest) (*QueryGetTimedoutPostResponse, error)
-	// Queries a list of TimedoutPost items.
-	TimedoutPostAll(context.Context, *QueryAllTimedoutPostRequest) (*QueryAllTimedoutPostResponse, error)
+func (m *QuerySearchPostsRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QuerySearchPostsResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Post(ctx context.Context, req *QueryGetPostRequest) (*QueryGetPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+func (m *QuerySearchPostsResponse) Reset()         { *m = QuerySearchPostsResponse{} }
+func (m *QuerySearchPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchPostsResponse) ProtoMessage()    {}
+func (*QuerySearchPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{17}
 }
-func (*UnimplementedQueryServer) PostAll(ctx context.Context, req *QueryAllPostRequest) (*QueryAllPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostAll not implemented")
+func (m *QuerySearchPostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SentPost(ctx context.Context, req *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SentPost not implemented")
+func (m *QuerySearchPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchPostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SentPostAll(ctx context.Context, req *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SentPostAll not implemented")
+func (m *QuerySearchPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchPostsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TimedoutPost(ctx context.Context, req *QueryGetTimedoutPostRequest) (*QueryGetTimedoutPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TimedoutPost not implemented")
+func (m *QuerySearchPostsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TimedoutPostAll(ctx context.Context, req *QueryAllTimedoutPostRequest) (*QueryAllTimedoutPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TimedoutPostAll not implemented")
+func (m *QuerySearchPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchPostsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QuerySearchPostsResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySearchPostsResponse) GetPost() []Post {
+	if m != nil {
+		return m.Post
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Post(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/Post",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Post(ctx, req.(*QueryGetPostRequest))
+func (m *QuerySearchPostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PostAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/PostAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PostAll(ctx, req.(*QueryAllPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPostsByTagRequest struct {
+	Tag        string             `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// includeHidden includes the posts hidden by a moderator
+	IncludeHidden bool `protobuf:"varint,3,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
-func _Query_SentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSentPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SentPost(ctx, in)
+func (m *QueryPostsByTagRequest) Reset()         { *m = QueryPostsByTagRequest{} }
+func (m *QueryPostsByTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByTagRequest) ProtoMessage()    {}
+func (*QueryPostsByTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{18}
+}
+func (m *QueryPostsByTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostsByTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostsByTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/SentPost",
+}
+func (m *QueryPostsByTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByTagRequest.Merge(m, src)
+}
+func (m *QueryPostsByTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostsByTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostsByTagRequest proto.InternalMessageInfo
+
+func (m *QueryPostsByTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SentPost(ctx, req.(*QueryGetSentPostRequest))
+	return ""
+}
+
+func (m *QueryPostsByTagRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SentPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSentPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPostsByTagRequest) GetIncludeHidden() bool {
+	if m != nil {
+		return m.IncludeHidden
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SentPostAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/SentPostAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SentPostAll(ctx, req.(*QueryAllSentPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_TimedoutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTimedoutPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TimedoutPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/TimedoutPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TimedoutPost(ctx, req.(*QueryGetTimedoutPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPostsByTagResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_TimedoutPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTimedoutPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TimedoutPostAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/TimedoutPostAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TimedoutPostAll(ctx, req.(*QueryAllTimedoutPostRequest))
+func (m *QueryPostsByTagResponse) Reset()         { *m = QueryPostsByTagResponse{} }
+func (m *QueryPostsByTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostsByTagResponse) ProtoMessage()    {}
+func (*QueryPostsByTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{19}
+}
+func (m *QueryPostsByTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostsByTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostsByTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPostsByTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostsByTagResponse.Merge(m, src)
+}
+func (m *QueryPostsByTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostsByTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostsByTagResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "planet.blog.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Post",
-			Handler:    _Query_Post_Handler,
-		},
-		{
-			MethodName: "PostAll",
-			Handler:    _Query_PostAll_Handler,
-		},
-		{
-			MethodName: "SentPost",
-			Handler:    _Query_SentPost_Handler,
-		},
-		{
-			MethodName: "SentPostAll",
-			Handler:    _Query_SentPostAll_Handler,
-		},
-		{
-			MethodName: "TimedoutPost",
-			Handler:    _Query_TimedoutPost_Handler,
-		},
-		{
-			MethodName: "TimedoutPostAll",
-			Handler:    _Query_TimedoutPostAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "planet/blog/query.proto",
+var xxx_messageInfo_QueryPostsByTagResponse proto.InternalMessageInfo
+
+func (m *QueryPostsByTagResponse) GetPost() []Post {
+	if m != nil {
+		return m.Post
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPostsByTagResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopTagsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTopTagsRequest) Reset()         { *m = QueryTopTagsRequest{} }
+func (m *QueryTopTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopTagsRequest) ProtoMessage()    {}
+func (*QueryTopTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{20}
+}
+func (m *QueryTopTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopTagsRequest.Merge(m, src)
+}
+func (m *QueryTopTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopTagsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopTagsRequest proto.InternalMessageInfo
+
+func (m *QueryTopTagsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopTagsResponse struct {
+	TagCount   []TagCount          `protobuf:"bytes,1,rep,name=TagCount,proto3" json:"TagCount"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTopTagsResponse) Reset()         { *m = QueryTopTagsResponse{} }
+func (m *QueryTopTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopTagsResponse) ProtoMessage()    {}
+func (*QueryTopTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{21}
+}
+func (m *QueryTopTagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopTagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTopTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopTagsResponse.Merge(m, src)
 }
-
-func (m *QueryGetPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopTagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopTagsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryTopTagsResponse proto.InternalMessageInfo
+
+func (m *QueryTopTagsResponse) GetTagCount() []TagCount {
+	if m != nil {
+		return m.TagCount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTopTagsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetNotificationRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetNotificationRequest) Reset()         { *m = QueryGetNotificationRequest{} }
+func (m *QueryGetNotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNotificationRequest) ProtoMessage()    {}
+func (*QueryGetNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{22}
+}
+func (m *QueryGetNotificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNotificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNotificationRequest.Merge(m, src)
+}
+func (m *QueryGetNotificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNotificationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetNotificationRequest proto.InternalMessageInfo
+
+func (m *QueryGetNotificationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetNotificationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryAllPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryGetNotificationResponse struct {
+	Notification Notification `protobuf:"bytes,1,opt,name=Notification,proto3" json:"Notification"`
+}
+
+func (m *QueryGetNotificationResponse) Reset()         { *m = QueryGetNotificationResponse{} }
+func (m *QueryGetNotificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNotificationResponse) ProtoMessage()    {}
+func (*QueryGetNotificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{23}
+}
+func (m *QueryGetNotificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNotificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNotificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetNotificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNotificationResponse.Merge(m, src)
+}
+func (m *QueryGetNotificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNotificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNotificationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetNotificationResponse proto.InternalMessageInfo
+
+func (m *QueryGetNotificationResponse) GetNotification() Notification {
+	if m != nil {
+		return m.Notification
 	}
-	return dAtA[:n], nil
+	return Notification{}
 }
 
-func (m *QueryAllPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNotificationsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unreadOnly restricts the notifications to the unread ones
+	UnreadOnly bool               `protobuf:"varint,2,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Post) > 0 {
-		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryNotificationsRequest) Reset()         { *m = QueryNotificationsRequest{} }
+func (m *QueryNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNotificationsRequest) ProtoMessage()    {}
+func (*QueryNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{24}
+}
+func (m *QueryNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNotificationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetSentPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNotificationsRequest.Merge(m, src)
+}
+func (m *QueryNotificationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNotificationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNotificationsRequest proto.InternalMessageInfo
+
+func (m *QueryNotificationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryGetSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryNotificationsRequest) GetUnreadOnly() bool {
+	if m != nil {
+		return m.UnreadOnly
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryGetSentPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNotificationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNotificationsResponse struct {
+	Notification []Notification      `protobuf:"bytes,1,rep,name=Notification,proto3" json:"Notification"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SentPost.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNotificationsResponse) Reset()         { *m = QueryNotificationsResponse{} }
+func (m *QueryNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNotificationsResponse) ProtoMessage()    {}
+func (*QueryNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{25}
+}
+func (m *QueryNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNotificationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllSentPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNotificationsResponse.Merge(m, src)
 }
-
-func (m *QueryAllSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNotificationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNotificationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryNotificationsResponse proto.InternalMessageInfo
+
+func (m *QueryNotificationsResponse) GetNotification() []Notification {
+	if m != nil {
+		return m.Notification
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllSentPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNotificationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetInboundPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryAllSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SentPost) > 0 {
-		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetInboundPostRequest) Reset()         { *m = QueryGetInboundPostRequest{} }
+func (m *QueryGetInboundPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetInboundPostRequest) ProtoMessage()    {}
+func (*QueryGetInboundPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{26}
+}
+func (m *QueryGetInboundPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetInboundPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetInboundPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetInboundPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetInboundPostRequest.Merge(m, src)
+}
+func (m *QueryGetInboundPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetInboundPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetInboundPostRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTimedoutPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetInboundPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetInboundPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetTimedoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetInboundPostResponse struct {
+	InboundPost InboundPost `protobuf:"bytes,1,opt,name=InboundPost,proto3" json:"InboundPost"`
 }
 
-func (m *QueryGetTimedoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetInboundPostResponse) Reset()         { *m = QueryGetInboundPostResponse{} }
+func (m *QueryGetInboundPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetInboundPostResponse) ProtoMessage()    {}
+func (*QueryGetInboundPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{27}
+}
+func (m *QueryGetInboundPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetInboundPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetInboundPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetInboundPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetInboundPostResponse.Merge(m, src)
+}
+func (m *QueryGetInboundPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetInboundPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetInboundPostResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTimedoutPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetInboundPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetInboundPostResponse) GetInboundPost() InboundPost {
+	if m != nil {
+		return m.InboundPost
 	}
-	return dAtA[:n], nil
+	return InboundPost{}
 }
 
-func (m *QueryGetTimedoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllInboundPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTimedoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TimedoutPost.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllInboundPostRequest) Reset()         { *m = QueryAllInboundPostRequest{} }
+func (m *QueryAllInboundPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInboundPostRequest) ProtoMessage()    {}
+func (*QueryAllInboundPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{28}
+}
+func (m *QueryAllInboundPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllInboundPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllInboundPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllInboundPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInboundPostRequest.Merge(m, src)
+}
+func (m *QueryAllInboundPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllInboundPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInboundPostRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllTimedoutPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllInboundPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllInboundPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllTimedoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllInboundPostResponse struct {
+	InboundPost []InboundPost       `protobuf:"bytes,1,rep,name=InboundPost,proto3" json:"InboundPost"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllTimedoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllInboundPostResponse) Reset()         { *m = QueryAllInboundPostResponse{} }
+func (m *QueryAllInboundPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllInboundPostResponse) ProtoMessage()    {}
+func (*QueryAllInboundPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{29}
+}
+func (m *QueryAllInboundPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllInboundPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllInboundPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllInboundPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllInboundPostResponse.Merge(m, src)
+}
+func (m *QueryAllInboundPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllInboundPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllInboundPostResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllTimedoutPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllInboundPostResponse proto.InternalMessageInfo
+
+func (m *QueryAllInboundPostResponse) GetInboundPost() []InboundPost {
+	if m != nil {
+		return m.InboundPost
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllTimedoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllInboundPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllTimedoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TimedoutPost) > 0 {
-		for iNdEx := len(m.TimedoutPost) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TimedoutPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryModeratorsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryModeratorsRequest) Reset()         { *m = QueryModeratorsRequest{} }
+func (m *QueryModeratorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModeratorsRequest) ProtoMessage()    {}
+func (*QueryModeratorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{30}
+}
+func (m *QueryModeratorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModeratorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModeratorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryModeratorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModeratorsRequest.Merge(m, src)
+}
+func (m *QueryModeratorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModeratorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModeratorsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryModeratorsRequest proto.InternalMessageInfo
+
+func (m *QueryModeratorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+type QueryModeratorsResponse struct {
+	Moderator  []Moderator         `protobuf:"bytes,1,rep,name=Moderator,proto3" json:"Moderator"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModeratorsResponse) Reset()         { *m = QueryModeratorsResponse{} }
+func (m *QueryModeratorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModeratorsResponse) ProtoMessage()    {}
+func (*QueryModeratorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{31}
+}
+func (m *QueryModeratorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModeratorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModeratorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryModeratorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModeratorsResponse.Merge(m, src)
+}
+func (m *QueryModeratorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModeratorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModeratorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryModeratorsResponse proto.InternalMessageInfo
+
+func (m *QueryModeratorsResponse) GetModerator() []Moderator {
+	if m != nil {
+		return m.Moderator
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModeratorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Post.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryModerationLogRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Post) > 0 {
-		for _, e := range m.Post {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryModerationLogRequest) Reset()         { *m = QueryModerationLogRequest{} }
+func (m *QueryModerationLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModerationLogRequest) ProtoMessage()    {}
+func (*QueryModerationLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{32}
+}
+func (m *QueryModerationLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModerationLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModerationLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryModerationLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModerationLogRequest.Merge(m, src)
+}
+func (m *QueryModerationLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModerationLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModerationLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModerationLogRequest proto.InternalMessageInfo
+
+func (m *QueryModerationLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetSentPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryModerationLogResponse struct {
+	ModerationAction []ModerationAction  `protobuf:"bytes,1,rep,name=ModerationAction,proto3" json:"ModerationAction"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryModerationLogResponse) Reset()         { *m = QueryModerationLogResponse{} }
+func (m *QueryModerationLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModerationLogResponse) ProtoMessage()    {}
+func (*QueryModerationLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{33}
+}
+func (m *QueryModerationLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModerationLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModerationLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+}
+func (m *QueryModerationLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModerationLogResponse.Merge(m, src)
+}
+func (m *QueryModerationLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModerationLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModerationLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModerationLogResponse proto.InternalMessageInfo
+
+func (m *QueryModerationLogResponse) GetModerationAction() []ModerationAction {
+	if m != nil {
+		return m.ModerationAction
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetSentPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModerationLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.SentPost.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllSentPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryBannedAuthorsRequest struct {
+	// channelID restricts the authors to the ones banned on a channel
+	ChannelID  string             `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBannedAuthorsRequest) Reset()         { *m = QueryBannedAuthorsRequest{} }
+func (m *QueryBannedAuthorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBannedAuthorsRequest) ProtoMessage()    {}
+func (*QueryBannedAuthorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{34}
+}
+func (m *QueryBannedAuthorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBannedAuthorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBannedAuthorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBannedAuthorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBannedAuthorsRequest.Merge(m, src)
+}
+func (m *QueryBannedAuthorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBannedAuthorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBannedAuthorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBannedAuthorsRequest proto.InternalMessageInfo
+
+func (m *QueryBannedAuthorsRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllSentPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBannedAuthorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.SentPost) > 0 {
-		for _, e := range m.SentPost {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryBannedAuthorsResponse struct {
+	BannedAuthor []BannedAuthor      `protobuf:"bytes,1,rep,name=BannedAuthor,proto3" json:"BannedAuthor"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBannedAuthorsResponse) Reset()         { *m = QueryBannedAuthorsResponse{} }
+func (m *QueryBannedAuthorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBannedAuthorsResponse) ProtoMessage()    {}
+func (*QueryBannedAuthorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{35}
+}
+func (m *QueryBannedAuthorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBannedAuthorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBannedAuthorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBannedAuthorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBannedAuthorsResponse.Merge(m, src)
+}
+func (m *QueryBannedAuthorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBannedAuthorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBannedAuthorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBannedAuthorsResponse proto.InternalMessageInfo
+
+func (m *QueryBannedAuthorsResponse) GetBannedAuthor() []BannedAuthor {
+	if m != nil {
+		return m.BannedAuthor
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetTimedoutPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBannedAuthorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return nil
+}
+
+type QueryBannedChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBannedChannelsRequest) Reset()         { *m = QueryBannedChannelsRequest{} }
+func (m *QueryBannedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBannedChannelsRequest) ProtoMessage()    {}
+func (*QueryBannedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{36}
+}
+func (m *QueryBannedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBannedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBannedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBannedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBannedChannelsRequest.Merge(m, src)
+}
+func (m *QueryBannedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBannedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBannedChannelsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTimedoutPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBannedChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryBannedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.TimedoutPost.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllTimedoutPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryBannedChannelsResponse struct {
+	BannedChannel []BannedChannel     `protobuf:"bytes,1,rep,name=BannedChannel,proto3" json:"BannedChannel"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBannedChannelsResponse) Reset()         { *m = QueryBannedChannelsResponse{} }
+func (m *QueryBannedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBannedChannelsResponse) ProtoMessage()    {}
+func (*QueryBannedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{37}
+}
+func (m *QueryBannedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBannedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBannedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBannedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBannedChannelsResponse.Merge(m, src)
+}
+func (m *QueryBannedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBannedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBannedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBannedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryBannedChannelsResponse) GetBannedChannel() []BannedChannel {
+	if m != nil {
+		return m.BannedChannel
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllTimedoutPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBannedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.TimedoutPost) > 0 {
-		for _, e := range m.TimedoutPost {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryReportedPostsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportedPostsRequest) Reset()         { *m = QueryReportedPostsRequest{} }
+func (m *QueryReportedPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportedPostsRequest) ProtoMessage()    {}
+func (*QueryReportedPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{38}
+}
+func (m *QueryReportedPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportedPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportedPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryReportedPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportedPostsRequest.Merge(m, src)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryReportedPostsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *QueryReportedPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportedPostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportedPostsRequest proto.InternalMessageInfo
+
+func (m *QueryReportedPostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryReportedPostsResponse struct {
+	ReportedPost []ReportedPost      `protobuf:"bytes,1,rep,name=ReportedPost,proto3" json:"ReportedPost"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportedPostsResponse) Reset()         { *m = QueryReportedPostsResponse{} }
+func (m *QueryReportedPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportedPostsResponse) ProtoMessage()    {}
+func (*QueryReportedPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{39}
+}
+func (m *QueryReportedPostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportedPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportedPostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportedPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportedPostsResponse.Merge(m, src)
+}
+func (m *QueryReportedPostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportedPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportedPostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportedPostsResponse proto.InternalMessageInfo
+
+func (m *QueryReportedPostsResponse) GetReportedPost() []ReportedPost {
+	if m != nil {
+		return m.ReportedPost
+	}
+	return nil
+}
+
+func (m *QueryReportedPostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetSentPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetSentPostRequest) Reset()         { *m = QueryGetSentPostRequest{} }
+func (m *QueryGetSentPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSentPostRequest) ProtoMessage()    {}
+func (*QueryGetSentPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{40}
+}
+func (m *QueryGetSentPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSentPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSentPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSentPostRequest.Merge(m, src)
+}
+func (m *QueryGetSentPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSentPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSentPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSentPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetSentPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetSentPostResponse struct {
+	SentPost SentPost `protobuf:"bytes,1,opt,name=SentPost,proto3" json:"SentPost"`
+	// reactions are the numbers of reactions to the post received from the destination chain by type
+	Reactions []ReactionCount `protobuf:"bytes,2,rep,name=reactions,proto3" json:"reactions"`
+}
+
+func (m *QueryGetSentPostResponse) Reset()         { *m = QueryGetSentPostResponse{} }
+func (m *QueryGetSentPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSentPostResponse) ProtoMessage()    {}
+func (*QueryGetSentPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{41}
+}
+func (m *QueryGetSentPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSentPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSentPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSentPostResponse.Merge(m, src)
+}
+func (m *QueryGetSentPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSentPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSentPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSentPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetSentPostResponse) GetSentPost() SentPost {
+	if m != nil {
+		return m.SentPost
+	}
+	return SentPost{}
+}
+
+func (m *QueryGetSentPostResponse) GetReactions() []ReactionCount {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+type QueryAllSentPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSentPostRequest) Reset()         { *m = QueryAllSentPostRequest{} }
+func (m *QueryAllSentPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSentPostRequest) ProtoMessage()    {}
+func (*QueryAllSentPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{42}
+}
+func (m *QueryAllSentPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSentPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSentPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSentPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSentPostRequest.Merge(m, src)
+}
+func (m *QueryAllSentPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSentPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSentPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSentPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllSentPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllSentPostResponse struct {
+	SentPost   []SentPost          `protobuf:"bytes,1,rep,name=SentPost,proto3" json:"SentPost"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSentPostResponse) Reset()         { *m = QueryAllSentPostResponse{} }
+func (m *QueryAllSentPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSentPostResponse) ProtoMessage()    {}
+func (*QueryAllSentPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{43}
+}
+func (m *QueryAllSentPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSentPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSentPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSentPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSentPostResponse.Merge(m, src)
+}
+func (m *QueryAllSentPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSentPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSentPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSentPostResponse proto.InternalMessageInfo
+
+func (m *QueryAllSentPostResponse) GetSentPost() []SentPost {
+	if m != nil {
+		return m.SentPost
+	}
+	return nil
+}
+
+func (m *QueryAllSentPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySentPostsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySentPostsByCreatorRequest) Reset()         { *m = QuerySentPostsByCreatorRequest{} }
+func (m *QuerySentPostsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostsByCreatorRequest) ProtoMessage()    {}
+func (*QuerySentPostsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{44}
+}
+func (m *QuerySentPostsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySentPostsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySentPostsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySentPostsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostsByCreatorRequest.Merge(m, src)
+}
+func (m *QuerySentPostsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySentPostsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySentPostsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QuerySentPostsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QuerySentPostsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySentPostsByCreatorResponse struct {
+	SentPost   []SentPost          `protobuf:"bytes,1,rep,name=SentPost,proto3" json:"SentPost"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySentPostsByCreatorResponse) Reset()         { *m = QuerySentPostsByCreatorResponse{} }
+func (m *QuerySentPostsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostsByCreatorResponse) ProtoMessage()    {}
+func (*QuerySentPostsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{45}
+}
+func (m *QuerySentPostsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySentPostsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySentPostsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySentPostsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostsByCreatorResponse.Merge(m, src)
+}
+func (m *QuerySentPostsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySentPostsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostsByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySentPostsByCreatorResponse proto.InternalMessageInfo
+
+func (m *QuerySentPostsByCreatorResponse) GetSentPost() []SentPost {
+	if m != nil {
+		return m.SentPost
+	}
+	return nil
+}
+
+func (m *QuerySentPostsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetTimedoutPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetTimedoutPostRequest) Reset()         { *m = QueryGetTimedoutPostRequest{} }
+func (m *QueryGetTimedoutPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimedoutPostRequest) ProtoMessage()    {}
+func (*QueryGetTimedoutPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{46}
+}
+func (m *QueryGetTimedoutPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimedoutPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimedoutPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimedoutPostRequest.Merge(m, src)
+}
+func (m *QueryGetTimedoutPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimedoutPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimedoutPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimedoutPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetTimedoutPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetTimedoutPostResponse struct {
+	TimedoutPost TimedoutPost `protobuf:"bytes,1,opt,name=TimedoutPost,proto3" json:"TimedoutPost"`
+}
+
+func (m *QueryGetTimedoutPostResponse) Reset()         { *m = QueryGetTimedoutPostResponse{} }
+func (m *QueryGetTimedoutPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimedoutPostResponse) ProtoMessage()    {}
+func (*QueryGetTimedoutPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{47}
+}
+func (m *QueryGetTimedoutPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimedoutPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimedoutPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimedoutPostResponse.Merge(m, src)
+}
+func (m *QueryGetTimedoutPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimedoutPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimedoutPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimedoutPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetTimedoutPostResponse) GetTimedoutPost() TimedoutPost {
+	if m != nil {
+		return m.TimedoutPost
+	}
+	return TimedoutPost{}
+}
+
+type QueryAllTimedoutPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTimedoutPostRequest) Reset()         { *m = QueryAllTimedoutPostRequest{} }
+func (m *QueryAllTimedoutPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTimedoutPostRequest) ProtoMessage()    {}
+func (*QueryAllTimedoutPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{48}
+}
+func (m *QueryAllTimedoutPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTimedoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTimedoutPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTimedoutPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTimedoutPostRequest.Merge(m, src)
+}
+func (m *QueryAllTimedoutPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTimedoutPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTimedoutPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTimedoutPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllTimedoutPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTimedoutPostResponse struct {
+	TimedoutPost []TimedoutPost      `protobuf:"bytes,1,rep,name=TimedoutPost,proto3" json:"TimedoutPost"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTimedoutPostResponse) Reset()         { *m = QueryAllTimedoutPostResponse{} }
+func (m *QueryAllTimedoutPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTimedoutPostResponse) ProtoMessage()    {}
+func (*QueryAllTimedoutPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{49}
+}
+func (m *QueryAllTimedoutPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTimedoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTimedoutPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTimedoutPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTimedoutPostResponse.Merge(m, src)
+}
+func (m *QueryAllTimedoutPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTimedoutPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTimedoutPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTimedoutPostResponse proto.InternalMessageInfo
+
+func (m *QueryAllTimedoutPostResponse) GetTimedoutPost() []TimedoutPost {
+	if m != nil {
+		return m.TimedoutPost
+	}
+	return nil
+}
+
+func (m *QueryAllTimedoutPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTimedoutPostsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) Reset()         { *m = QueryTimedoutPostsByCreatorRequest{} }
+func (m *QueryTimedoutPostsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimedoutPostsByCreatorRequest) ProtoMessage()    {}
+func (*QueryTimedoutPostsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{50}
+}
+func (m *QueryTimedoutPostsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimedoutPostsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimedoutPostsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimedoutPostsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimedoutPostsByCreatorRequest.Merge(m, src)
+}
+func (m *QueryTimedoutPostsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimedoutPostsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimedoutPostsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimedoutPostsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryTimedoutPostsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTimedoutPostsByCreatorResponse struct {
+	TimedoutPost []TimedoutPost      `protobuf:"bytes,1,rep,name=TimedoutPost,proto3" json:"TimedoutPost"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) Reset()         { *m = QueryTimedoutPostsByCreatorResponse{} }
+func (m *QueryTimedoutPostsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimedoutPostsByCreatorResponse) ProtoMessage()    {}
+func (*QueryTimedoutPostsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{51}
+}
+func (m *QueryTimedoutPostsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimedoutPostsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimedoutPostsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimedoutPostsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimedoutPostsByCreatorResponse.Merge(m, src)
+}
+func (m *QueryTimedoutPostsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimedoutPostsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimedoutPostsByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimedoutPostsByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryTimedoutPostsByCreatorResponse) GetTimedoutPost() []TimedoutPost {
+	if m != nil {
+		return m.TimedoutPost
+	}
+	return nil
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPendingPostRequest struct {
+	Port      string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetPendingPostRequest) Reset()         { *m = QueryGetPendingPostRequest{} }
+func (m *QueryGetPendingPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingPostRequest) ProtoMessage()    {}
+func (*QueryGetPendingPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{52}
+}
+func (m *QueryGetPendingPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingPostRequest.Merge(m, src)
+}
+func (m *QueryGetPendingPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingPostRequest) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *QueryGetPendingPostRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *QueryGetPendingPostRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetPendingPostResponse struct {
+	PendingPost PendingPost `protobuf:"bytes,1,opt,name=pendingPost,proto3" json:"pendingPost"`
+}
+
+func (m *QueryGetPendingPostResponse) Reset()         { *m = QueryGetPendingPostResponse{} }
+func (m *QueryGetPendingPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingPostResponse) ProtoMessage()    {}
+func (*QueryGetPendingPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{53}
+}
+func (m *QueryGetPendingPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingPostResponse.Merge(m, src)
+}
+func (m *QueryGetPendingPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingPostResponse) GetPendingPost() PendingPost {
+	if m != nil {
+		return m.PendingPost
+	}
+	return PendingPost{}
+}
+
+type QueryAllPendingPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingPostRequest) Reset()         { *m = QueryAllPendingPostRequest{} }
+func (m *QueryAllPendingPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingPostRequest) ProtoMessage()    {}
+func (*QueryAllPendingPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{54}
+}
+func (m *QueryAllPendingPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingPostRequest.Merge(m, src)
+}
+func (m *QueryAllPendingPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingPostResponse struct {
+	PendingPost []PendingPost       `protobuf:"bytes,1,rep,name=pendingPost,proto3" json:"pendingPost"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingPostResponse) Reset()         { *m = QueryAllPendingPostResponse{} }
+func (m *QueryAllPendingPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingPostResponse) ProtoMessage()    {}
+func (*QueryAllPendingPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{55}
+}
+func (m *QueryAllPendingPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingPostResponse.Merge(m, src)
+}
+func (m *QueryAllPendingPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingPostResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingPostResponse) GetPendingPost() []PendingPost {
+	if m != nil {
+		return m.PendingPost
+	}
+	return nil
+}
+
+func (m *QueryAllPendingPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingPostsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPostsByCreatorRequest) Reset()         { *m = QueryPendingPostsByCreatorRequest{} }
+func (m *QueryPendingPostsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPostsByCreatorRequest) ProtoMessage()    {}
+func (*QueryPendingPostsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{56}
+}
+func (m *QueryPendingPostsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPostsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPostsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPostsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPostsByCreatorRequest.Merge(m, src)
+}
+func (m *QueryPendingPostsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPostsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPostsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPostsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPostsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryPendingPostsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingPostsByCreatorResponse struct {
+	PendingPost []PendingPost       `protobuf:"bytes,1,rep,name=pendingPost,proto3" json:"pendingPost"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPostsByCreatorResponse) Reset()         { *m = QueryPendingPostsByCreatorResponse{} }
+func (m *QueryPendingPostsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPostsByCreatorResponse) ProtoMessage()    {}
+func (*QueryPendingPostsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{57}
+}
+func (m *QueryPendingPostsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPostsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPostsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPostsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPostsByCreatorResponse.Merge(m, src)
+}
+func (m *QueryPendingPostsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPostsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPostsByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPostsByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPostsByCreatorResponse) GetPendingPost() []PendingPost {
+	if m != nil {
+		return m.PendingPost
+	}
+	return nil
+}
+
+func (m *QueryPendingPostsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetFailedPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetFailedPostRequest) Reset()         { *m = QueryGetFailedPostRequest{} }
+func (m *QueryGetFailedPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedPostRequest) ProtoMessage()    {}
+func (*QueryGetFailedPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{58}
+}
+func (m *QueryGetFailedPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedPostRequest.Merge(m, src)
+}
+func (m *QueryGetFailedPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetFailedPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetFailedPostResponse struct {
+	FailedPost FailedPost `protobuf:"bytes,1,opt,name=FailedPost,proto3" json:"FailedPost"`
+}
+
+func (m *QueryGetFailedPostResponse) Reset()         { *m = QueryGetFailedPostResponse{} }
+func (m *QueryGetFailedPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedPostResponse) ProtoMessage()    {}
+func (*QueryGetFailedPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{59}
+}
+func (m *QueryGetFailedPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedPostResponse.Merge(m, src)
+}
+func (m *QueryGetFailedPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetFailedPostResponse) GetFailedPost() FailedPost {
+	if m != nil {
+		return m.FailedPost
+	}
+	return FailedPost{}
+}
+
+type QueryAllFailedPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFailedPostRequest) Reset()         { *m = QueryAllFailedPostRequest{} }
+func (m *QueryAllFailedPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedPostRequest) ProtoMessage()    {}
+func (*QueryAllFailedPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{60}
+}
+func (m *QueryAllFailedPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFailedPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedPostRequest.Merge(m, src)
+}
+func (m *QueryAllFailedPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFailedPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllFailedPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllFailedPostResponse struct {
+	FailedPost []FailedPost        `protobuf:"bytes,1,rep,name=FailedPost,proto3" json:"FailedPost"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFailedPostResponse) Reset()         { *m = QueryAllFailedPostResponse{} }
+func (m *QueryAllFailedPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedPostResponse) ProtoMessage()    {}
+func (*QueryAllFailedPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{61}
+}
+func (m *QueryAllFailedPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFailedPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedPostResponse.Merge(m, src)
+}
+func (m *QueryAllFailedPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFailedPostResponse proto.InternalMessageInfo
+
+func (m *QueryAllFailedPostResponse) GetFailedPost() []FailedPost {
+	if m != nil {
+		return m.FailedPost
+	}
+	return nil
+}
+
+func (m *QueryAllFailedPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetCommentRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetCommentRequest) Reset()         { *m = QueryGetCommentRequest{} }
+func (m *QueryGetCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommentRequest) ProtoMessage()    {}
+func (*QueryGetCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{62}
+}
+func (m *QueryGetCommentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommentRequest.Merge(m, src)
+}
+func (m *QueryGetCommentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCommentRequest proto.InternalMessageInfo
+
+func (m *QueryGetCommentRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetCommentResponse struct {
+	Comment Comment `protobuf:"bytes,1,opt,name=Comment,proto3" json:"Comment"`
+}
+
+func (m *QueryGetCommentResponse) Reset()         { *m = QueryGetCommentResponse{} }
+func (m *QueryGetCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommentResponse) ProtoMessage()    {}
+func (*QueryGetCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{63}
+}
+func (m *QueryGetCommentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCommentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommentResponse.Merge(m, src)
+}
+func (m *QueryGetCommentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCommentResponse proto.InternalMessageInfo
+
+func (m *QueryGetCommentResponse) GetComment() Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return Comment{}
+}
+
+type QueryAllCommentRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCommentRequest) Reset()         { *m = QueryAllCommentRequest{} }
+func (m *QueryAllCommentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCommentRequest) ProtoMessage()    {}
+func (*QueryAllCommentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{64}
+}
+func (m *QueryAllCommentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCommentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCommentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCommentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCommentRequest.Merge(m, src)
+}
+func (m *QueryAllCommentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCommentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCommentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCommentRequest proto.InternalMessageInfo
+
+func (m *QueryAllCommentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllCommentResponse struct {
+	Comment    []Comment           `protobuf:"bytes,1,rep,name=Comment,proto3" json:"Comment"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCommentResponse) Reset()         { *m = QueryAllCommentResponse{} }
+func (m *QueryAllCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCommentResponse) ProtoMessage()    {}
+func (*QueryAllCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{65}
+}
+func (m *QueryAllCommentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCommentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCommentResponse.Merge(m, src)
+}
+func (m *QueryAllCommentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCommentResponse proto.InternalMessageInfo
+
+func (m *QueryAllCommentResponse) GetComment() []Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+func (m *QueryAllCommentResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCommentThreadRequest struct {
+	// postKind is either "post" or "sentPost"
+	PostKind   string             `protobuf:"bytes,1,opt,name=postKind,proto3" json:"postKind,omitempty"`
+	PostID     uint64             `protobuf:"varint,2,opt,name=postID,proto3" json:"postID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommentThreadRequest) Reset()         { *m = QueryCommentThreadRequest{} }
+func (m *QueryCommentThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommentThreadRequest) ProtoMessage()    {}
+func (*QueryCommentThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{66}
+}
+func (m *QueryCommentThreadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommentThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommentThreadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommentThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommentThreadRequest.Merge(m, src)
+}
+func (m *QueryCommentThreadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommentThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommentThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommentThreadRequest proto.InternalMessageInfo
+
+func (m *QueryCommentThreadRequest) GetPostKind() string {
+	if m != nil {
+		return m.PostKind
+	}
+	return ""
+}
+
+func (m *QueryCommentThreadRequest) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *QueryCommentThreadRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCommentThreadResponse struct {
+	Comment    []Comment           `protobuf:"bytes,1,rep,name=Comment,proto3" json:"Comment"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommentThreadResponse) Reset()         { *m = QueryCommentThreadResponse{} }
+func (m *QueryCommentThreadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommentThreadResponse) ProtoMessage()    {}
+func (*QueryCommentThreadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{67}
+}
+func (m *QueryCommentThreadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommentThreadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommentThreadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommentThreadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommentThreadResponse.Merge(m, src)
+}
+func (m *QueryCommentThreadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommentThreadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommentThreadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommentThreadResponse proto.InternalMessageInfo
+
+func (m *QueryCommentThreadResponse) GetComment() []Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+func (m *QueryCommentThreadResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "planet.blog.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "planet.blog.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPostRequest)(nil), "planet.blog.QueryGetPostRequest")
+	proto.RegisterType((*QueryGetPostResponse)(nil), "planet.blog.QueryGetPostResponse")
+	proto.RegisterType((*QueryAllPostRequest)(nil), "planet.blog.QueryAllPostRequest")
+	proto.RegisterType((*QueryAllPostResponse)(nil), "planet.blog.QueryAllPostResponse")
+	proto.RegisterType((*QueryPostsByCreatorRequest)(nil), "planet.blog.QueryPostsByCreatorRequest")
+	proto.RegisterType((*QueryPostsByCreatorResponse)(nil), "planet.blog.QueryPostsByCreatorResponse")
+	proto.RegisterType((*QueryPostsByRemoteAuthorRequest)(nil), "planet.blog.QueryPostsByRemoteAuthorRequest")
+	proto.RegisterType((*QueryPostsByRemoteAuthorResponse)(nil), "planet.blog.QueryPostsByRemoteAuthorResponse")
+	proto.RegisterType((*QueryPostsBySourceChannelRequest)(nil), "planet.blog.QueryPostsBySourceChannelRequest")
+	proto.RegisterType((*QueryPostsBySourceChannelResponse)(nil), "planet.blog.QueryPostsBySourceChannelResponse")
+	proto.RegisterType((*QueryPostRevisionsRequest)(nil), "planet.blog.QueryPostRevisionsRequest")
+	proto.RegisterType((*QueryPostRevisionsResponse)(nil), "planet.blog.QueryPostRevisionsResponse")
+	proto.RegisterType((*QueryPostAtRevisionRequest)(nil), "planet.blog.QueryPostAtRevisionRequest")
+	proto.RegisterType((*QueryPostAtRevisionResponse)(nil), "planet.blog.QueryPostAtRevisionResponse")
+	proto.RegisterType((*QuerySearchPostsRequest)(nil), "planet.blog.QuerySearchPostsRequest")
+	proto.RegisterType((*QuerySearchPostsResponse)(nil), "planet.blog.QuerySearchPostsResponse")
+	proto.RegisterType((*QueryPostsByTagRequest)(nil), "planet.blog.QueryPostsByTagRequest")
+	proto.RegisterType((*QueryPostsByTagResponse)(nil), "planet.blog.QueryPostsByTagResponse")
+	proto.RegisterType((*QueryTopTagsRequest)(nil), "planet.blog.QueryTopTagsRequest")
+	proto.RegisterType((*QueryTopTagsResponse)(nil), "planet.blog.QueryTopTagsResponse")
+	proto.RegisterType((*QueryGetNotificationRequest)(nil), "planet.blog.QueryGetNotificationRequest")
+	proto.RegisterType((*QueryGetNotificationResponse)(nil), "planet.blog.QueryGetNotificationResponse")
+	proto.RegisterType((*QueryNotificationsRequest)(nil), "planet.blog.QueryNotificationsRequest")
+	proto.RegisterType((*QueryNotificationsResponse)(nil), "planet.blog.QueryNotificationsResponse")
+	proto.RegisterType((*QueryGetInboundPostRequest)(nil), "planet.blog.QueryGetInboundPostRequest")
+	proto.RegisterType((*QueryGetInboundPostResponse)(nil), "planet.blog.QueryGetInboundPostResponse")
+	proto.RegisterType((*QueryAllInboundPostRequest)(nil), "planet.blog.QueryAllInboundPostRequest")
+	proto.RegisterType((*QueryAllInboundPostResponse)(nil), "planet.blog.QueryAllInboundPostResponse")
+	proto.RegisterType((*QueryModeratorsRequest)(nil), "planet.blog.QueryModeratorsRequest")
+	proto.RegisterType((*QueryModeratorsResponse)(nil), "planet.blog.QueryModeratorsResponse")
+	proto.RegisterType((*QueryModerationLogRequest)(nil), "planet.blog.QueryModerationLogRequest")
+	proto.RegisterType((*QueryModerationLogResponse)(nil), "planet.blog.QueryModerationLogResponse")
+	proto.RegisterType((*QueryBannedAuthorsRequest)(nil), "planet.blog.QueryBannedAuthorsRequest")
+	proto.RegisterType((*QueryBannedAuthorsResponse)(nil), "planet.blog.QueryBannedAuthorsResponse")
+	proto.RegisterType((*QueryBannedChannelsRequest)(nil), "planet.blog.QueryBannedChannelsRequest")
+	proto.RegisterType((*QueryBannedChannelsResponse)(nil), "planet.blog.QueryBannedChannelsResponse")
+	proto.RegisterType((*QueryReportedPostsRequest)(nil), "planet.blog.QueryReportedPostsRequest")
+	proto.RegisterType((*QueryReportedPostsResponse)(nil), "planet.blog.QueryReportedPostsResponse")
+	proto.RegisterType((*QueryGetSentPostRequest)(nil), "planet.blog.QueryGetSentPostRequest")
+	proto.RegisterType((*QueryGetSentPostResponse)(nil), "planet.blog.QueryGetSentPostResponse")
+	proto.RegisterType((*QueryAllSentPostRequest)(nil), "planet.blog.QueryAllSentPostRequest")
+	proto.RegisterType((*QueryAllSentPostResponse)(nil), "planet.blog.QueryAllSentPostResponse")
+	proto.RegisterType((*QuerySentPostsByCreatorRequest)(nil), "planet.blog.QuerySentPostsByCreatorRequest")
+	proto.RegisterType((*QuerySentPostsByCreatorResponse)(nil), "planet.blog.QuerySentPostsByCreatorResponse")
+	proto.RegisterType((*QueryGetTimedoutPostRequest)(nil), "planet.blog.QueryGetTimedoutPostRequest")
+	proto.RegisterType((*QueryGetTimedoutPostResponse)(nil), "planet.blog.QueryGetTimedoutPostResponse")
+	proto.RegisterType((*QueryAllTimedoutPostRequest)(nil), "planet.blog.QueryAllTimedoutPostRequest")
+	proto.RegisterType((*QueryAllTimedoutPostResponse)(nil), "planet.blog.QueryAllTimedoutPostResponse")
+	proto.RegisterType((*QueryTimedoutPostsByCreatorRequest)(nil), "planet.blog.QueryTimedoutPostsByCreatorRequest")
+	proto.RegisterType((*QueryTimedoutPostsByCreatorResponse)(nil), "planet.blog.QueryTimedoutPostsByCreatorResponse")
+	proto.RegisterType((*QueryGetPendingPostRequest)(nil), "planet.blog.QueryGetPendingPostRequest")
+	proto.RegisterType((*QueryGetPendingPostResponse)(nil), "planet.blog.QueryGetPendingPostResponse")
+	proto.RegisterType((*QueryAllPendingPostRequest)(nil), "planet.blog.QueryAllPendingPostRequest")
+	proto.RegisterType((*QueryAllPendingPostResponse)(nil), "planet.blog.QueryAllPendingPostResponse")
+	proto.RegisterType((*QueryPendingPostsByCreatorRequest)(nil), "planet.blog.QueryPendingPostsByCreatorRequest")
+	proto.RegisterType((*QueryPendingPostsByCreatorResponse)(nil), "planet.blog.QueryPendingPostsByCreatorResponse")
+	proto.RegisterType((*QueryGetFailedPostRequest)(nil), "planet.blog.QueryGetFailedPostRequest")
+	proto.RegisterType((*QueryGetFailedPostResponse)(nil), "planet.blog.QueryGetFailedPostResponse")
+	proto.RegisterType((*QueryAllFailedPostRequest)(nil), "planet.blog.QueryAllFailedPostRequest")
+	proto.RegisterType((*QueryAllFailedPostResponse)(nil), "planet.blog.QueryAllFailedPostResponse")
+	proto.RegisterType((*QueryGetCommentRequest)(nil), "planet.blog.QueryGetCommentRequest")
+	proto.RegisterType((*QueryGetCommentResponse)(nil), "planet.blog.QueryGetCommentResponse")
+	proto.RegisterType((*QueryAllCommentRequest)(nil), "planet.blog.QueryAllCommentRequest")
+	proto.RegisterType((*QueryAllCommentResponse)(nil), "planet.blog.QueryAllCommentResponse")
+	proto.RegisterType((*QueryCommentThreadRequest)(nil), "planet.blog.QueryCommentThreadRequest")
+	proto.RegisterType((*QueryCommentThreadResponse)(nil), "planet.blog.QueryCommentThreadResponse")
+}
+
+func init() { proto.RegisterFile("planet/blog/query.proto", fileDescriptor_b1671705bf75f3f4) }
+
+var fileDescriptor_b1671705bf75f3f4 = []byte{
+	// 2591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xed, 0x6f, 0x1c, 0x47,
+	0x19, 0xcf, 0xf8, 0xdc, 0xbc, 0x8c, 0x71, 0x68, 0xc7, 0x8e, 0x7d, 0x5e, 0x3b, 0x67, 0x7b, 0xe3,
+	0xe4, 0x2e, 0xc4, 0xbd, 0xad, 0x43, 0xa5, 0x00, 0x12, 0x2f, 0x8e, 0xab, 0xb8, 0x51, 0x29, 0x31,
+	0x17, 0x7f, 0x02, 0xa1, 0x63, 0x7d, 0x37, 0x3d, 0x2f, 0xac, 0x77, 0xaf, 0xbb, 0x7b, 0xa5, 0xe6,
+	0x38, 0x09, 0x02, 0xad, 0x00, 0x55, 0x50, 0x29, 0x08, 0x52, 0xb5, 0x20, 0x21, 0x40, 0x42, 0x08,
+	0xb5, 0x42, 0x05, 0x24, 0x3e, 0xf3, 0xa5, 0x1f, 0x2b, 0xf1, 0x85, 0x4f, 0x08, 0x25, 0xfc, 0x21,
+	0x68, 0x67, 0x9f, 0xd9, 0x9d, 0xb9, 0x9d, 0xdd, 0x3b, 0x97, 0xc5, 0xce, 0xb7, 0xdb, 0x99, 0x67,
+	0xe6, 0xf9, 0x3d, 0xbf, 0x79, 0x7b, 0xe6, 0x79, 0xe6, 0xf0, 0x7c, 0xd7, 0x36, 0x1d, 0x1a, 0x18,
+	0x7b, 0xb6, 0xdb, 0x31, 0x5e, 0xee, 0x51, 0xef, 0xb0, 0xde, 0xf5, 0xdc, 0xc0, 0x25, 0x53, 0x51,
+	0x45, 0x3d, 0xac, 0xd0, 0x66, 0x3b, 0x6e, 0xc7, 0x65, 0xe5, 0x46, 0xf8, 0x2b, 0x12, 0xd1, 0x96,
+	0x3a, 0xae, 0xdb, 0xb1, 0xa9, 0x61, 0x76, 0x2d, 0xc3, 0x74, 0x1c, 0x37, 0x30, 0x03, 0xcb, 0x75,
+	0x7c, 0xa8, 0xfd, 0x44, 0xcb, 0xf5, 0x0f, 0x5c, 0xdf, 0xd8, 0x33, 0x7d, 0x1a, 0xf5, 0x6c, 0xbc,
+	0xb2, 0xb1, 0x47, 0x03, 0x73, 0xc3, 0xe8, 0x9a, 0x1d, 0xcb, 0x61, 0xc2, 0x20, 0x5b, 0x16, 0x51,
+	0x74, 0x4d, 0xcf, 0x3c, 0xe0, 0xbd, 0xcc, 0x49, 0x35, 0xae, 0x1f, 0x40, 0xf9, 0xa2, 0x58, 0xee,
+	0x53, 0x27, 0x68, 0x0a, 0x95, 0xcb, 0x62, 0x65, 0x60, 0x1d, 0xd0, 0xb6, 0xdb, 0x93, 0x04, 0x2a,
+	0x52, 0xaf, 0xd4, 0x69, 0x5b, 0x4e, 0x47, 0xac, 0xbf, 0x28, 0xd6, 0xbf, 0x64, 0x5a, 0x36, 0x6d,
+	0x8b, 0xd5, 0x0b, 0x62, 0x75, 0xcb, 0x3d, 0x38, 0xa0, 0x8e, 0x52, 0x75, 0xd8, 0xa4, 0xe9, 0xd1,
+	0x57, 0x2c, 0x3f, 0x31, 0x55, 0x02, 0x1e, 0x98, 0x9d, 0x66, 0xcb, 0xed, 0x39, 0x4a, 0x5c, 0x8e,
+	0x1b, 0x58, 0x2f, 0x59, 0x2d, 0x91, 0x27, 0x4d, 0xac, 0xf7, 0xa8, 0xd9, 0x12, 0xea, 0xa4, 0xb6,
+	0x96, 0xb3, 0xe7, 0xf6, 0x1c, 0x09, 0xf4, 0x92, 0x58, 0x7f, 0xe0, 0xb6, 0xa9, 0x27, 0xf6, 0x7c,
+	0x41, 0xac, 0xdd, 0x33, 0x95, 0x03, 0xe3, 0xd1, 0xae, 0xeb, 0x41, 0x77, 0xfa, 0x2c, 0x26, 0x5f,
+	0x0e, 0x07, 0x75, 0x87, 0x8d, 0x56, 0x83, 0xbe, 0xdc, 0xa3, 0x7e, 0xa0, 0x3f, 0x8f, 0x67, 0xa4,
+	0x52, 0xbf, 0xeb, 0x3a, 0x3e, 0x25, 0x1b, 0xf8, 0x74, 0x34, 0xaa, 0x65, 0xb4, 0x82, 0x6a, 0x53,
+	0xd7, 0x67, 0xea, 0xc2, 0xec, 0xaa, 0x47, 0xc2, 0x37, 0x27, 0x3f, 0xf8, 0xd7, 0xf2, 0xa9, 0x06,
+	0x08, 0xea, 0x2f, 0x40, 0x4f, 0xdb, 0x34, 0xd8, 0x71, 0xfd, 0x00, 0x14, 0x90, 0xf3, 0x78, 0xc2,
+	0x6a, 0xb3, 0x5e, 0x26, 0x1b, 0x13, 0x56, 0x9b, 0xac, 0xe1, 0x69, 0xcb, 0x69, 0xd9, 0xbd, 0x36,
+	0x7d, 0xde, 0x6a, 0xb7, 0xa9, 0x53, 0x9e, 0x58, 0x41, 0xb5, 0xb3, 0x0d, 0xb9, 0x50, 0xff, 0x3e,
+	0xc2, 0xb3, 0x72, 0x6f, 0x00, 0xec, 0x1a, 0x9e, 0x0c, 0xbf, 0x01, 0xd6, 0x53, 0x32, 0x2c, 0xd7,
+	0x0f, 0x00, 0x14, 0x13, 0x22, 0x9f, 0xc3, 0xe7, 0x38, 0xe7, 0x7e, 0x79, 0x62, 0xa5, 0x54, 0x9b,
+	0xba, 0xae, 0x49, 0x2d, 0x1a, 0x50, 0xbb, 0x15, 0x0e, 0x29, 0x34, 0x4d, 0x9a, 0xe8, 0x6f, 0x23,
+	0xb0, 0x69, 0xd3, 0xb6, 0x45, 0x9b, 0x6e, 0x61, 0x9c, 0xac, 0x08, 0x80, 0x72, 0xa5, 0x1e, 0x2d,
+	0x9f, 0x7a, 0xb8, 0x7c, 0xea, 0xd1, 0xc2, 0x84, 0xe5, 0x53, 0xdf, 0x31, 0x3b, 0x14, 0xda, 0x36,
+	0x84, 0x96, 0x64, 0x0e, 0x9f, 0x76, 0x3d, 0xab, 0x63, 0x45, 0x24, 0x9c, 0x6b, 0xc0, 0x57, 0x9a,
+	0xa3, 0x92, 0x8a, 0xa3, 0x37, 0x38, 0x47, 0x31, 0xba, 0x14, 0x47, 0xa5, 0xd1, 0x1c, 0x6d, 0x4b,
+	0xb6, 0x4c, 0x30, 0x5b, 0xaa, 0x23, 0x6d, 0x89, 0x34, 0x89, 0xc6, 0xe8, 0xbf, 0x45, 0x58, 0x8b,
+	0xa6, 0x92, 0xeb, 0x07, 0xfe, 0xcd, 0xc3, 0x2d, 0x8f, 0x9a, 0x81, 0xeb, 0x71, 0xce, 0xca, 0xf8,
+	0x4c, 0x2b, 0x2a, 0x61, 0x84, 0x9d, 0x6b, 0xf0, 0x4f, 0x72, 0x4b, 0x81, 0xe0, 0xa3, 0xb0, 0x39,
+	0x1e, 0x6b, 0xf7, 0x11, 0x5e, 0x54, 0xc2, 0x3c, 0x51, 0xf2, 0xfe, 0x8e, 0xf0, 0xb2, 0x88, 0xaa,
+	0x41, 0x0f, 0xdc, 0x80, 0x6e, 0xf6, 0x82, 0x7d, 0x99, 0xc1, 0x7d, 0xd3, 0x72, 0x6e, 0x3f, 0x17,
+	0x33, 0x18, 0x7d, 0x86, 0x35, 0x66, 0xbb, 0xed, 0x51, 0xdf, 0x87, 0x89, 0xc4, 0x3f, 0x87, 0xb8,
+	0x2d, 0x15, 0xc7, 0xed, 0xa4, 0x8a, 0xdb, 0x07, 0x08, 0xaf, 0x64, 0x5b, 0x71, 0xa2, 0x04, 0xbf,
+	0x3b, 0x04, 0xed, 0xae, 0xdb, 0xf3, 0x5a, 0x74, 0x6b, 0xdf, 0x74, 0x1c, 0x6a, 0x73, 0x86, 0x97,
+	0xf0, 0xb9, 0x56, 0x54, 0x12, 0x73, 0x9c, 0x14, 0x1c, 0xf3, 0x3c, 0x7d, 0x0b, 0xe1, 0xd5, 0x1c,
+	0xc0, 0x27, 0x4a, 0x66, 0x1f, 0x2f, 0xc4, 0xd0, 0x1a, 0x70, 0x5a, 0xf2, 0x13, 0x25, 0xdc, 0xd4,
+	0xc2, 0x43, 0x0c, 0x18, 0x9c, 0x6c, 0xc0, 0x57, 0x51, 0xf4, 0xe9, 0x7f, 0x10, 0xf7, 0x19, 0x41,
+	0x3b, 0x30, 0xb2, 0x85, 0x3f, 0x26, 0x56, 0x00, 0x33, 0x0b, 0x29, 0x66, 0xb8, 0x00, 0x30, 0x24,
+	0x35, 0x2a, 0x8e, 0xa9, 0x1d, 0x01, 0xeb, 0x66, 0xdc, 0xff, 0x28, 0xaa, 0x34, 0x7c, 0x96, 0x3b,
+	0x21, 0x4c, 0xf9, 0x64, 0x23, 0xfe, 0xd6, 0xf7, 0xf0, 0xa2, 0xb2, 0xc7, 0x4c, 0xf3, 0xd1, 0x91,
+	0xcd, 0xd7, 0xff, 0x86, 0xf0, 0x3c, 0x53, 0x72, 0x97, 0x9a, 0x5e, 0x6b, 0x3f, 0xac, 0x8b, 0x87,
+	0x77, 0x16, 0x3f, 0xc1, 0x6c, 0x87, 0xf5, 0x11, 0x7d, 0x84, 0x88, 0xdd, 0x2e, 0xf5, 0xd8, 0xf6,
+	0x1e, 0x6d, 0x41, 0xf1, 0xf7, 0x31, 0xef, 0x41, 0x6f, 0x22, 0x5c, 0x4e, 0x63, 0x3f, 0xd1, 0xe5,
+	0xf2, 0x00, 0xe1, 0x39, 0x71, 0x29, 0xef, 0x9a, 0x1d, 0xce, 0xe6, 0x93, 0xb8, 0x14, 0x98, 0x1d,
+	0xe0, 0x32, 0xfc, 0x79, 0xcc, 0xbb, 0xcc, 0x4f, 0xf9, 0x48, 0x8b, 0xd0, 0x4e, 0x94, 0xac, 0xaf,
+	0x81, 0xcb, 0xb5, 0xeb, 0x76, 0x77, 0xcd, 0x8e, 0x5f, 0xb0, 0xcb, 0xa5, 0x3f, 0xe0, 0x4e, 0x53,
+	0xdc, 0x3f, 0x58, 0x7b, 0x03, 0x9f, 0xdd, 0x35, 0x3b, 0xcc, 0x11, 0x04, 0x8b, 0x2f, 0x48, 0x16,
+	0xf3, 0x4a, 0xb0, 0x3a, 0x16, 0x2e, 0xce, 0xf2, 0x6d, 0x58, 0xd9, 0xdb, 0x34, 0xf8, 0x92, 0x70,
+	0x93, 0x10, 0x8e, 0x7f, 0x7e, 0xc8, 0x23, 0xf9, 0x90, 0x8f, 0x5c, 0xec, 0x09, 0xee, 0x62, 0xeb,
+	0x2d, 0xbc, 0xa4, 0xee, 0x28, 0xd9, 0x23, 0xc4, 0x72, 0xe5, 0x1e, 0x21, 0x0a, 0xf0, 0x3d, 0x42,
+	0x2c, 0xd3, 0x7f, 0x89, 0xe0, 0x10, 0x10, 0x4b, 0xfd, 0xd1, 0x60, 0x2b, 0x18, 0xf7, 0x1c, 0x8f,
+	0x9a, 0xed, 0x3b, 0x8e, 0x7d, 0x08, 0xce, 0xbf, 0x50, 0x52, 0xd4, 0x6e, 0x91, 0x1c, 0x13, 0x43,
+	0xf8, 0x32, 0x39, 0x28, 0x1d, 0x99, 0x83, 0xe2, 0x86, 0x7e, 0x1d, 0xb0, 0x6e, 0xd3, 0xe0, 0x76,
+	0x74, 0x11, 0xcc, 0xb9, 0x42, 0xe9, 0x4d, 0xbc, 0xa8, 0x94, 0x06, 0xd3, 0xbe, 0x80, 0xa7, 0x84,
+	0x62, 0x18, 0xdd, 0xb2, 0x64, 0x99, 0x50, 0x0f, 0x86, 0x89, 0x4d, 0xf4, 0x36, 0xc0, 0xd9, 0xb4,
+	0x6d, 0x05, 0x9c, 0xa2, 0x96, 0xe2, 0xef, 0xb9, 0x27, 0x3e, 0xac, 0x26, 0xcb, 0x8e, 0xd2, 0x11,
+	0xed, 0x28, 0x6e, 0x7c, 0xbe, 0x0e, 0x1b, 0xf8, 0x8b, 0xd1, 0x2d, 0xdc, 0xf5, 0x0a, 0xdf, 0x97,
+	0x7e, 0xc5, 0x37, 0x62, 0x51, 0x05, 0x10, 0xf1, 0x19, 0x7c, 0x2e, 0x2e, 0x05, 0x1a, 0xe6, 0x24,
+	0x1a, 0xe2, 0x5a, 0x7e, 0x85, 0x8d, 0x0b, 0x8a, 0xa3, 0xa0, 0x05, 0xcb, 0xfd, 0xc5, 0x38, 0x10,
+	0xf1, 0x45, 0xb7, 0x53, 0x34, 0x0b, 0x7f, 0xe5, 0x8b, 0x76, 0x48, 0x0b, 0x10, 0x71, 0x07, 0x3f,
+	0x99, 0x54, 0x6c, 0xb6, 0x84, 0x85, 0x7b, 0x51, 0xc5, 0x47, 0x2c, 0x04, 0xb4, 0xa4, 0x1a, 0x17,
+	0xc7, 0xce, 0xf7, 0xf8, 0x6e, 0x78, 0xd3, 0x74, 0x1c, 0xda, 0x8e, 0xae, 0x3c, 0xfe, 0xb1, 0xde,
+	0x2b, 0x92, 0x1d, 0x6f, 0x08, 0x43, 0xb2, 0xe3, 0x89, 0x15, 0xca, 0x1d, 0x4f, 0x14, 0xe0, 0x3b,
+	0x9e, 0x58, 0x56, 0x1c, 0x61, 0x6d, 0x09, 0x2b, 0x5c, 0x6b, 0x0a, 0x5f, 0x55, 0xef, 0xf2, 0x2d,
+	0x66, 0x58, 0x0d, 0x70, 0x72, 0x0b, 0x4f, 0x4b, 0x35, 0x65, 0xa4, 0x08, 0x12, 0x49, 0x12, 0xc0,
+	0x8a, 0xdc, 0xac, 0xf8, 0x55, 0xd6, 0x60, 0x91, 0x3b, 0xda, 0x96, 0x5c, 0xef, 0xa2, 0x58, 0x89,
+	0x27, 0xca, 0x90, 0x96, 0x64, 0xa2, 0x88, 0x15, 0xca, 0x89, 0x22, 0x0a, 0xf0, 0x89, 0x22, 0x96,
+	0x15, 0xc7, 0xc8, 0x55, 0xd8, 0x17, 0xb7, 0x69, 0x70, 0x97, 0x3a, 0x79, 0xa1, 0x45, 0xfd, 0x3e,
+	0x77, 0xfd, 0x25, 0xd9, 0xc4, 0xbf, 0xe3, 0x65, 0x40, 0x9d, 0xec, 0xdf, 0xf1, 0x4a, 0xee, 0xdf,
+	0xf1, 0xef, 0xff, 0x39, 0x88, 0x68, 0x82, 0x01, 0x9b, 0xb6, 0x3d, 0x6c, 0x40, 0x51, 0x03, 0xfa,
+	0x0e, 0x37, 0x5c, 0xd2, 0xa1, 0x34, 0xbc, 0x34, 0xbe, 0xe1, 0x85, 0x0d, 0xe1, 0x3d, 0x84, 0x2b,
+	0x70, 0x25, 0x73, 0x82, 0xe1, 0xb0, 0xdb, 0x31, 0x45, 0x07, 0xf5, 0xdf, 0xf0, 0x08, 0x9b, 0x0a,
+	0xc4, 0x63, 0x43, 0xd5, 0xd3, 0x89, 0x6b, 0xb7, 0x0b, 0x69, 0x90, 0xbc, 0x19, 0x2f, 0x78, 0xfa,
+	0xb2, 0x78, 0xb2, 0x94, 0xc5, 0x72, 0xa5, 0xa7, 0x2f, 0x0a, 0xf0, 0xa5, 0x2c, 0x96, 0xe9, 0x34,
+	0x71, 0xd3, 0x54, 0x98, 0x8a, 0x9a, 0xc4, 0x7f, 0x44, 0x78, 0x49, 0xad, 0x27, 0xd3, 0x98, 0xd2,
+	0x91, 0x8d, 0x29, 0x6e, 0xa4, 0x5e, 0x47, 0x58, 0x8f, 0x2e, 0x92, 0x42, 0xf7, 0x27, 0x31, 0xb1,
+	0xdf, 0x47, 0xf8, 0x52, 0x2e, 0x90, 0xc7, 0x92, 0xbe, 0x6f, 0x24, 0x37, 0x9e, 0x9d, 0x28, 0x9d,
+	0x27, 0xce, 0x29, 0x82, 0x27, 0xc3, 0xb3, 0x04, 0x28, 0x63, 0xbf, 0x65, 0x27, 0x6a, 0x62, 0xd8,
+	0x89, 0xd2, 0xf0, 0x59, 0x3f, 0x6c, 0xec, 0xb4, 0x28, 0xbb, 0x34, 0x4e, 0x36, 0xe2, 0x6f, 0xf1,
+	0xbe, 0x24, 0xe9, 0x4a, 0xee, 0x19, 0xdd, 0xa4, 0x58, 0x79, 0x5f, 0x12, 0x9a, 0xf1, 0x7b, 0x86,
+	0xd0, 0x44, 0xbc, 0x2f, 0x29, 0x8c, 0xf9, 0x7f, 0xdc, 0x97, 0xc6, 0xb2, 0xa3, 0x74, 0x44, 0x3b,
+	0x8a, 0x1b, 0xdd, 0xd7, 0xe2, 0xe0, 0x75, 0xd2, 0xfb, 0x49, 0xac, 0x8d, 0xf7, 0xf8, 0x22, 0xcd,
+	0xc0, 0xf1, 0xf8, 0x31, 0x77, 0x0d, 0x1c, 0xc0, 0x6d, 0x1a, 0xdc, 0x62, 0x69, 0xec, 0xbc, 0xed,
+	0xff, 0xab, 0x58, 0x53, 0x09, 0x83, 0x55, 0x9f, 0xc5, 0x38, 0x29, 0x85, 0x79, 0x37, 0x2f, 0x19,
+	0x95, 0x54, 0x83, 0x4d, 0x42, 0x83, 0xd8, 0x15, 0xdd, 0xb4, 0xed, 0x34, 0x92, 0xa2, 0xe6, 0x74,
+	0x9c, 0x34, 0x1c, 0xd2, 0x92, 0x61, 0x42, 0xe9, 0x48, 0x26, 0x14, 0x37, 0x2a, 0x35, 0xb8, 0xff,
+	0x6f, 0xd3, 0x60, 0x2b, 0x7a, 0x3d, 0x90, 0x35, 0x24, 0x77, 0xf0, 0x7c, 0x4a, 0x12, 0x8c, 0x79,
+	0x16, 0x9f, 0x81, 0x22, 0x20, 0x6c, 0x56, 0xb2, 0x04, 0xea, 0xc0, 0x0c, 0x2e, 0x1a, 0x87, 0x1e,
+	0x36, 0x6d, 0x7b, 0x48, 0x75, 0x81, 0x21, 0xd1, 0xf9, 0x94, 0x0a, 0x15, 0xe6, 0xd2, 0x98, 0x98,
+	0x8b, 0xe3, 0xfd, 0x17, 0xfc, 0x5a, 0x0d, 0x3d, 0xef, 0xee, 0x87, 0x71, 0x42, 0x4e, 0x80, 0x86,
+	0xcf, 0x76, 0x5d, 0x3f, 0x78, 0xc1, 0x72, 0xda, 0xb0, 0x81, 0xc4, 0xdf, 0x42, 0x6a, 0x65, 0x22,
+	0x27, 0x0b, 0xf5, 0xd1, 0xc3, 0x8b, 0x6f, 0xf3, 0x89, 0x3b, 0x84, 0xec, 0xb1, 0xe0, 0xed, 0xfa,
+	0x9f, 0x2f, 0xe3, 0x27, 0x18, 0x3a, 0xb2, 0x8f, 0x4f, 0x47, 0xaf, 0x35, 0xc8, 0xb2, 0x84, 0x20,
+	0xfd, 0x14, 0x44, 0x5b, 0xc9, 0x16, 0x88, 0x54, 0xe8, 0x8b, 0xf7, 0xfe, 0xf1, 0x9f, 0xfb, 0x13,
+	0x17, 0xc8, 0x8c, 0x91, 0x7e, 0xfe, 0x43, 0xbe, 0x19, 0xa5, 0x0b, 0x88, 0xa2, 0x1b, 0xf9, 0x49,
+	0x88, 0xb6, 0x9a, 0x23, 0x01, 0x9a, 0x2a, 0x4c, 0x53, 0x99, 0xcc, 0x19, 0xc3, 0xcf, 0x73, 0x8c,
+	0xbe, 0xd5, 0x1e, 0x10, 0x0b, 0x9f, 0x61, 0x09, 0x30, 0xdb, 0x56, 0xe9, 0x93, 0x9f, 0x6b, 0x68,
+	0xab, 0x39, 0x12, 0xa0, 0x6f, 0x81, 0xe9, 0x9b, 0x21, 0x4f, 0xa5, 0xf4, 0x91, 0x9f, 0x21, 0x7c,
+	0x5e, 0x3e, 0x37, 0x48, 0x55, 0xc1, 0x94, 0xea, 0x84, 0xd3, 0x6a, 0xa3, 0x05, 0x01, 0x80, 0xc1,
+	0x00, 0x5c, 0x25, 0xd5, 0x14, 0x00, 0xbf, 0xb9, 0x77, 0xd8, 0x84, 0x83, 0xd1, 0xe8, 0xc3, 0x8f,
+	0x01, 0x79, 0x1f, 0xe1, 0x19, 0x45, 0x9a, 0x9d, 0xac, 0x67, 0xaa, 0x54, 0xbc, 0x29, 0xd0, 0x9e,
+	0x1e, 0x53, 0x1a, 0x50, 0x7e, 0x9e, 0xa1, 0xfc, 0x34, 0xb9, 0xa1, 0x46, 0xe9, 0xb1, 0x36, 0x4d,
+	0x93, 0x35, 0x32, 0xfa, 0xf0, 0x3c, 0x61, 0x60, 0xf4, 0x21, 0xf8, 0x3f, 0x20, 0xef, 0x21, 0x3c,
+	0xab, 0x4a, 0x68, 0x93, 0x6c, 0x20, 0xaa, 0x4c, 0xbd, 0x56, 0x1f, 0x57, 0x1c, 0x80, 0x7f, 0x8a,
+	0x01, 0xbf, 0x4e, 0x9e, 0x51, 0x03, 0xf7, 0x59, 0xa3, 0x26, 0xb8, 0x93, 0x46, 0x1f, 0x7e, 0xdc,
+	0x7e, 0x6e, 0x40, 0x7e, 0x82, 0xf0, 0xb4, 0x94, 0x69, 0x26, 0x57, 0xd4, 0xba, 0x87, 0x13, 0xe1,
+	0x5a, 0x75, 0xa4, 0x1c, 0x80, 0x5b, 0x67, 0xe0, 0xae, 0x90, 0x35, 0x23, 0xf3, 0x2d, 0x9a, 0x6f,
+	0xf4, 0xa3, 0x0d, 0x6c, 0x40, 0xde, 0x81, 0xf9, 0x98, 0x24, 0x7f, 0xb3, 0xe6, 0x63, 0x2a, 0xe1,
+	0xac, 0xd5, 0x46, 0x0b, 0x02, 0xa6, 0x1b, 0x0c, 0xd3, 0x06, 0x31, 0xc6, 0xc1, 0x64, 0xf4, 0x79,
+	0xd9, 0x80, 0x0c, 0xf0, 0x94, 0x90, 0x79, 0x25, 0x6b, 0x69, 0x8d, 0xe9, 0xa4, 0xb2, 0x76, 0x79,
+	0x84, 0x14, 0x80, 0x5a, 0x65, 0xa0, 0x16, 0xc9, 0x82, 0x21, 0x3f, 0x26, 0x0c, 0x25, 0xd9, 0xcb,
+	0x39, 0x9f, 0x7c, 0x17, 0x61, 0x9c, 0xe4, 0x32, 0xc9, 0xa5, 0xcc, 0x79, 0x92, 0x24, 0x61, 0xb5,
+	0xb5, 0x7c, 0x21, 0x50, 0x5e, 0x65, 0xca, 0x57, 0xc9, 0xb2, 0x7a, 0x0a, 0x05, 0x66, 0xc7, 0xe8,
+	0x07, 0x66, 0x67, 0x40, 0x0e, 0xf0, 0x19, 0x48, 0x2e, 0xaa, 0xf6, 0x26, 0x39, 0xaf, 0xa9, 0xad,
+	0xe6, 0x48, 0x80, 0xe2, 0x8b, 0x4c, 0xf1, 0x3c, 0xb9, 0x20, 0x29, 0x0e, 0xdc, 0x6e, 0xa8, 0xd3,
+	0x27, 0x3f, 0x47, 0x72, 0x2a, 0x8b, 0xd4, 0x94, 0xdb, 0xab, 0x22, 0xa5, 0xa8, 0x5d, 0x1d, 0x43,
+	0x12, 0x40, 0x6c, 0x30, 0x10, 0xd7, 0xc8, 0x55, 0x23, 0xeb, 0xc5, 0xa3, 0x9f, 0x2c, 0xf3, 0x68,
+	0x8f, 0x0e, 0x57, 0x8e, 0xd8, 0x97, 0x72, 0xe5, 0xa8, 0xb2, 0x87, 0x5a, 0x75, 0xa4, 0x5c, 0xee,
+	0xca, 0xc9, 0x40, 0x45, 0x7e, 0x88, 0xa4, 0x8c, 0x92, 0x6a, 0xd9, 0x28, 0x13, 0x70, 0x5a, 0x6d,
+	0xb4, 0x20, 0x00, 0xba, 0xc2, 0x00, 0xad, 0x90, 0x8a, 0x91, 0xf5, 0xb8, 0x33, 0xe2, 0xe6, 0x75,
+	0x84, 0xcf, 0x0b, 0xed, 0xc3, 0x73, 0xac, 0xaa, 0x3c, 0xa5, 0xc6, 0x43, 0xa3, 0xce, 0xa0, 0x65,
+	0xac, 0x17, 0x11, 0x0d, 0x79, 0x15, 0xe3, 0x24, 0xe3, 0xa4, 0x5a, 0x2e, 0xa9, 0x94, 0x97, 0xb6,
+	0x96, 0x2f, 0x04, 0xba, 0x97, 0x99, 0xee, 0x05, 0x32, 0x6f, 0x28, 0x9e, 0xb1, 0x86, 0xba, 0x5e,
+	0x43, 0x78, 0x5a, 0x4a, 0xf3, 0xa8, 0xa6, 0x87, 0x2a, 0xdb, 0xa4, 0x55, 0x47, 0xca, 0x01, 0x86,
+	0x4b, 0x0c, 0xc3, 0x45, 0xb2, 0x68, 0xa8, 0x9f, 0xd2, 0x36, 0x6d, 0xb7, 0xc3, 0x70, 0x48, 0x19,
+	0x13, 0x15, 0x0e, 0x55, 0x5a, 0x47, 0xab, 0x8e, 0x94, 0xcb, 0xc5, 0xb1, 0xc7, 0x64, 0xe1, 0xb4,
+	0xf4, 0xc9, 0x8f, 0x10, 0x3e, 0x2f, 0xa7, 0x29, 0x48, 0xa6, 0x82, 0xa1, 0x7c, 0x89, 0x56, 0x1b,
+	0x2d, 0x08, 0x50, 0xd6, 0x18, 0x94, 0x0a, 0x59, 0x52, 0x41, 0x69, 0x71, 0xc5, 0x21, 0x27, 0x52,
+	0x72, 0x40, 0xc5, 0x89, 0x2a, 0x47, 0xa1, 0x55, 0x47, 0xca, 0xe5, 0x72, 0xe2, 0x81, 0x2c, 0xec,
+	0xe6, 0xdf, 0x49, 0x02, 0xb2, 0xaa, 0x93, 0x24, 0x9d, 0x13, 0xd0, 0x2e, 0x8f, 0x90, 0xca, 0xd5,
+	0x1e, 0x3f, 0x4b, 0x8f, 0x16, 0xe9, 0xb7, 0xf1, 0x14, 0x6f, 0x18, 0x2e, 0xd0, 0x35, 0xe5, 0xba,
+	0x1b, 0x03, 0x80, 0x22, 0x2a, 0x9f, 0xe1, 0xe0, 0xc6, 0x00, 0xc8, 0xef, 0x10, 0x26, 0xe9, 0x48,
+	0x35, 0xb9, 0xa6, 0x3a, 0x28, 0x33, 0x82, 0xea, 0xda, 0xfa, 0x78, 0xc2, 0x80, 0xe8, 0x59, 0x86,
+	0xa8, 0x4e, 0xd6, 0xd5, 0x88, 0x32, 0xdc, 0xd0, 0x37, 0x90, 0x1c, 0x56, 0xcc, 0x38, 0x7d, 0x14,
+	0x81, 0x63, 0xed, 0xea, 0x18, 0x92, 0xb9, 0x67, 0xaf, 0xf4, 0x47, 0x81, 0x68, 0xc8, 0x7e, 0x8c,
+	0xf0, 0xc7, 0xc5, 0x1e, 0xc2, 0x71, 0x53, 0xef, 0x97, 0x63, 0x22, 0xca, 0x08, 0x46, 0xeb, 0x3a,
+	0x43, 0xb4, 0x44, 0xb4, 0x6c, 0x44, 0xe4, 0x2f, 0x08, 0xcf, 0xa9, 0x83, 0xb2, 0xc4, 0x50, 0x1c,
+	0xfb, 0x79, 0x71, 0x64, 0xed, 0x99, 0xf1, 0x1b, 0xe4, 0xba, 0xbc, 0x12, 0xc2, 0x8c, 0x31, 0xfd,
+	0x35, 0xc2, 0x53, 0x42, 0xbc, 0x2b, 0xe3, 0x9c, 0x4c, 0x47, 0x3a, 0xb5, 0xda, 0x68, 0xc1, 0xfc,
+	0x8b, 0x84, 0xf0, 0xc7, 0x8e, 0xd0, 0xb9, 0xf4, 0x82, 0x81, 0xe8, 0x8b, 0x1b, 0x7d, 0x1e, 0xd2,
+	0x8d, 0x0e, 0x50, 0xa1, 0xe3, 0xec, 0x03, 0x74, 0x3c, 0x98, 0xea, 0x90, 0x6a, 0xc6, 0x01, 0x2a,
+	0xc2, 0x24, 0x7f, 0x42, 0xf8, 0x82, 0x32, 0xba, 0x48, 0x54, 0x77, 0x94, 0x9c, 0x70, 0xa8, 0x66,
+	0x8c, 0x2d, 0x9f, 0xef, 0xa3, 0x0b, 0xe8, 0x32, 0x06, 0xf8, 0x07, 0x48, 0x8c, 0xab, 0xa9, 0xf6,
+	0x76, 0x55, 0xf8, 0x51, 0xab, 0x8e, 0x94, 0x03, 0x60, 0x97, 0x19, 0xb0, 0x65, 0x72, 0xd1, 0xc8,
+	0xf8, 0x5b, 0x4e, 0xb4, 0x58, 0xef, 0x21, 0x3c, 0x9d, 0xb4, 0x0e, 0x87, 0xf0, 0x8a, 0x72, 0x64,
+	0xc6, 0x42, 0xa2, 0x0c, 0x20, 0xea, 0x2b, 0x0c, 0x89, 0x46, 0xca, 0x59, 0x48, 0xc8, 0xb7, 0xe2,
+	0x48, 0x8d, 0xca, 0xfb, 0x49, 0x05, 0xfc, 0xb4, 0xb5, 0x7c, 0xa1, 0xdc, 0x89, 0x03, 0xff, 0x3c,
+	0x8a, 0xac, 0xef, 0x61, 0x0c, 0xad, 0x42, 0xcb, 0x2f, 0x29, 0x2d, 0x1a, 0xad, 0x3b, 0x1d, 0xb3,
+	0xd3, 0x97, 0x98, 0xee, 0x39, 0x32, 0xab, 0xd2, 0x4d, 0xde, 0x42, 0x78, 0x5a, 0x8a, 0x59, 0xa9,
+	0x48, 0x57, 0x85, 0xdb, 0xb4, 0xea, 0x48, 0xb9, 0xdc, 0x79, 0x09, 0x00, 0x9a, 0x01, 0x13, 0x36,
+	0xfa, 0x3c, 0x54, 0x37, 0x88, 0xaf, 0x91, 0x37, 0x9f, 0xfe, 0xe0, 0x61, 0x05, 0x7d, 0xf8, 0xb0,
+	0x82, 0xfe, 0xfd, 0xb0, 0x82, 0xde, 0x7c, 0x54, 0x39, 0xf5, 0xe1, 0xa3, 0xca, 0xa9, 0x7f, 0x3e,
+	0xaa, 0x9c, 0xfa, 0xca, 0x0c, 0xf4, 0xf4, 0x6a, 0xd4, 0x57, 0x70, 0xd8, 0xa5, 0xfe, 0xde, 0x69,
+	0xf6, 0xc7, 0xa6, 0x4f, 0xfe, 0x77, 0x00, 0x7c, 0x47, 0xa4, 0xc5, 0x13, 0x37, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Post by id.
+	Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error)
+	// Queries a list of Post items.
+	PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error)
+	// Queries a list of Post items by creator.
+	PostsByCreator(ctx context.Context, in *QueryPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryPostsByCreatorResponse, error)
+	// Queries a list of Post items received from an author on another chain.
+	PostsByRemoteAuthor(ctx context.Context, in *QueryPostsByRemoteAuthorRequest, opts ...grpc.CallOption) (*QueryPostsByRemoteAuthorResponse, error)
+	// Queries a list of Post items received through a channel.
+	PostsBySourceChannel(ctx context.Context, in *QueryPostsBySourceChannelRequest, opts ...grpc.CallOption) (*QueryPostsBySourceChannelResponse, error)
+	// Queries the revisions of a Post, from the oldest.
+	PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error)
+	// Queries a revision of a Post.
+	PostAtRevision(ctx context.Context, in *QueryPostAtRevisionRequest, opts ...grpc.CallOption) (*QueryPostAtRevisionResponse, error)
+	// Queries a list of Post items matching the words of a query.
+	SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error)
+	// Queries a list of Post items tagged with a tag, hashtags of the content included.
+	PostsByTag(ctx context.Context, in *QueryPostsByTagRequest, opts ...grpc.CallOption) (*QueryPostsByTagResponse, error)
+	// Queries the tags with the most posts, from the most used.
+	TopTags(ctx context.Context, in *QueryTopTagsRequest, opts ...grpc.CallOption) (*QueryTopTagsResponse, error)
+	// Queries a Notification of an address by id.
+	Notification(ctx context.Context, in *QueryGetNotificationRequest, opts ...grpc.CallOption) (*QueryGetNotificationResponse, error)
+	// Queries the notifications of an address, from the oldest.
+	Notifications(ctx context.Context, in *QueryNotificationsRequest, opts ...grpc.CallOption) (*QueryNotificationsResponse, error)
+	// Queries a post queued for moderation by id.
+	InboundPost(ctx context.Context, in *QueryGetInboundPostRequest, opts ...grpc.CallOption) (*QueryGetInboundPostResponse, error)
+	// Queries the posts queued for moderation, from the oldest.
+	InboundPostAll(ctx context.Context, in *QueryAllInboundPostRequest, opts ...grpc.CallOption) (*QueryAllInboundPostResponse, error)
+	// Queries the moderators.
+	Moderators(ctx context.Context, in *QueryModeratorsRequest, opts ...grpc.CallOption) (*QueryModeratorsResponse, error)
+	// Queries the log of the moderation actions, from the oldest.
+	ModerationLog(ctx context.Context, in *QueryModerationLogRequest, opts ...grpc.CallOption) (*QueryModerationLogResponse, error)
+	// Queries the banned authors of other chains, optionally on a channel.
+	BannedAuthors(ctx context.Context, in *QueryBannedAuthorsRequest, opts ...grpc.CallOption) (*QueryBannedAuthorsResponse, error)
+	// Queries the banned channels.
+	BannedChannels(ctx context.Context, in *QueryBannedChannelsRequest, opts ...grpc.CallOption) (*QueryBannedChannelsResponse, error)
+	// Queries the posts with open reports, for review by the moderators.
+	ReportedPosts(ctx context.Context, in *QueryReportedPostsRequest, opts ...grpc.CallOption) (*QueryReportedPostsResponse, error)
+	// Queries a SentPost by id.
+	SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error)
+	// Queries a list of SentPost items.
+	SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error)
+	// Queries a list of SentPost items by creator.
+	SentPostsByCreator(ctx context.Context, in *QuerySentPostsByCreatorRequest, opts ...grpc.CallOption) (*QuerySentPostsByCreatorResponse, error)
+	// Queries a TimedoutPost by id.
+	TimedoutPost(ctx context.Context, in *QueryGetTimedoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimedoutPostResponse, error)
+	// Queries a list of TimedoutPost items.
+	TimedoutPostAll(ctx context.Context, in *QueryAllTimedoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimedoutPostResponse, error)
+	// Queries a list of TimedoutPost items by creator.
+	TimedoutPostsByCreator(ctx context.Context, in *QueryTimedoutPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryTimedoutPostsByCreatorResponse, error)
+	// Queries a PendingPost by index.
+	PendingPost(ctx context.Context, in *QueryGetPendingPostRequest, opts ...grpc.CallOption) (*QueryGetPendingPostResponse, error)
+	// Queries a list of PendingPost items.
+	PendingPostAll(ctx context.Context, in *QueryAllPendingPostRequest, opts ...grpc.CallOption) (*QueryAllPendingPostResponse, error)
+	// Queries a list of PendingPost items sent by a creator.
+	PendingPostsByCreator(ctx context.Context, in *QueryPendingPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryPendingPostsByCreatorResponse, error)
+	// Queries a FailedPost by id.
+	FailedPost(ctx context.Context, in *QueryGetFailedPostRequest, opts ...grpc.CallOption) (*QueryGetFailedPostResponse, error)
+	// Queries a list of FailedPost items.
+	FailedPostAll(ctx context.Context, in *QueryAllFailedPostRequest, opts ...grpc.CallOption) (*QueryAllFailedPostResponse, error)
+	// Queries a Comment by id.
+	Comment(ctx context.Context, in *QueryGetCommentRequest, opts ...grpc.CallOption) (*QueryGetCommentResponse, error)
+	// Queries a list of Comment items.
+	CommentAll(ctx context.Context, in *QueryAllCommentRequest, opts ...grpc.CallOption) (*QueryAllCommentResponse, error)
+	// Queries the comment thread of a post.
+	CommentThread(ctx context.Context, in *QueryCommentThreadRequest, opts ...grpc.CallOption) (*QueryCommentThreadResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error) {
+	out := new(QueryGetPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Post", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error) {
+	out := new(QueryAllPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostsByCreator(ctx context.Context, in *QueryPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryPostsByCreatorResponse, error) {
+	out := new(QueryPostsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostsByRemoteAuthor(ctx context.Context, in *QueryPostsByRemoteAuthorRequest, opts ...grpc.CallOption) (*QueryPostsByRemoteAuthorResponse, error) {
+	out := new(QueryPostsByRemoteAuthorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostsByRemoteAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostsBySourceChannel(ctx context.Context, in *QueryPostsBySourceChannelRequest, opts ...grpc.CallOption) (*QueryPostsBySourceChannelResponse, error) {
+	out := new(QueryPostsBySourceChannelResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostsBySourceChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error) {
+	out := new(QueryPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostAtRevision(ctx context.Context, in *QueryPostAtRevisionRequest, opts ...grpc.CallOption) (*QueryPostAtRevisionResponse, error) {
+	out := new(QueryPostAtRevisionResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostAtRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error) {
+	out := new(QuerySearchPostsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SearchPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostsByTag(ctx context.Context, in *QueryPostsByTagRequest, opts ...grpc.CallOption) (*QueryPostsByTagResponse, error) {
+	out := new(QueryPostsByTagResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopTags(ctx context.Context, in *QueryTopTagsRequest, opts ...grpc.CallOption) (*QueryTopTagsResponse, error) {
+	out := new(QueryTopTagsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TopTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Notification(ctx context.Context, in *QueryGetNotificationRequest, opts ...grpc.CallOption) (*QueryGetNotificationResponse, error) {
+	out := new(QueryGetNotificationResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Notification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Notifications(ctx context.Context, in *QueryNotificationsRequest, opts ...grpc.CallOption) (*QueryNotificationsResponse, error) {
+	out := new(QueryNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Notifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InboundPost(ctx context.Context, in *QueryGetInboundPostRequest, opts ...grpc.CallOption) (*QueryGetInboundPostResponse, error) {
+	out := new(QueryGetInboundPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/InboundPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InboundPostAll(ctx context.Context, in *QueryAllInboundPostRequest, opts ...grpc.CallOption) (*QueryAllInboundPostResponse, error) {
+	out := new(QueryAllInboundPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/InboundPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Moderators(ctx context.Context, in *QueryModeratorsRequest, opts ...grpc.CallOption) (*QueryModeratorsResponse, error) {
+	out := new(QueryModeratorsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Moderators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModerationLog(ctx context.Context, in *QueryModerationLogRequest, opts ...grpc.CallOption) (*QueryModerationLogResponse, error) {
+	out := new(QueryModerationLogResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/ModerationLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BannedAuthors(ctx context.Context, in *QueryBannedAuthorsRequest, opts ...grpc.CallOption) (*QueryBannedAuthorsResponse, error) {
+	out := new(QueryBannedAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/BannedAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BannedChannels(ctx context.Context, in *QueryBannedChannelsRequest, opts ...grpc.CallOption) (*QueryBannedChannelsResponse, error) {
+	out := new(QueryBannedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/BannedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReportedPosts(ctx context.Context, in *QueryReportedPostsRequest, opts ...grpc.CallOption) (*QueryReportedPostsResponse, error) {
+	out := new(QueryReportedPostsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/ReportedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error) {
+	out := new(QueryGetSentPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error) {
+	out := new(QueryAllSentPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SentPostsByCreator(ctx context.Context, in *QuerySentPostsByCreatorRequest, opts ...grpc.CallOption) (*QuerySentPostsByCreatorResponse, error) {
+	out := new(QuerySentPostsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimedoutPost(ctx context.Context, in *QueryGetTimedoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimedoutPostResponse, error) {
+	out := new(QueryGetTimedoutPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimedoutPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimedoutPostAll(ctx context.Context, in *QueryAllTimedoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimedoutPostResponse, error) {
+	out := new(QueryAllTimedoutPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimedoutPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimedoutPostsByCreator(ctx context.Context, in *QueryTimedoutPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryTimedoutPostsByCreatorResponse, error) {
+	out := new(QueryTimedoutPostsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimedoutPostsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPost(ctx context.Context, in *QueryGetPendingPostRequest, opts ...grpc.CallOption) (*QueryGetPendingPostResponse, error) {
+	out := new(QueryGetPendingPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PendingPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPostAll(ctx context.Context, in *QueryAllPendingPostRequest, opts ...grpc.CallOption) (*QueryAllPendingPostResponse, error) {
+	out := new(QueryAllPendingPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PendingPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPostsByCreator(ctx context.Context, in *QueryPendingPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryPendingPostsByCreatorResponse, error) {
+	out := new(QueryPendingPostsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PendingPostsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedPost(ctx context.Context, in *QueryGetFailedPostRequest, opts ...grpc.CallOption) (*QueryGetFailedPostResponse, error) {
+	out := new(QueryGetFailedPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/FailedPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedPostAll(ctx context.Context, in *QueryAllFailedPostRequest, opts ...grpc.CallOption) (*QueryAllFailedPostResponse, error) {
+	out := new(QueryAllFailedPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/FailedPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Comment(ctx context.Context, in *QueryGetCommentRequest, opts ...grpc.CallOption) (*QueryGetCommentResponse, error) {
+	out := new(QueryGetCommentResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Comment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommentAll(ctx context.Context, in *QueryAllCommentRequest, opts ...grpc.CallOption) (*QueryAllCommentResponse, error) {
+	out := new(QueryAllCommentResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/CommentAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommentThread(ctx context.Context, in *QueryCommentThreadRequest, opts ...grpc.CallOption) (*QueryCommentThreadResponse, error) {
+	out := new(QueryCommentThreadResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/CommentThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Post by id.
+	Post(context.Context, *QueryGetPostRequest) (*QueryGetPostResponse, error)
+	// Queries a list of Post items.
+	PostAll(context.Context, *QueryAllPostRequest) (*QueryAllPostResponse, error)
+	// Queries a list of Post items by creator.
+	PostsByCreator(context.Context, *QueryPostsByCreatorRequest) (*QueryPostsByCreatorResponse, error)
+	// Queries a list of Post items received from an author on another chain.
+	PostsByRemoteAuthor(context.Context, *QueryPostsByRemoteAuthorRequest) (*QueryPostsByRemoteAuthorResponse, error)
+	// Queries a list of Post items received through a channel.
+	PostsBySourceChannel(context.Context, *QueryPostsBySourceChannelRequest) (*QueryPostsBySourceChannelResponse, error)
+	// Queries the revisions of a Post, from the oldest.
+	PostRevisions(context.Context, *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error)
+	// Queries a revision of a Post.
+	PostAtRevision(context.Context, *QueryPostAtRevisionRequest) (*QueryPostAtRevisionResponse, error)
+	// Queries a list of Post items matching the words of a query.
+	SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error)
+	// Queries a list of Post items tagged with a tag, hashtags of the content included.
+	PostsByTag(context.Context, *QueryPostsByTagRequest) (*QueryPostsByTagResponse, error)
+	// Queries the tags with the most posts, from the most used.
+	TopTags(context.Context, *QueryTopTagsRequest) (*QueryTopTagsResponse, error)
+	// Queries a Notification of an address by id.
+	Notification(context.Context, *QueryGetNotificationRequest) (*QueryGetNotificationResponse, error)
+	// Queries the notifications of an address, from the oldest.
+	Notifications(context.Context, *QueryNotificationsRequest) (*QueryNotificationsResponse, error)
+	// Queries a post queued for moderation by id.
+	InboundPost(context.Context, *QueryGetInboundPostRequest) (*QueryGetInboundPostResponse, error)
+	// Queries the posts queued for moderation, from the oldest.
+	InboundPostAll(context.Context, *QueryAllInboundPostRequest) (*QueryAllInboundPostResponse, error)
+	// Queries the moderators.
+	Moderators(context.Context, *QueryModeratorsRequest) (*QueryModeratorsResponse, error)
+	// Queries the log of the moderation actions, from the oldest.
+	ModerationLog(context.Context, *QueryModerationLogRequest) (*QueryModerationLogResponse, error)
+	// Queries the banned authors of other chains, optionally on a channel.
+	BannedAuthors(context.Context, *QueryBannedAuthorsRequest) (*QueryBannedAuthorsResponse, error)
+	// Queries the banned channels.
+	BannedChannels(context.Context, *QueryBannedChannelsRequest) (*QueryBannedChannelsResponse, error)
+	// Queries the posts with open reports, for review by the moderators.
+	ReportedPosts(context.Context, *QueryReportedPostsRequest) (*QueryReportedPostsResponse, error)
+	// Queries a SentPost by id.
+	SentPost(context.Context, *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error)
+	// Queries a list of SentPost items.
+	SentPostAll(context.Context, *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error)
+	// Queries a list of SentPost items by creator.
+	SentPostsByCreator(context.Context, *QuerySentPostsByCreatorRequest) (*QuerySentPostsByCreatorResponse, error)
+	// Queries a TimedoutPost by id.
+	TimedoutPost(context.Context, *QueryGetTimedoutPostRequest) (*QueryGetTimedoutPostResponse, error)
+	// Queries a list of TimedoutPost items.
+	TimedoutPostAll(context.Context, *QueryAllTimedoutPostRequest) (*QueryAllTimedoutPostResponse, error)
+	// Queries a list of TimedoutPost items by creator.
+	TimedoutPostsByCreator(context.Context, *QueryTimedoutPostsByCreatorRequest) (*QueryTimedoutPostsByCreatorResponse, error)
+	// Queries a PendingPost by index.
+	PendingPost(context.Context, *QueryGetPendingPostRequest) (*QueryGetPendingPostResponse, error)
+	// Queries a list of PendingPost items.
+	PendingPostAll(context.Context, *QueryAllPendingPostRequest) (*QueryAllPendingPostResponse, error)
+	// Queries a list of PendingPost items sent by a creator.
+	PendingPostsByCreator(context.Context, *QueryPendingPostsByCreatorRequest) (*QueryPendingPostsByCreatorResponse, error)
+	// Queries a FailedPost by id.
+	FailedPost(context.Context, *QueryGetFailedPostRequest) (*QueryGetFailedPostResponse, error)
+	// Queries a list of FailedPost items.
+	FailedPostAll(context.Context, *QueryAllFailedPostRequest) (*QueryAllFailedPostResponse, error)
+	// Queries a Comment by id.
+	Comment(context.Context, *QueryGetCommentRequest) (*QueryGetCommentResponse, error)
+	// Queries a list of Comment items.
+	CommentAll(context.Context, *QueryAllCommentRequest) (*QueryAllCommentResponse, error)
+	// Queries the comment thread of a post.
+	CommentThread(context.Context, *QueryCommentThreadRequest) (*QueryCommentThreadResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Post(ctx context.Context, req *QueryGetPostRequest) (*QueryGetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+}
+func (*UnimplementedQueryServer) PostAll(ctx context.Context, req *QueryAllPostRequest) (*QueryAllPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAll not implemented")
+}
+func (*UnimplementedQueryServer) PostsByCreator(ctx context.Context, req *QueryPostsByCreatorRequest) (*QueryPostsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) PostsByRemoteAuthor(ctx context.Context, req *QueryPostsByRemoteAuthorRequest) (*QueryPostsByRemoteAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostsByRemoteAuthor not implemented")
+}
+func (*UnimplementedQueryServer) PostsBySourceChannel(ctx context.Context, req *QueryPostsBySourceChannelRequest) (*QueryPostsBySourceChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostsBySourceChannel not implemented")
+}
+func (*UnimplementedQueryServer) PostRevisions(ctx context.Context, req *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevisions not implemented")
+}
+func (*UnimplementedQueryServer) PostAtRevision(ctx context.Context, req *QueryPostAtRevisionRequest) (*QueryPostAtRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAtRevision not implemented")
+}
+func (*UnimplementedQueryServer) SearchPosts(ctx context.Context, req *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (*UnimplementedQueryServer) PostsByTag(ctx context.Context, req *QueryPostsByTagRequest) (*QueryPostsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostsByTag not implemented")
+}
+func (*UnimplementedQueryServer) TopTags(ctx context.Context, req *QueryTopTagsRequest) (*QueryTopTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopTags not implemented")
+}
+func (*UnimplementedQueryServer) Notification(ctx context.Context, req *QueryGetNotificationRequest) (*QueryGetNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notification not implemented")
+}
+func (*UnimplementedQueryServer) Notifications(ctx context.Context, req *QueryNotificationsRequest) (*QueryNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notifications not implemented")
+}
+func (*UnimplementedQueryServer) InboundPost(ctx context.Context, req *QueryGetInboundPostRequest) (*QueryGetInboundPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundPost not implemented")
+}
+func (*UnimplementedQueryServer) InboundPostAll(ctx context.Context, req *QueryAllInboundPostRequest) (*QueryAllInboundPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundPostAll not implemented")
+}
+func (*UnimplementedQueryServer) Moderators(ctx context.Context, req *QueryModeratorsRequest) (*QueryModeratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Moderators not implemented")
+}
+func (*UnimplementedQueryServer) ModerationLog(ctx context.Context, req *QueryModerationLogRequest) (*QueryModerationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationLog not implemented")
+}
+func (*UnimplementedQueryServer) BannedAuthors(ctx context.Context, req *QueryBannedAuthorsRequest) (*QueryBannedAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BannedAuthors not implemented")
+}
+func (*UnimplementedQueryServer) BannedChannels(ctx context.Context, req *QueryBannedChannelsRequest) (*QueryBannedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BannedChannels not implemented")
+}
+func (*UnimplementedQueryServer) ReportedPosts(ctx context.Context, req *QueryReportedPostsRequest) (*QueryReportedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportedPosts not implemented")
+}
+func (*UnimplementedQueryServer) SentPost(ctx context.Context, req *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPost not implemented")
+}
+func (*UnimplementedQueryServer) SentPostAll(ctx context.Context, req *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPostAll not implemented")
+}
+func (*UnimplementedQueryServer) SentPostsByCreator(ctx context.Context, req *QuerySentPostsByCreatorRequest) (*QuerySentPostsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPostsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) TimedoutPost(ctx context.Context, req *QueryGetTimedoutPostRequest) (*QueryGetTimedoutPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimedoutPost not implemented")
+}
+func (*UnimplementedQueryServer) TimedoutPostAll(ctx context.Context, req *QueryAllTimedoutPostRequest) (*QueryAllTimedoutPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimedoutPostAll not implemented")
+}
+func (*UnimplementedQueryServer) TimedoutPostsByCreator(ctx context.Context, req *QueryTimedoutPostsByCreatorRequest) (*QueryTimedoutPostsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimedoutPostsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) PendingPost(ctx context.Context, req *QueryGetPendingPostRequest) (*QueryGetPendingPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPost not implemented")
+}
+func (*UnimplementedQueryServer) PendingPostAll(ctx context.Context, req *QueryAllPendingPostRequest) (*QueryAllPendingPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPostAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingPostsByCreator(ctx context.Context, req *QueryPendingPostsByCreatorRequest) (*QueryPendingPostsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPostsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) FailedPost(ctx context.Context, req *QueryGetFailedPostRequest) (*QueryGetFailedPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedPost not implemented")
+}
+func (*UnimplementedQueryServer) FailedPostAll(ctx context.Context, req *QueryAllFailedPostRequest) (*QueryAllFailedPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedPostAll not implemented")
+}
+func (*UnimplementedQueryServer) Comment(ctx context.Context, req *QueryGetCommentRequest) (*QueryGetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Comment not implemented")
+}
+func (*UnimplementedQueryServer) CommentAll(ctx context.Context, req *QueryAllCommentRequest) (*QueryAllCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentAll not implemented")
+}
+func (*UnimplementedQueryServer) CommentThread(ctx context.Context, req *QueryCommentThreadRequest) (*QueryCommentThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentThread not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Post(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Post",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Post(ctx, req.(*QueryGetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAll(ctx, req.(*QueryAllPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostsByCreator(ctx, req.(*QueryPostsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostsByRemoteAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsByRemoteAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostsByRemoteAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostsByRemoteAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostsByRemoteAuthor(ctx, req.(*QueryPostsByRemoteAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostsBySourceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsBySourceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostsBySourceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostsBySourceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostsBySourceChannel(ctx, req.(*QueryPostsBySourceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostRevisions(ctx, req.(*QueryPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostAtRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostAtRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostAtRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostAtRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAtRevision(ctx, req.(*QueryPostAtRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchPosts(ctx, req.(*QuerySearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostsByTag(ctx, req.(*QueryPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TopTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopTags(ctx, req.(*QueryTopTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Notification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Notification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Notification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Notification(ctx, req.(*QueryGetNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Notifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Notifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Notifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Notifications(ctx, req.(*QueryNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InboundPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInboundPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InboundPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/InboundPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InboundPost(ctx, req.(*QueryGetInboundPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InboundPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInboundPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InboundPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/InboundPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InboundPostAll(ctx, req.(*QueryAllInboundPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Moderators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModeratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Moderators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Moderators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Moderators(ctx, req.(*QueryModeratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModerationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModerationLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModerationLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/ModerationLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModerationLog(ctx, req.(*QueryModerationLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BannedAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBannedAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BannedAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/BannedAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BannedAuthors(ctx, req.(*QueryBannedAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BannedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBannedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BannedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/BannedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BannedChannels(ctx, req.(*QueryBannedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReportedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/ReportedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportedPosts(ctx, req.(*QueryReportedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSentPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPost(ctx, req.(*QueryGetSentPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSentPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPostAll(ctx, req.(*QueryAllSentPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPostsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySentPostsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPostsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPostsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPostsByCreator(ctx, req.(*QuerySentPostsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimedoutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTimedoutPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimedoutPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TimedoutPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimedoutPost(ctx, req.(*QueryGetTimedoutPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimedoutPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTimedoutPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimedoutPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TimedoutPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimedoutPostAll(ctx, req.(*QueryAllTimedoutPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimedoutPostsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimedoutPostsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimedoutPostsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TimedoutPostsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimedoutPostsByCreator(ctx, req.(*QueryTimedoutPostsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PendingPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPost(ctx, req.(*QueryGetPendingPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PendingPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPostAll(ctx, req.(*QueryAllPendingPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPostsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPostsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPostsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PendingPostsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPostsByCreator(ctx, req.(*QueryPendingPostsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFailedPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/FailedPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedPost(ctx, req.(*QueryGetFailedPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFailedPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/FailedPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedPostAll(ctx, req.(*QueryAllFailedPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Comment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Comment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Comment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Comment(ctx, req.(*QueryGetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommentAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommentAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/CommentAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommentAll(ctx, req.(*QueryAllCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommentThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommentThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommentThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/CommentThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommentThread(ctx, req.(*QueryCommentThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "planet.blog.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Post",
+			Handler:    _Query_Post_Handler,
+		},
+		{
+			MethodName: "PostAll",
+			Handler:    _Query_PostAll_Handler,
+		},
+		{
+			MethodName: "PostsByCreator",
+			Handler:    _Query_PostsByCreator_Handler,
+		},
+		{
+			MethodName: "PostsByRemoteAuthor",
+			Handler:    _Query_PostsByRemoteAuthor_Handler,
+		},
+		{
+			MethodName: "PostsBySourceChannel",
+			Handler:    _Query_PostsBySourceChannel_Handler,
+		},
+		{
+			MethodName: "PostRevisions",
+			Handler:    _Query_PostRevisions_Handler,
+		},
+		{
+			MethodName: "PostAtRevision",
+			Handler:    _Query_PostAtRevision_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Query_SearchPosts_Handler,
+		},
+		{
+			MethodName: "PostsByTag",
+			Handler:    _Query_PostsByTag_Handler,
+		},
+		{
+			MethodName: "TopTags",
+			Handler:    _Query_TopTags_Handler,
+		},
+		{
+			MethodName: "Notification",
+			Handler:    _Query_Notification_Handler,
+		},
+		{
+			MethodName: "Notifications",
+			Handler:    _Query_Notifications_Handler,
+		},
+		{
+			MethodName: "InboundPost",
+			Handler:    _Query_InboundPost_Handler,
+		},
+		{
+			MethodName: "InboundPostAll",
+			Handler:    _Query_InboundPostAll_Handler,
+		},
+		{
+			MethodName: "Moderators",
+			Handler:    _Query_Moderators_Handler,
+		},
+		{
+			MethodName: "ModerationLog",
+			Handler:    _Query_ModerationLog_Handler,
+		},
+		{
+			MethodName: "BannedAuthors",
+			Handler:    _Query_BannedAuthors_Handler,
+		},
+		{
+			MethodName: "BannedChannels",
+			Handler:    _Query_BannedChannels_Handler,
+		},
+		{
+			MethodName: "ReportedPosts",
+			Handler:    _Query_ReportedPosts_Handler,
+		},
+		{
+			MethodName: "SentPost",
+			Handler:    _Query_SentPost_Handler,
+		},
+		{
+			MethodName: "SentPostAll",
+			Handler:    _Query_SentPostAll_Handler,
+		},
+		{
+			MethodName: "SentPostsByCreator",
+			Handler:    _Query_SentPostsByCreator_Handler,
+		},
+		{
+			MethodName: "TimedoutPost",
+			Handler:    _Query_TimedoutPost_Handler,
+		},
+		{
+			MethodName: "TimedoutPostAll",
+			Handler:    _Query_TimedoutPostAll_Handler,
+		},
+		{
+			MethodName: "TimedoutPostsByCreator",
+			Handler:    _Query_TimedoutPostsByCreator_Handler,
+		},
+		{
+			MethodName: "PendingPost",
+			Handler:    _Query_PendingPost_Handler,
+		},
+		{
+			MethodName: "PendingPostAll",
+			Handler:    _Query_PendingPostAll_Handler,
+		},
+		{
+			MethodName: "PendingPostsByCreator",
+			Handler:    _Query_PendingPostsByCreator_Handler,
+		},
+		{
+			MethodName: "FailedPost",
+			Handler:    _Query_FailedPost_Handler,
+		},
+		{
+			MethodName: "FailedPostAll",
+			Handler:    _Query_FailedPostAll_Handler,
+		},
+		{
+			MethodName: "Comment",
+			Handler:    _Query_Comment_Handler,
+		},
+		{
+			MethodName: "CommentAll",
+			Handler:    _Query_CommentAll_Handler,
+		},
+		{
+			MethodName: "CommentThread",
+			Handler:    _Query_CommentThread_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "planet/blog/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByRemoteAuthorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByRemoteAuthorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByRemoteAuthorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsBySourceChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsBySourceChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsBySourceChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsBySourceChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsBySourceChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsBySourceChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostRevision) > 0 {
+		for iNdEx := len(m.PostRevision) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevision[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostAtRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostAtRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostAtRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostAtRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostAtRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostAtRevisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PostRevision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchPostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchPostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchPostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHidden {
+		i--
+		if m.IncludeHidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostsByTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostsByTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostsByTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopTagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TagCount) > 0 {
+		for iNdEx := len(m.TagCount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TagCount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNotificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNotificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNotificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNotificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNotificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNotificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Notification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNotificationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNotificationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNotificationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UnreadOnly {
+		i--
+		if m.UnreadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNotificationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNotificationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNotificationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Notification) > 0 {
+		for iNdEx := len(m.Notification) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notification[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInboundPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInboundPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInboundPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetInboundPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetInboundPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetInboundPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InboundPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInboundPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInboundPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInboundPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInboundPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInboundPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInboundPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundPost) > 0 {
+		for iNdEx := len(m.InboundPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModeratorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModeratorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModeratorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModeratorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moderator) > 0 {
+		for iNdEx := len(m.Moderator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moderator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModerationLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModerationLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModerationLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModerationLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModerationLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModerationLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModerationAction) > 0 {
+		for iNdEx := len(m.ModerationAction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModerationAction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBannedAuthorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBannedAuthorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBannedAuthorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBannedAuthorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBannedAuthorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBannedAuthorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BannedAuthor) > 0 {
+		for iNdEx := len(m.BannedAuthor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BannedAuthor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBannedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBannedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBannedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBannedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBannedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBannedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BannedChannel) > 0 {
+		for iNdEx := len(m.BannedChannel) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BannedChannel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportedPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportedPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportedPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportedPostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportedPostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportedPostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReportedPost) > 0 {
+		for iNdEx := len(m.ReportedPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportedPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSentPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSentPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SentPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSentPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSentPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SentPost) > 0 {
+		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SentPost) > 0 {
+		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimedoutPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimedoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimedoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimedoutPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimedoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimedoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TimedoutPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimedoutPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimedoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimedoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimedoutPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimedoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimedoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TimedoutPost) > 0 {
+		for iNdEx := len(m.TimedoutPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedoutPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TimedoutPost) > 0 {
+		for iNdEx := len(m.TimedoutPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedoutPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingPost) > 0 {
+		for iNdEx := len(m.PendingPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPostsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPostsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPostsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPostsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPostsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPostsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingPost) > 0 {
+		for iNdEx := len(m.PendingPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFailedPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFailedPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedPost) > 0 {
+		for iNdEx := len(m.FailedPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCommentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCommentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCommentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Comment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCommentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCommentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCommentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCommentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCommentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCommentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Comment) > 0 {
+		for iNdEx := len(m.Comment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Comment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommentThreadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommentThreadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommentThreadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostKind) > 0 {
+		i -= len(m.PostKind)
+		copy(dAtA[i:], m.PostKind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PostKind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommentThreadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommentThreadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommentThreadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Comment) > 0 {
+		for iNdEx := len(m.Comment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Comment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPostsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostsByRemoteAuthorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPostsByRemoteAuthorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostsBySourceChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPostsBySourceChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovQuery(uint64(m.PostID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PostRevision) > 0 {
+		for _, e := range m.PostRevision {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostAtRevisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovQuery(uint64(m.PostID))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryPostAtRevisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PostRevision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySearchPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySearchPostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostsByTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeHidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPostsByTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TagCount) > 0 {
+		for _, e := range m.TagCount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNotificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetNotificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Notification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNotificationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnreadOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNotificationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Notification) > 0 {
+		for _, e := range m.Notification {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInboundPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetInboundPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InboundPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllInboundPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInboundPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InboundPost) > 0 {
+		for _, e := range m.InboundPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModeratorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModeratorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Moderator) > 0 {
+		for _, e := range m.Moderator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModerationLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModerationLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModerationAction) > 0 {
+		for _, e := range m.ModerationAction {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBannedAuthorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBannedAuthorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BannedAuthor) > 0 {
+		for _, e := range m.BannedAuthor {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBannedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBannedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BannedChannel) > 0 {
+		for _, e := range m.BannedChannel {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportedPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportedPostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReportedPost) > 0 {
+		for _, e := range m.ReportedPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSentPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSentPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SentPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllSentPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSentPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SentPost) > 0 {
+		for _, e := range m.SentPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySentPostsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySentPostsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SentPost) > 0 {
+		for _, e := range m.SentPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTimedoutPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTimedoutPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimedoutPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTimedoutPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTimedoutPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimedoutPost) > 0 {
+		for _, e := range m.TimedoutPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimedoutPostsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimedoutPostsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimedoutPost) > 0 {
+		for _, e := range m.TimedoutPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetPendingPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPost) > 0 {
+		for _, e := range m.PendingPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPostsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPostsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPost) > 0 {
+		for _, e := range m.PendingPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFailedPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetFailedPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFailedPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFailedPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedPost) > 0 {
+		for _, e := range m.FailedPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCommentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCommentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Comment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCommentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCommentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		for _, e := range m.Comment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommentThreadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostKind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovQuery(uint64(m.PostID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommentThreadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		for _, e := range m.Comment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, ReactionCount{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByRemoteAuthorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByRemoteAuthorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByRemoteAuthorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByRemoteAuthorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByRemoteAuthorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByRemoteAuthorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsBySourceChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsBySourceChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsBySourceChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsBySourceChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsBySourceChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsBySourceChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostRevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRevision = append(m.PostRevision, PostRevision{})
+			if err := m.PostRevision[len(m.PostRevision)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostAtRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostAtRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostAtRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostAtRevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostAtRevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostAtRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PostRevision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchPostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchPostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchPostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByTagRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByTagRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostsByTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostsByTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostsByTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopTagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopTagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopTagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopTagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagCount = append(m.TagCount, TagCount{})
+			if err := m.TagCount[len(m.TagCount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNotificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNotificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNotificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNotificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNotificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNotificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Notification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNotificationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNotificationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNotificationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnreadOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNotificationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNotificationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNotificationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notification = append(m.Notification, Notification{})
+			if err := m.Notification[len(m.Notification)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInboundPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInboundPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInboundPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInboundPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInboundPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInboundPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInboundPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInboundPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInboundPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInboundPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInboundPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInboundPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundPost = append(m.InboundPost, InboundPost{})
+			if err := m.InboundPost[len(m.InboundPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModeratorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModeratorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModeratorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModeratorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModeratorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModeratorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moderator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moderator = append(m.Moderator, Moderator{})
+			if err := m.Moderator[len(m.Moderator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModerationLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModerationLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModerationLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModerationLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModerationLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModerationLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationAction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModerationAction = append(m.ModerationAction, ModerationAction{})
+			if err := m.ModerationAction[len(m.ModerationAction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBannedAuthorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBannedAuthorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBannedAuthorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBannedAuthorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBannedAuthorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBannedAuthorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedAuthor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedAuthor = append(m.BannedAuthor, BannedAuthor{})
+			if err := m.BannedAuthor[len(m.BannedAuthor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBannedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBannedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBannedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBannedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBannedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBannedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedChannel = append(m.BannedChannel, BannedChannel{})
+			if err := m.BannedChannel[len(m.BannedChannel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedPostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedPostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedPostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedPost = append(m.ReportedPost, ReportedPost{})
+			if err := m.ReportedPost[len(m.ReportedPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSentPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSentPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, ReactionCount{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSentPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSentPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentPost = append(m.SentPost, SentPost{})
+			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentPost = append(m.SentPost, SentPost{})
+			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimedoutPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimedoutPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimedoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimedoutPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimedoutPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimedoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimedoutPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimedoutPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimedoutPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimedoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimedoutPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimedoutPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimedoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedoutPost = append(m.TimedoutPost, TimedoutPost{})
+			if err := m.TimedoutPost[len(m.TimedoutPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimedoutPostsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimedoutPostsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimedoutPostsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimedoutPostsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimedoutPostsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimedoutPostsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedoutPost = append(m.TimedoutPost, TimedoutPost{})
+			if err := m.TimedoutPost[len(m.TimedoutPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPost = append(m.PendingPost, PendingPost{})
+			if err := m.PendingPost[len(m.PendingPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPostsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPostsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPostsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1785,7 +15208,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPostsByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,15 +15231,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPostsByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPostsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1843,7 +15266,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingPost = append(m.PendingPost, PendingPost{})
+			if err := m.PendingPost[len(m.PendingPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1868,7 +15328,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedPostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,10 +15351,10 @@ func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedPostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1937,7 +15397,7 @@ func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedPostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1960,15 +15420,15 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedPostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1995,7 +15455,7 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2020,7 +15480,7 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedPostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,10 +15503,10 @@ func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedPostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2106,7 +15566,7 @@ func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedPostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,15 +15589,15 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedPostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2164,8 +15624,8 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Post = append(m.Post, Post{})
-			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedPost = append(m.FailedPost, FailedPost{})
+			if err := m.FailedPost[len(m.FailedPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2226,7 +15686,7 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2249,10 +15709,10 @@ func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSentPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2295,7 +15755,7 @@ func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2318,15 +15778,15 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSentPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2353,7 +15813,7 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SentPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Comment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2378,7 +15838,7 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCommentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2401,10 +15861,10 @@ func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSentPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCommentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCommentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2464,7 +15924,7 @@ func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCommentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,15 +15947,15 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSentPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCommentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCommentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2522,8 +15982,8 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentPost = append(m.SentPost, SentPost{})
-			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Comment = append(m.Comment, Comment{})
+			if err := m.Comment[len(m.Comment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2584,7 +16044,7 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTimedoutPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommentThreadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2607,17 +16067,17 @@ func (m *QueryGetTimedoutPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTimedoutPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommentThreadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTimedoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommentThreadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostKind", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2627,66 +16087,29 @@ func (m *QueryGetTimedoutPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetTimedoutPostResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTimedoutPostResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTimedoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPost", wireType)
+			m.PostKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
 			}
-			var msglen int
+			m.PostID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2696,76 +16119,12 @@ func (m *QueryGetTimedoutPostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PostID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TimedoutPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllTimedoutPostRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTimedoutPostRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTimedoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2822,7 +16181,7 @@ func (m *QueryAllTimedoutPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTimedoutPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommentThreadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,15 +16204,15 @@ func (m *QueryAllTimedoutPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTimedoutPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommentThreadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTimedoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommentThreadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2880,8 +16239,8 @@ func (m *QueryAllTimedoutPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimedoutPost = append(m.TimedoutPost, TimedoutPost{})
-			if err := m.TimedoutPost[len(m.TimedoutPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Comment = append(m.Comment, Comment{})
+			if err := m.Comment[len(m.Comment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
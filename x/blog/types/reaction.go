@@ -0,0 +1,46 @@
+package types
+
+// The types of reactions to a post
+const (
+	ReactionLike  = "like"
+	ReactionLove  = "love"
+	ReactionLaugh = "laugh"
+	ReactionWow   = "wow"
+	ReactionSad   = "sad"
+	ReactionAngry = "angry"
+)
+
+// IsValidReaction returns true if the type of a reaction is known
+func IsValidReaction(reaction string) bool {
+	switch reaction {
+	case ReactionLike, ReactionLove, ReactionLaugh, ReactionWow, ReactionSad, ReactionAngry:
+		return true
+	default:
+		return false
+	}
+}
+
+// Reactor returns the value identifying the account of a reaction, the address of a local account or the chain ID
+// and address of an account of another chain
+func (r Reaction) Reactor() string {
+	if r.RemoteAuthor != nil {
+		return RemoteAuthorIndexValue(r.RemoteAuthor.ChainID, r.RemoteAuthor.Address)
+	}
+	return r.Creator
+}
+
+// ReactionPostKey returns the store key prefix of the reactions to a post and of their counts by type, length
+// prefixed by IndexKeyPrefix
+func ReactionPostKey(postKind string, postID uint64) []byte {
+	return IndexKeyPrefix(CommentThreadIndexValue(postKind, postID))
+}
+
+// ReactionKey returns the store key to retrieve the reaction of an account to a post
+func ReactionKey(postKind string, postID uint64, reactor string) []byte {
+	return append(ReactionPostKey(postKind, postID), reactor...)
+}
+
+// ReactionCountStoreKey returns the store key of the number of reactions of a type to a post
+func ReactionCountStoreKey(postKind string, postID uint64, reaction string) []byte {
+	return append(ReactionPostKey(postKind, postID), reaction...)
+}
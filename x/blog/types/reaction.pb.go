@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/reaction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reaction is the reaction of an account to a post, an account has at most one reaction per post
+type Reaction struct {
+	// postKind is "post" when postID is the ID of a Post, or "sentPost" when postID is the ID of a SentPost
+	// the reaction was received for
+	PostKind string `protobuf:"bytes,1,opt,name=postKind,proto3" json:"postKind,omitempty"`
+	PostID   uint64 `protobuf:"varint,2,opt,name=postID,proto3" json:"postID,omitempty"`
+	// reaction is the type of the reaction, like "like"
+	Reaction string `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// creator is the address of the local account, it is empty for reactions received from another chain
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// remoteAuthor identifies the account of a reaction received from another chain, it is not set for local
+	// reactions
+	RemoteAuthor *RemoteAuthor `protobuf:"bytes,5,opt,name=remoteAuthor,proto3" json:"remoteAuthor,omitempty"`
+	// createdAt is the unix time in seconds of the block the reaction was created or received in
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CreatedHeight int64 `protobuf:"varint,7,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_619025005bafef58, []int{0}
+}
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(m, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetPostKind() string {
+	if m != nil {
+		return m.PostKind
+	}
+	return ""
+}
+
+func (m *Reaction) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *Reaction) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *Reaction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Reaction) GetRemoteAuthor() *RemoteAuthor {
+	if m != nil {
+		return m.RemoteAuthor
+	}
+	return nil
+}
+
+func (m *Reaction) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Reaction) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+// ReactionCount is the number of reactions of a type to a post
+type ReactionCount struct {
+	Reaction string `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ReactionCount) Reset()         { *m = ReactionCount{} }
+func (m *ReactionCount) String() string { return proto.CompactTextString(m) }
+func (*ReactionCount) ProtoMessage()    {}
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_619025005bafef58, []int{1}
+}
+func (m *ReactionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReactionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReactionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReactionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionCount.Merge(m, src)
+}
+func (m *ReactionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReactionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionCount proto.InternalMessageInfo
+
+func (m *ReactionCount) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *ReactionCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Reaction)(nil), "planet.blog.Reaction")
+	proto.RegisterType((*ReactionCount)(nil), "planet.blog.ReactionCount")
+}
+
+func init() { proto.RegisterFile("planet/blog/reaction.proto", fileDescriptor_619025005bafef58) }
+
+var fileDescriptor_619025005bafef58 = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x3f, 0x4f, 0xc3, 0x30,
+	0x10, 0xc5, 0x73, 0xb4, 0x4d, 0xdb, 0x2b, 0x5d, 0x0c, 0xaa, 0x4c, 0x84, 0xac, 0xa8, 0x62, 0xc8,
+	0x42, 0x2a, 0xc1, 0xcc, 0x10, 0x60, 0x00, 0xb1, 0x79, 0x64, 0x4b, 0x53, 0xab, 0x8d, 0x54, 0xe2,
+	0xc8, 0xbd, 0x4a, 0xf0, 0x2d, 0xf8, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x21, 0x58, 0x51, 0xfe,
+	0x41, 0xc2, 0xe6, 0x77, 0xef, 0x77, 0xb6, 0xdf, 0x43, 0x27, 0xdd, 0x86, 0x89, 0xa2, 0xc5, 0x72,
+	0xab, 0xd7, 0x0b, 0xa3, 0xc2, 0x88, 0x62, 0x9d, 0xf8, 0xa9, 0xd1, 0xa4, 0xd9, 0xa4, 0xf2, 0xfc,
+	0xc2, 0x73, 0x66, 0x6d, 0x30, 0xd5, 0x3b, 0xaa, 0xa0, 0xf9, 0x37, 0xe0, 0x48, 0xd6, 0x7b, 0xcc,
+	0xc1, 0x51, 0x61, 0x3d, 0xc5, 0xc9, 0x8a, 0x83, 0x0b, 0xde, 0x58, 0xfe, 0x6a, 0x36, 0x43, 0xbb,
+	0x38, 0x3f, 0xde, 0xf3, 0x23, 0x17, 0xbc, 0xbe, 0xac, 0x55, 0xb1, 0xd3, 0xbc, 0xcb, 0x7b, 0xd5,
+	0x4e, 0xa3, 0x19, 0xc7, 0x61, 0x64, 0x54, 0x48, 0xda, 0xf0, 0x7e, 0x69, 0x35, 0x92, 0xdd, 0xe0,
+	0xb1, 0x51, 0x2f, 0x9a, 0x54, 0xb0, 0xa7, 0x8d, 0x36, 0x7c, 0xe0, 0x82, 0x37, 0xb9, 0x3a, 0xf3,
+	0x5b, 0x5f, 0xf6, 0x65, 0x0b, 0x90, 0x1d, 0x9c, 0x9d, 0xe3, 0xb8, 0xbc, 0x49, 0xad, 0x02, 0xe2,
+	0xb6, 0x0b, 0x5e, 0x4f, 0xfe, 0x0d, 0xd8, 0x05, 0x4e, 0x6b, 0xf1, 0xa0, 0xe2, 0xf5, 0x86, 0xf8,
+	0xb0, 0x24, 0xba, 0xc3, 0x79, 0x80, 0xd3, 0x26, 0xf8, 0x9d, 0xde, 0x27, 0xd4, 0x49, 0x02, 0xff,
+	0x92, 0x9c, 0xe2, 0x20, 0x2a, 0xa0, 0x3a, 0x7c, 0x25, 0x6e, 0x2f, 0x3f, 0x32, 0x01, 0x87, 0x4c,
+	0xc0, 0x57, 0x26, 0xe0, 0x3d, 0x17, 0xd6, 0x21, 0x17, 0xd6, 0x67, 0x2e, 0xac, 0xe7, 0x93, 0xba,
+	0xee, 0xd7, 0xaa, 0x70, 0x7a, 0x4b, 0xd5, 0x6e, 0x69, 0x97, 0x95, 0x5f, 0xff, 0x0c, 0x00, 0x56,
+	0x1b, 0xc9, 0x83, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (m *Reaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemoteAuthor != nil {
+		{
+			size, err := m.RemoteAuthor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintReaction(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reaction) > 0 {
+		i -= len(m.Reaction)
+		copy(dAtA[i:], m.Reaction)
+		i = encodeVarintReaction(dAtA, i, uint64(len(m.Reaction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostID != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostKind) > 0 {
+		i -= len(m.PostKind)
+		copy(dAtA[i:], m.PostKind)
+		i = encodeVarintReaction(dAtA, i, uint64(len(m.PostKind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReactionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReactionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReactionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reaction) > 0 {
+		i -= len(m.Reaction)
+		copy(dAtA[i:], m.Reaction)
+		i = encodeVarintReaction(dAtA, i, uint64(len(m.Reaction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostKind)
+	if l > 0 {
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovReaction(uint64(m.PostID))
+	}
+	l = len(m.Reaction)
+	if l > 0 {
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	if m.RemoteAuthor != nil {
+		l = m.RemoteAuthor.Size()
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovReaction(uint64(m.CreatedAt))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovReaction(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *ReactionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reaction)
+	if l > 0 {
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovReaction(uint64(m.Count))
+	}
+	return n
+}
+
+func sovReaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReaction(x uint64) (n int) {
+	return sovReaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reaction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAuthor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteAuthor == nil {
+				m.RemoteAuthor = &RemoteAuthor{}
+			}
+			if err := m.RemoteAuthor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReactionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReactionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReactionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reaction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReaction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReaction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReaction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReaction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReaction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReaction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReaction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package types
+
+// The reason codes of a report
+const (
+	ReportReasonSpam           = "spam"
+	ReportReasonAbuse          = "abuse"
+	ReportReasonIllegal        = "illegal"
+	ReportReasonMisinformation = "misinformation"
+	ReportReasonOther          = "other"
+)
+
+// IsValidReportReason returns true if the reason code of a report is known
+func IsValidReportReason(reason string) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonAbuse, ReportReasonIllegal, ReportReasonMisinformation, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MinSearchTokenLength is the minimum number of characters of a search token, shorter words are not indexed
+	MinSearchTokenLength = 2
+	// MaxSearchTokenLength is the maximum number of characters of a search token, longer words are not indexed
+	MaxSearchTokenLength = 64
+	// MaxSearchQueryTokens is the maximum number of search tokens of a search query
+	MaxSearchQueryTokens = 8
+
+	// SearchOperatorAnd matches the posts containing all the tokens of a search query
+	SearchOperatorAnd = "and"
+	// SearchOperatorOr matches the posts containing any token of a search query
+	SearchOperatorOr = "or"
+)
+
+// SearchTokens returns the distinct search tokens of texts in order of first occurrence, at most maxTokens tokens
+// are returned. The tokens are the lower-cased words of letters and digits.
+func SearchTokens(maxTokens uint64, texts ...string) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, word := range strings.FieldsFunc(text, isSearchTokenSeparator) {
+			if uint64(len(tokens)) >= maxTokens {
+				return tokens
+			}
+			length := utf8.RuneCountInString(word)
+			if length < MinSearchTokenLength || length > MaxSearchTokenLength {
+				continue
+			}
+			token := strings.ToLower(word)
+			if seen[token] {
+				continue
+			}
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// IsValidSearchOperator returns true if the operator combines the tokens of a search query, an empty operator
+// is the "and" operator
+func IsValidSearchOperator(operator string) bool {
+	switch operator {
+	case "", SearchOperatorAnd, SearchOperatorOr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSearchTokenSeparator returns true if the rune separates words
+func isSearchTokenSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+// SearchTokensValue returns the stored value of the search tokens a post is indexed with
+func SearchTokensValue(tokens []string) []byte {
+	return []byte(strings.Join(tokens, " "))
+}
+
+// ParseSearchTokensValue returns the search tokens of a stored value built by SearchTokensValue
+func ParseSearchTokensValue(bz []byte) []string {
+	return strings.Fields(string(bz))
+}
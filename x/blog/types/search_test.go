@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTokens(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		maxTokens uint64
+		texts     []string
+		tokens    []string
+	}{
+		{
+			name:      "words",
+			maxTokens: 10,
+			texts:     []string{"Hello, Mars! It's 2042."},
+			tokens:    []string{"hello", "mars", "it", "2042"},
+		},
+		{
+			name:      "distinct tokens across texts",
+			maxTokens: 10,
+			texts:     []string{"Hello Mars", "hello MARS and Venus"},
+			tokens:    []string{"hello", "mars", "and", "venus"},
+		},
+		{
+			name:      "unicode",
+			maxTokens: 10,
+			texts:     []string{"Élan vital, ÉLAN"},
+			tokens:    []string{"élan", "vital"},
+		},
+		{
+			name:      "length limits",
+			maxTokens: 10,
+			texts:     []string{"a " + strings.Repeat("x", MaxSearchTokenLength+1) + " ok"},
+			tokens:    []string{"ok"},
+		},
+		{
+			name:      "max tokens",
+			maxTokens: 2,
+			texts:     []string{"one two", "three"},
+			tokens:    []string{"one", "two"},
+		},
+		{
+			name:      "no token",
+			maxTokens: 0,
+			texts:     []string{"one two"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.tokens, SearchTokens(tc.maxTokens, tc.texts...))
+		})
+	}
+}
+
+func TestSearchTokensValue(t *testing.T) {
+	tokens := []string{"hello", "mars"}
+	require.Equal(t, tokens, ParseSearchTokensValue(SearchTokensValue(tokens)))
+	require.Empty(t, ParseSearchTokensValue(nil))
+}
@@ -23,11 +23,27 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SentPost struct {
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostID  string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
-	Title   string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Chain   string `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// chainID is the chain ID of the destination chain, resolved from the client of the channel
+	ChainID string `protobuf:"bytes,4,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sentAt is the unix time in seconds of the block the packet was sent in
+	SentAt     int64  `protobuf:"varint,6,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	SentHeight int64  `protobuf:"varint,7,opt,name=sentHeight,proto3" json:"sentHeight,omitempty"`
+	SentTxHash string `protobuf:"bytes,8,opt,name=sentTxHash,proto3" json:"sentTxHash,omitempty"`
+	// ackedAt is the unix time in seconds of the block the acknowledgement was received in
+	AckedAt     int64 `protobuf:"varint,9,opt,name=ackedAt,proto3" json:"ackedAt,omitempty"`
+	AckedHeight int64 `protobuf:"varint,10,opt,name=ackedHeight,proto3" json:"ackedHeight,omitempty"`
+	// postID is the ID of the post on the destination chain
+	PostID uint64 `protobuf:"varint,11,opt,name=postID,proto3" json:"postID,omitempty"`
+	// destinationPort and destinationChannel identify the receiving end of the channel the post was sent through
+	DestinationPort    string `protobuf:"bytes,12,opt,name=destinationPort,proto3" json:"destinationPort,omitempty"`
+	DestinationChannel string `protobuf:"bytes,13,opt,name=destinationChannel,proto3" json:"destinationChannel,omitempty"`
+	// sourcePort and sourceChannel identify the sending end of the channel on this chain, edits and retractions
+	// of the post are sent through it
+	SourcePort    string `protobuf:"bytes,14,opt,name=sourcePort,proto3" json:"sourcePort,omitempty"`
+	SourceChannel string `protobuf:"bytes,15,opt,name=sourceChannel,proto3" json:"sourceChannel,omitempty"`
 }
 
 func (m *SentPost) Reset()         { *m = SentPost{} }
@@ -70,30 +86,93 @@ func (m *SentPost) GetId() uint64 {
 	return 0
 }
 
-func (m *SentPost) GetPostID() string {
+func (m *SentPost) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SentPost) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SentPost) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *SentPost) GetSentAt() int64 {
+	if m != nil {
+		return m.SentAt
+	}
+	return 0
+}
+
+func (m *SentPost) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *SentPost) GetSentTxHash() string {
+	if m != nil {
+		return m.SentTxHash
+	}
+	return ""
+}
+
+func (m *SentPost) GetAckedAt() int64 {
+	if m != nil {
+		return m.AckedAt
+	}
+	return 0
+}
+
+func (m *SentPost) GetAckedHeight() int64 {
+	if m != nil {
+		return m.AckedHeight
+	}
+	return 0
+}
+
+func (m *SentPost) GetPostID() uint64 {
 	if m != nil {
 		return m.PostID
 	}
+	return 0
+}
+
+func (m *SentPost) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
 	return ""
 }
 
-func (m *SentPost) GetTitle() string {
+func (m *SentPost) GetDestinationChannel() string {
 	if m != nil {
-		return m.Title
+		return m.DestinationChannel
 	}
 	return ""
 }
 
-func (m *SentPost) GetChain() string {
+func (m *SentPost) GetSourcePort() string {
 	if m != nil {
-		return m.Chain
+		return m.SourcePort
 	}
 	return ""
 }
 
-func (m *SentPost) GetCreator() string {
+func (m *SentPost) GetSourceChannel() string {
 	if m != nil {
-		return m.Creator
+		return m.SourceChannel
 	}
 	return ""
 }
@@ -105,20 +184,28 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/sent_post.proto", fileDescriptor_c61cffbf1305fe72) }
 
 var fileDescriptor_c61cffbf1305fe72 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0x4e, 0xcd, 0x2b, 0x89, 0x2f, 0xc8, 0x2f,
-	0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x48, 0xea, 0x81, 0x24, 0x95, 0x2a,
-	0xb8, 0x38, 0x82, 0x53, 0xf3, 0x4a, 0x02, 0xf2, 0x8b, 0x4b, 0x84, 0xf8, 0xb8, 0x98, 0x32, 0x53,
-	0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32, 0x53, 0x84, 0xc4, 0xb8, 0xd8, 0x40, 0xda,
-	0x3c, 0x5d, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x21, 0x11, 0x2e, 0xd6, 0x92,
-	0xcc, 0x92, 0x9c, 0x54, 0x09, 0x66, 0xb0, 0x30, 0x84, 0x03, 0x12, 0x4d, 0xce, 0x48, 0xcc, 0xcc,
-	0x93, 0x60, 0x81, 0x88, 0x82, 0x39, 0x42, 0x12, 0x5c, 0xec, 0xc9, 0x45, 0xa9, 0x89, 0x25, 0xf9,
-	0x45, 0x12, 0xac, 0x60, 0x71, 0x18, 0xd7, 0x49, 0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x84, 0xa1, 0xae, 0xaf, 0x80, 0xb8, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x98, 0x6d, 0x45, 0xdb, 0x00,
-	0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xc2, 0x40,
+	0x10, 0x87, 0x29, 0xe5, 0xef, 0x20, 0x60, 0x56, 0x63, 0x36, 0x31, 0xd9, 0x34, 0xc6, 0x43, 0x2f,
+	0xc2, 0xc1, 0x27, 0x40, 0x39, 0x80, 0x27, 0x82, 0x9e, 0xbc, 0x98, 0x85, 0x6e, 0xe8, 0xc6, 0x66,
+	0xb7, 0x69, 0xc7, 0x04, 0xdf, 0xc2, 0xa7, 0xf1, 0x19, 0x3c, 0x72, 0xf4, 0x68, 0xe0, 0x45, 0x4c,
+	0xa7, 0xad, 0xa9, 0xc6, 0xdb, 0xfe, 0xbe, 0x6f, 0x3b, 0x3b, 0xd3, 0x81, 0xf3, 0x38, 0x92, 0x46,
+	0xe1, 0x78, 0x15, 0xd9, 0xcd, 0x38, 0x55, 0x06, 0x9f, 0x62, 0x9b, 0xe2, 0x28, 0x4e, 0x2c, 0x5a,
+	0xd6, 0xcb, 0xe5, 0x28, 0x93, 0x17, 0xef, 0x2e, 0x74, 0xee, 0x95, 0xc1, 0x85, 0x4d, 0x91, 0x0d,
+	0xa0, 0xae, 0x03, 0xee, 0x78, 0x8e, 0xdf, 0x58, 0xd6, 0x75, 0xc0, 0x4e, 0xa1, 0x89, 0x1a, 0x23,
+	0xc5, 0x5d, 0xcf, 0xf1, 0xbb, 0xcb, 0x3c, 0x30, 0x0e, 0xed, 0x75, 0x28, 0xb5, 0x99, 0x4f, 0x79,
+	0x83, 0x78, 0x19, 0xc9, 0x24, 0x4a, 0xa2, 0x4d, 0x78, 0xb3, 0x30, 0x79, 0x64, 0x67, 0xd0, 0xca,
+	0xda, 0x98, 0x20, 0x6f, 0x79, 0x8e, 0xef, 0x2e, 0x8b, 0xc4, 0x04, 0x40, 0x76, 0x9a, 0x29, 0xbd,
+	0x09, 0x91, 0xb7, 0xc9, 0x55, 0x48, 0xe9, 0x1f, 0xb6, 0x33, 0x99, 0x86, 0xbc, 0x43, 0x45, 0x2b,
+	0x24, 0x7b, 0x51, 0xae, 0x9f, 0x55, 0x30, 0x41, 0xde, 0xa5, 0x8f, 0xcb, 0xc8, 0x3c, 0xe8, 0xd1,
+	0xb1, 0x28, 0x0d, 0x64, 0xab, 0x28, 0xeb, 0x29, 0xfb, 0x2b, 0xf3, 0x29, 0xef, 0xd1, 0xc4, 0x45,
+	0x62, 0x3e, 0x0c, 0x03, 0x95, 0xa2, 0x36, 0x12, 0xb5, 0x35, 0x0b, 0x9b, 0x20, 0x3f, 0xa2, 0x87,
+	0xff, 0x62, 0x36, 0x02, 0x56, 0x41, 0xb7, 0xa1, 0x34, 0x46, 0x45, 0xbc, 0x4f, 0x97, 0xff, 0x31,
+	0x34, 0x8d, 0x7d, 0x49, 0xd6, 0x8a, 0x8a, 0x0e, 0x8a, 0x69, 0x7e, 0x08, 0xbb, 0x84, 0x7e, 0x9e,
+	0xca, 0x52, 0x43, 0xba, 0xf2, 0x1b, 0xde, 0x35, 0x3a, 0xf5, 0x63, 0xf7, 0xe6, 0xea, 0x63, 0x2f,
+	0x9c, 0xdd, 0x5e, 0x38, 0x5f, 0x7b, 0xe1, 0xbc, 0x1d, 0x44, 0x6d, 0x77, 0x10, 0xb5, 0xcf, 0x83,
+	0xa8, 0x3d, 0x9e, 0x14, 0xcb, 0xdf, 0xe6, 0xeb, 0xc7, 0xd7, 0x58, 0xa5, 0xab, 0x16, 0xed, 0xfe,
+	0xfa, 0x7b, 0x00, 0x96, 0xa2, 0x82, 0x16, 0x1a, 0x02, 0x00, 0x00,
 }
 
 func (m *SentPost) Marshal() (dAtA []byte, err error) {
@@ -141,6 +228,66 @@ func (m *SentPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PostID != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AckedHeight != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.AckedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AckedAt != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.AckedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SentTxHash) > 0 {
+		i -= len(m.SentTxHash)
+		copy(dAtA[i:], m.SentTxHash)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.SentTxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -148,10 +295,10 @@ func (m *SentPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintSentPost(dAtA, i, uint64(len(m.Chain)))
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.ChainID)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -162,13 +309,6 @@ func (m *SentPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.PostID) > 0 {
-		i -= len(m.PostID)
-		copy(dAtA[i:], m.PostID)
-		i = encodeVarintSentPost(dAtA, i, uint64(len(m.PostID)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.Id != 0 {
 		i = encodeVarintSentPost(dAtA, i, uint64(m.Id))
 		i--
@@ -197,19 +337,50 @@ func (m *SentPost) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovSentPost(uint64(m.Id))
 	}
-	l = len(m.PostID)
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovSentPost(uint64(l))
 	}
-	l = len(m.Title)
+	l = len(m.ChainID)
 	if l > 0 {
 		n += 1 + l + sovSentPost(uint64(l))
 	}
-	l = len(m.Chain)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovSentPost(uint64(l))
 	}
-	l = len(m.Creator)
+	if m.SentAt != 0 {
+		n += 1 + sovSentPost(uint64(m.SentAt))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovSentPost(uint64(m.SentHeight))
+	}
+	l = len(m.SentTxHash)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
+	if m.AckedAt != 0 {
+		n += 1 + sovSentPost(uint64(m.AckedAt))
+	}
+	if m.AckedHeight != 0 {
+		n += 1 + sovSentPost(uint64(m.AckedHeight))
+	}
+	if m.PostID != 0 {
+		n += 1 + sovSentPost(uint64(m.PostID))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
+	l = len(m.SourceChannel)
 	if l > 0 {
 		n += 1 + l + sovSentPost(uint64(l))
 	}
@@ -270,10 +441,233 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedAt", wireType)
+			}
+			m.AckedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedHeight", wireType)
+			}
+			m.AckedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
 			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -300,11 +694,11 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostID = string(dAtA[iNdEx:postIndex])
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -332,11 +726,11 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -364,11 +758,11 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -396,7 +790,7 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// tagPattern matches a valid tag, made of letters, digits and underscores
+	tagPattern = regexp.MustCompile(`^[\p{L}\p{N}_]+$`)
+	// hashtagPattern matches the hashtags of a text, a hashtag starts a word
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#])#([\p{L}\p{N}_]+)`)
+)
+
+// ValidateTags checks the tags are valid and distinct
+func ValidateTags(tags []string) error {
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		if !tagPattern.MatchString(tag) {
+			return fmt.Errorf("invalid tag %q, tags are made of letters, digits and underscores", tag)
+		}
+		normalized := NormalizeTag(tag)
+		if seen[normalized] {
+			return fmt.Errorf("duplicated tag %s", tag)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
+// NormalizeTag returns the indexed form of a tag, tags are case insensitive
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(tag, "#"))
+}
+
+// ExtractHashtags returns the distinct normalized hashtags of a text in order of first occurrence
+func ExtractHashtags(text string) []string {
+	var hashtags []string
+	seen := make(map[string]bool)
+	for _, match := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		hashtag := NormalizeTag(match[1])
+		if !seen[hashtag] {
+			seen[hashtag] = true
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return hashtags
+}
+
+// PostTags returns the distinct normalized tags a post is indexed with, the tags given by the author followed by
+// the hashtags of the content. At most maxTags tags are returned, tags longer than maxTagLength are skipped.
+func PostTags(maxTags, maxTagLength uint64, tags []string, content string) []string {
+	var postTags []string
+	seen := make(map[string]bool)
+	for _, tag := range append(append([]string{}, tags...), ExtractHashtags(content)...) {
+		if uint64(len(postTags)) >= maxTags {
+			break
+		}
+		tag = NormalizeTag(tag)
+		if tag == "" || uint64(utf8.RuneCountInString(tag)) > maxTagLength || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		postTags = append(postTags, tag)
+	}
+	return postTags
+}
+
+// TagsValue returns the stored value of the tags a post is indexed with
+func TagsValue(tags []string) []byte {
+	return []byte(strings.Join(tags, " "))
+}
+
+// ParseTagsValue returns the tags of a stored value built by TagsValue
+func ParseTagsValue(bz []byte) []string {
+	return strings.Fields(string(bz))
+}
+
+// TagByCountKey returns the key of a tag in the index of the tags by count. The count is complemented so that
+// the most used tags come first in the index, tags with the same count are ordered alphabetically.
+func TagByCountKey(tag string, count uint64) []byte {
+	key := make([]byte, 8, 8+len(tag))
+	binary.BigEndian.PutUint64(key, ^count)
+	return append(key, tag...)
+}
+
+// ParseTagByCountKey returns the tag and count of a key of the index of the tags by count
+func ParseTagByCountKey(key []byte) (tag string, count uint64) {
+	return string(key[8:]), ^binary.BigEndian.Uint64(key[:8])
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTags(t *testing.T) {
+	require.NoError(t, ValidateTags(nil))
+	require.NoError(t, ValidateTags([]string{"mars", "Venus_2", "élan"}))
+	require.Error(t, ValidateTags([]string{""}))
+	require.Error(t, ValidateTags([]string{"#mars"}))
+	require.Error(t, ValidateTags([]string{"venus express"}))
+	require.Error(t, ValidateTags([]string{"mars", "MARS"}))
+}
+
+func TestExtractHashtags(t *testing.T) {
+	for _, tc := range []struct {
+		text     string
+		hashtags []string
+	}{
+		{text: "no hashtag"},
+		{text: "#Hello #mars, #hello", hashtags: []string{"hello", "mars"}},
+		{text: "(#mars) and #venus_express!", hashtags: []string{"mars", "venus_express"}},
+		{text: "a#b ## #"},
+		{text: "#a#b", hashtags: []string{"a"}},
+	} {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.hashtags, ExtractHashtags(tc.text))
+		})
+	}
+}
+
+func TestPostTags(t *testing.T) {
+	require.Equal(t,
+		[]string{"mars", "venus", "earth"},
+		PostTags(10, 10, []string{"Mars", "venus"}, "#mars and #earth"),
+	)
+	// The tags given by the author come first
+	require.Equal(t, []string{"mars"}, PostTags(1, 10, []string{"mars"}, "#earth"))
+	// Long hashtags are skipped
+	require.Equal(t, []string{"earth"}, PostTags(10, 5, nil, "#jupiter #earth"))
+	require.Empty(t, PostTags(0, 10, []string{"mars"}, "#earth"))
+}
+
+func TestTagByCountKey(t *testing.T) {
+	tag, count := ParseTagByCountKey(TagByCountKey("mars", 3))
+	require.Equal(t, "mars", tag)
+	require.Equal(t, uint64(3), count)
+
+	// Tags with more posts come first
+	require.Less(t, string(TagByCountKey("mars", 3)), string(TagByCountKey("earth", 2)))
+	require.Less(t, string(TagByCountKey("earth", 2)), string(TagByCountKey("mars", 2)))
+}
@@ -23,10 +23,26 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type TimedoutPost struct {
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Chain   string `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
-	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// chainID is the chain ID of the destination chain, resolved from the client of the channel
+	ChainID        string `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Creator        string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Content        string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	RetryPort      string `protobuf:"bytes,6,opt,name=retryPort,proto3" json:"retryPort,omitempty"`
+	RetryChannelID string `protobuf:"bytes,7,opt,name=retryChannelID,proto3" json:"retryChannelID,omitempty"`
+	RetrySequence  uint64 `protobuf:"varint,8,opt,name=retrySequence,proto3" json:"retrySequence,omitempty"`
+	// sentAt is the unix time in seconds of the block the packet was sent in
+	SentAt     int64  `protobuf:"varint,9,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	SentHeight int64  `protobuf:"varint,10,opt,name=sentHeight,proto3" json:"sentHeight,omitempty"`
+	SentTxHash string `protobuf:"bytes,11,opt,name=sentTxHash,proto3" json:"sentTxHash,omitempty"`
+	// timedoutAt is the unix time in seconds of the block the timeout was received in
+	TimedoutAt     int64 `protobuf:"varint,12,opt,name=timedoutAt,proto3" json:"timedoutAt,omitempty"`
+	TimedoutHeight int64 `protobuf:"varint,13,opt,name=timedoutHeight,proto3" json:"timedoutHeight,omitempty"`
+	// destinationPort and destinationChannel identify the receiving end of the channel the post was sent through
+	DestinationPort    string   `protobuf:"bytes,14,opt,name=destinationPort,proto3" json:"destinationPort,omitempty"`
+	DestinationChannel string   `protobuf:"bytes,15,opt,name=destinationChannel,proto3" json:"destinationChannel,omitempty"`
+	Tags               []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (m *TimedoutPost) Reset()         { *m = TimedoutPost{} }
@@ -76,9 +92,9 @@ func (m *TimedoutPost) GetTitle() string {
 	return ""
 }
 
-func (m *TimedoutPost) GetChain() string {
+func (m *TimedoutPost) GetChainID() string {
 	if m != nil {
-		return m.Chain
+		return m.ChainID
 	}
 	return ""
 }
@@ -90,6 +106,90 @@ func (m *TimedoutPost) GetCreator() string {
 	return ""
 }
 
+func (m *TimedoutPost) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetRetryPort() string {
+	if m != nil {
+		return m.RetryPort
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetRetryChannelID() string {
+	if m != nil {
+		return m.RetryChannelID
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetRetrySequence() uint64 {
+	if m != nil {
+		return m.RetrySequence
+	}
+	return 0
+}
+
+func (m *TimedoutPost) GetSentAt() int64 {
+	if m != nil {
+		return m.SentAt
+	}
+	return 0
+}
+
+func (m *TimedoutPost) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *TimedoutPost) GetSentTxHash() string {
+	if m != nil {
+		return m.SentTxHash
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetTimedoutAt() int64 {
+	if m != nil {
+		return m.TimedoutAt
+	}
+	return 0
+}
+
+func (m *TimedoutPost) GetTimedoutHeight() int64 {
+	if m != nil {
+		return m.TimedoutHeight
+	}
+	return 0
+}
+
+func (m *TimedoutPost) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *TimedoutPost) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TimedoutPost)(nil), "planet.blog.TimedoutPost")
 }
@@ -97,19 +197,30 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/timedout_post.proto", fileDescriptor_dfeb3bcc1b7eff8d) }
 
 var fileDescriptor_dfeb3bcc1b7eff8d = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0xc9, 0xcc, 0x4d, 0x4d, 0xc9, 0x2f, 0x2d,
-	0x89, 0x2f, 0xc8, 0x2f, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0x50, 0x4a, 0xe1, 0xe2, 0x09, 0x81, 0xaa, 0x09, 0xc8, 0x2f, 0x2e, 0x11, 0xe2, 0xe3,
-	0x62, 0xca, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x62, 0xca, 0x4c, 0x11, 0x12, 0xe1,
-	0x62, 0x2d, 0xc9, 0x2c, 0xc9, 0x49, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x40,
-	0xa2, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x12, 0xcc, 0x10, 0x51, 0x30, 0x47, 0x48, 0x82, 0x8b, 0x3d,
-	0xb9, 0x28, 0x35, 0xb1, 0x24, 0xbf, 0x48, 0x82, 0x05, 0x2c, 0x0e, 0xe3, 0x3a, 0xe9, 0x9e, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x30, 0xd4, 0xb5, 0x15, 0x50, 0xf7, 0x56,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x78, 0x88,
-	0xf0, 0x35, 0xcb, 0x00, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbb, 0x6e, 0xe2, 0x40,
+	0x14, 0x86, 0x31, 0xe6, 0xb2, 0x1e, 0x6e, 0xab, 0xd9, 0xd5, 0xea, 0x14, 0x2b, 0xaf, 0xb5, 0x5a,
+	0xad, 0xdc, 0x04, 0x8a, 0x3c, 0x01, 0x09, 0x05, 0x74, 0xc8, 0xa1, 0x4a, 0x13, 0x19, 0x3c, 0xc2,
+	0x23, 0x39, 0x33, 0x8e, 0x7d, 0x90, 0xe0, 0x2d, 0xf2, 0x58, 0x29, 0x29, 0x53, 0x46, 0xf0, 0x1a,
+	0x29, 0x22, 0x1f, 0x8f, 0x05, 0x41, 0xe9, 0xe6, 0xff, 0xbe, 0xdf, 0x1a, 0xcf, 0xd1, 0x61, 0x7f,
+	0xd2, 0x24, 0x54, 0x02, 0x47, 0xcb, 0x44, 0xaf, 0x47, 0x28, 0x1f, 0x45, 0xa4, 0x37, 0xf8, 0x90,
+	0xea, 0x1c, 0x87, 0x69, 0xa6, 0x51, 0xf3, 0x4e, 0x59, 0x18, 0x16, 0x85, 0xbf, 0xef, 0x36, 0xeb,
+	0x2e, 0x4c, 0x69, 0xae, 0x73, 0xe4, 0x7d, 0x56, 0x97, 0x11, 0x58, 0x9e, 0xe5, 0x37, 0x82, 0xba,
+	0x8c, 0xf8, 0x4f, 0xd6, 0x44, 0x89, 0x89, 0x80, 0xba, 0x67, 0xf9, 0x4e, 0x50, 0x06, 0x0e, 0xac,
+	0xbd, 0x8a, 0x43, 0xa9, 0x66, 0x13, 0xb0, 0x89, 0x57, 0x91, 0x4c, 0x26, 0x42, 0xd4, 0x19, 0x34,
+	0x8c, 0x29, 0x23, 0x19, 0xad, 0x50, 0x28, 0x84, 0xa6, 0x31, 0x65, 0xe4, 0xbf, 0x99, 0x93, 0x09,
+	0xcc, 0x76, 0x73, 0x9d, 0x21, 0xb4, 0xc8, 0x9d, 0x00, 0xff, 0xcf, 0xfa, 0x14, 0x6e, 0xe3, 0x50,
+	0x29, 0x91, 0xcc, 0x26, 0xd0, 0xa6, 0xca, 0x05, 0xe5, 0xff, 0x58, 0x8f, 0xc8, 0x9d, 0x78, 0xda,
+	0x08, 0xb5, 0x12, 0xf0, 0x8d, 0x1e, 0xf1, 0x19, 0xf2, 0x5f, 0xac, 0x95, 0x0b, 0x85, 0x63, 0x04,
+	0xc7, 0xb3, 0x7c, 0x3b, 0x30, 0x89, 0xbb, 0x8c, 0x15, 0xa7, 0xa9, 0x90, 0xeb, 0x18, 0x81, 0x91,
+	0x3b, 0x23, 0x95, 0x5f, 0x6c, 0xa7, 0x61, 0x1e, 0x43, 0x87, 0xfe, 0xe0, 0x8c, 0x14, 0xbe, 0x1a,
+	0xf6, 0x18, 0xa1, 0x5b, 0x7e, 0x7f, 0x22, 0xc5, 0x2b, 0xaa, 0x64, 0xee, 0xe8, 0x51, 0xe7, 0x82,
+	0x72, 0x9f, 0x0d, 0x22, 0x91, 0xa3, 0x54, 0x21, 0x4a, 0xad, 0x68, 0x22, 0x7d, 0xba, 0xec, 0x12,
+	0xf3, 0x21, 0xe3, 0x67, 0xc8, 0xcc, 0x01, 0x06, 0x54, 0xfe, 0xc2, 0x70, 0xce, 0x1a, 0x18, 0xae,
+	0x73, 0xf8, 0xee, 0xd9, 0xbe, 0x13, 0xd0, 0xf9, 0xe6, 0xea, 0xe5, 0xe0, 0x5a, 0xfb, 0x83, 0x6b,
+	0xbd, 0x1d, 0x5c, 0xeb, 0xf9, 0xe8, 0xd6, 0xf6, 0x47, 0xb7, 0xf6, 0x7a, 0x74, 0x6b, 0xf7, 0x3f,
+	0xcc, 0x1a, 0x6d, 0xcd, 0x22, 0xed, 0x52, 0x91, 0x2f, 0x5b, 0xb4, 0x41, 0xd7, 0x1f, 0x03, 0x00,
+	0x22, 0xff, 0x4f, 0x69, 0x64, 0x02, 0x00, 0x00,
 }
 
 func (m *TimedoutPost) Marshal() (dAtA []byte, err error) {
@@ -132,6 +243,84 @@ func (m *TimedoutPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TimedoutHeight != 0 {
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(m.TimedoutHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TimedoutAt != 0 {
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(m.TimedoutAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.SentTxHash) > 0 {
+		i -= len(m.SentTxHash)
+		copy(dAtA[i:], m.SentTxHash)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.SentTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SentAt != 0 {
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(m.SentAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RetrySequence != 0 {
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(m.RetrySequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RetryChannelID) > 0 {
+		i -= len(m.RetryChannelID)
+		copy(dAtA[i:], m.RetryChannelID)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.RetryChannelID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RetryPort) > 0 {
+		i -= len(m.RetryPort)
+		copy(dAtA[i:], m.RetryPort)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.RetryPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -139,10 +328,10 @@ func (m *TimedoutPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.Chain)))
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTimedoutPost(dAtA, i, uint64(len(m.ChainID)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -185,7 +374,7 @@ func (m *TimedoutPost) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTimedoutPost(uint64(l))
 	}
-	l = len(m.Chain)
+	l = len(m.ChainID)
 	if l > 0 {
 		n += 1 + l + sovTimedoutPost(uint64(l))
 	}
@@ -193,6 +382,51 @@ func (m *TimedoutPost) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTimedoutPost(uint64(l))
 	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	l = len(m.RetryPort)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	l = len(m.RetryChannelID)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	if m.RetrySequence != 0 {
+		n += 1 + sovTimedoutPost(uint64(m.RetrySequence))
+	}
+	if m.SentAt != 0 {
+		n += 1 + sovTimedoutPost(uint64(m.SentAt))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovTimedoutPost(uint64(m.SentHeight))
+	}
+	l = len(m.SentTxHash)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	if m.TimedoutAt != 0 {
+		n += 1 + sovTimedoutPost(uint64(m.TimedoutAt))
+	}
+	if m.TimedoutHeight != 0 {
+		n += 1 + sovTimedoutPost(uint64(m.TimedoutHeight))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovTimedoutPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovTimedoutPost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,7 +518,7 @@ func (m *TimedoutPost) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -312,7 +546,7 @@ func (m *TimedoutPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -346,6 +580,325 @@ func (m *TimedoutPost) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrySequence", wireType)
+			}
+			m.RetrySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetrySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAt", wireType)
+			}
+			m.SentAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutAt", wireType)
+			}
+			m.TimedoutAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedoutAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedoutHeight", wireType)
+			}
+			m.TimedoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimedoutPost(dAtA[iNdEx:])